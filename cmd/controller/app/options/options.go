@@ -41,7 +41,11 @@ type Options struct {
 	Master      string
 	Kubeconfig  string
 	HealthzPort int
-	Cfg         lbconfig.Configuration
+	// WebhookPort is the port of validating webhook, 0 means disabled
+	WebhookPort     int
+	WebhookCertFile string
+	WebhookKeyFile  string
+	Cfg             lbconfig.Configuration
 }
 
 // NewOptions creates a new AddmissionOptions with a default config.
@@ -60,6 +64,9 @@ func (s *Options) Flags() *pflag.FlagSet {
 	fs.StringVar(&s.Kubeconfig, "kubeconfig", s.Kubeconfig, "Path to kubeconfig file with authorization and master location information.")
 	fs.StringVar(&s.Master, "master", s.Master, "The address of the Kubernetes API server (overrides any value in kubeconfig).")
 	fs.IntVar(&s.HealthzPort, "healthzPort", s.HealthzPort, "Port for health check")
+	fs.IntVar(&s.WebhookPort, "webhook-port", s.WebhookPort, "`Port` of validating webhook of LoadBalancers, 0 means disabled")
+	fs.StringVar(&s.WebhookCertFile, "webhook-cert-file", s.WebhookCertFile, "`File` of tls certificate of validating webhook")
+	fs.StringVar(&s.WebhookKeyFile, "webhook-key-file", s.WebhookKeyFile, "`File` of tls private key of validating webhook")
 
 	// init log
 	gofs := goflag.NewFlagSet("klog", goflag.ExitOnError)
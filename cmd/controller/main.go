@@ -24,6 +24,7 @@ import (
 
 	"github.com/caicloud/loadbalancer-controller/cmd/controller/app"
 	"github.com/caicloud/loadbalancer-controller/cmd/controller/app/options"
+	"github.com/caicloud/loadbalancer-controller/pkg/webhook"
 
 	"github.com/caicloud/go-common/kubernetes/leaderelection"
 	"github.com/caicloud/go-common/signal"
@@ -42,6 +43,10 @@ func main() {
 	}
 
 	stopCh := signal.SetupStopSignalHandler()
+	// webhook is served by all replicas, not only the leader
+	if s.WebhookPort != 0 {
+		go webhook.NewServer(s.WebhookPort, s.WebhookCertFile, s.WebhookKeyFile).Run(stopCh)
+	}
 	leaderelection.RunOrDie(leaderelection.Option{
 		LeaseLockName:      "loadbalancer-controller",
 		LeaseLockNamespace: "default",
@@ -21,6 +21,7 @@ require (
 )
 
 replace (
+	github.com/caicloud/clientset => ./staging/src/github.com/caicloud/clientset
 	k8s.io/api => k8s.io/api v0.17.5
 	k8s.io/apiextensions-apiserver => k8s.io/apiextensions-apiserver v0.17.5
 	k8s.io/apimachinery => k8s.io/apimachinery v0.17.5
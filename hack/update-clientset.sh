@@ -0,0 +1,31 @@
+#!/bin/bash
+#
+# Regenerates the deepcopy functions of the loadbalance API in the clientset fork
+# under staging/, and re-vendors the fork. Run it after editing types.go there.
+#
+# Usage: hack/update-clientset.sh
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+API=github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2
+DEEPCOPY_GEN=${DEEPCOPY_GEN:-$(go env GOPATH)/bin/deepcopy-gen}
+
+cd "${ROOT}"
+TMP=$(mktemp -d)
+trap 'rm -rf "${TMP}"' EXIT
+
+cat > "${TMP}/header.txt" <<HEADER
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+HEADER
+
+# deepcopy-gen reads the vendored copy, so sync it with the fork first
+go mod vendor
+GOFLAGS=-mod=vendor "${DEEPCOPY_GEN}" --input-dirs "${API}" -O zz_generated.deepcopy \
+  --go-header-file "${TMP}/header.txt" --output-base "${TMP}"
+cp "${TMP}/${API}/zz_generated.deepcopy.go" "staging/src/${API}/zz_generated.deepcopy.go"
+go mod vendor
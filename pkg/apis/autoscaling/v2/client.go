@@ -0,0 +1,91 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v2 accesses autoscaling/v2 HorizontalPodAutoscalers. The vendored
+// clientset only has autoscaling/v2beta2 which is removed since kubernetes 1.26,
+// the schema of v2beta2 is compatible with v2, so its types are reused here.
+package v2
+
+import (
+	"path"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// GroupName is the group name of autoscaling
+	GroupName = "autoscaling"
+	// Version is the version of the API
+	Version = "v2"
+)
+
+// HorizontalPodAutoscaler is an autoscaling/v2 HorizontalPodAutoscaler
+type HorizontalPodAutoscaler = autoscalingv2beta2.HorizontalPodAutoscaler
+
+// Client reads and writes autoscaling/v2 HorizontalPodAutoscalers through a rest client with json
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used,
+// such as clientset.AutoscalingV2beta2().RESTClient()
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func hpasPath(namespace string, elem ...string) string {
+	return path.Join(append([]string{"/apis", GroupName, Version, "namespaces", namespace, "horizontalpodautoscalers"}, elem...)...)
+}
+
+// GetHorizontalPodAutoscaler gets the HorizontalPodAutoscaler
+func (c *Client) GetHorizontalPodAutoscaler(namespace, name string) (*HorizontalPodAutoscaler, error) {
+	hpa := &HorizontalPodAutoscaler{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(hpasPath(namespace, name)), hpa)
+	return hpa, err
+}
+
+// CreateHorizontalPodAutoscaler creates the HorizontalPodAutoscaler
+func (c *Client) CreateHorizontalPodAutoscaler(hpa *HorizontalPodAutoscaler) (*HorizontalPodAutoscaler, error) {
+	return c.write(c.client.Post().AbsPath(hpasPath(hpa.Namespace)), hpa)
+}
+
+// UpdateHorizontalPodAutoscaler updates the HorizontalPodAutoscaler
+func (c *Client) UpdateHorizontalPodAutoscaler(hpa *HorizontalPodAutoscaler) (*HorizontalPodAutoscaler, error) {
+	return c.write(c.client.Put().AbsPath(hpasPath(hpa.Namespace, hpa.Name)), hpa)
+}
+
+func (c *Client) write(req *rest.Request, hpa *HorizontalPodAutoscaler) (*HorizontalPodAutoscaler, error) {
+	hpa.APIVersion = GroupName + "/" + Version
+	hpa.Kind = "HorizontalPodAutoscaler"
+	req, err := restjson.Body(req, hpa)
+	if err != nil {
+		return nil, err
+	}
+	result := &HorizontalPodAutoscaler{}
+	err = restjson.Do(req, result)
+	return result, err
+}
+
+// DeleteHorizontalPodAutoscaler deletes the HorizontalPodAutoscaler
+func (c *Client) DeleteHorizontalPodAutoscaler(namespace, name string) error {
+	return c.client.Delete().
+		AbsPath(hpasPath(namespace, name)).
+		Do().Error()
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"path"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	"k8s.io/client-go/rest"
+)
+
+// Client reads and writes cert-manager Certificates through a rest client with json
+// +k8s:deepcopy-gen=false
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used,
+// such as clientset.CoreV1().RESTClient()
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func certificatesPath(namespace string, elem ...string) string {
+	return path.Join(append([]string{"/apis", GroupName, Version, "namespaces", namespace, "certificates"}, elem...)...)
+}
+
+// GetCertificate gets the Certificate
+func (c *Client) GetCertificate(namespace, name string) (*Certificate, error) {
+	cert := &Certificate{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(certificatesPath(namespace, name)), cert)
+	return cert, err
+}
+
+// CreateCertificate creates the Certificate
+func (c *Client) CreateCertificate(cert *Certificate) (*Certificate, error) {
+	return c.write(c.client.Post().AbsPath(certificatesPath(cert.Namespace)), cert)
+}
+
+// UpdateCertificate updates the Certificate
+func (c *Client) UpdateCertificate(cert *Certificate) (*Certificate, error) {
+	return c.write(c.client.Put().AbsPath(certificatesPath(cert.Namespace, cert.Name)), cert)
+}
+
+func (c *Client) write(req *rest.Request, cert *Certificate) (*Certificate, error) {
+	cert.APIVersion = GroupName + "/" + Version
+	cert.Kind = Kind
+	req, err := restjson.Body(req, cert)
+	if err != nil {
+		return nil, err
+	}
+	result := &Certificate{}
+	err = restjson.Do(req, result)
+	return result, err
+}
+
+// DeleteCertificate deletes the Certificate
+func (c *Client) DeleteCertificate(namespace, name string) error {
+	return c.client.Delete().
+		AbsPath(certificatesPath(namespace, name)).
+		Do().Error()
+}
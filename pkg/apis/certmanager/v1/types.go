@@ -0,0 +1,98 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GroupName is the group name of cert-manager
+	GroupName = "cert-manager.io"
+	// Version is the version of the API
+	Version = "v1"
+	// Kind is the kind of Certificate
+	Kind = "Certificate"
+
+	// IssuerKind is the kind of namespaced Issuer
+	IssuerKind = "Issuer"
+	// ClusterIssuerKind is the kind of cluster-scoped ClusterIssuer
+	ClusterIssuerKind = "ClusterIssuer"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Certificate requests a certificate from an issuer, and stores it in a Secret
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CertificateSpec   `json:"spec,omitempty"`
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// CertificateSpec is the desired state of a Certificate
+type CertificateSpec struct {
+	// SecretName is the name of Secret which the certificate is stored in
+	SecretName string `json:"secretName"`
+	// DNSNames is the subject alternative names of the certificate
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// IssuerRef references the Issuer or ClusterIssuer signing the certificate
+	IssuerRef ObjectReference `json:"issuerRef"`
+}
+
+// ObjectReference references an issuer
+type ObjectReference struct {
+	Name  string `json:"name"`
+	Kind  string `json:"kind,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+// CertificateStatus is the observed state of a Certificate
+type CertificateStatus struct {
+	Conditions []CertificateCondition `json:"conditions,omitempty"`
+	// NotAfter is the expiration time of the certificate in Secret
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// RenewalTime is the time when the certificate will be renewed
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+}
+
+// CertificateConditionType is the type of a condition
+type CertificateConditionType string
+
+const (
+	// CertificateConditionReady means the certificate is issued and up to date
+	CertificateConditionReady CertificateConditionType = "Ready"
+)
+
+// CertificateCondition is a condition of a Certificate
+type CertificateCondition struct {
+	Type    CertificateConditionType `json:"type"`
+	Status  metav1.ConditionStatus   `json:"status"`
+	Reason  string                   `json:"reason,omitempty"`
+	Message string                   `json:"message,omitempty"`
+}
+
+// Condition returns the condition of type t, nil if not found
+func (c *Certificate) Condition(t CertificateConditionType) *CertificateCondition {
+	for i := range c.Status.Conditions {
+		if c.Status.Conditions[i].Type == t {
+			return &c.Status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"path"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// Client reads GatewayClasses and Gateways and patches their status
+// through a rest client with json
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func groupPath(elem ...string) string {
+	return path.Join(append([]string{"/apis", GroupName, Version}, elem...)...)
+}
+
+func gatewaysPath(namespace string, elem ...string) string {
+	if namespace == metav1.NamespaceAll {
+		return groupPath(append([]string{"gateways"}, elem...)...)
+	}
+	return groupPath(append([]string{"namespaces", namespace, "gateways"}, elem...)...)
+}
+
+// GetGatewayClass gets the GatewayClass
+func (c *Client) GetGatewayClass(name string) (*GatewayClass, error) {
+	class := &GatewayClass{}
+	err := restjson.Do(c.client.Get().AbsPath(groupPath("gatewayclasses", name)), class)
+	return class, err
+}
+
+// ListGatewayClasses lists GatewayClasses
+func (c *Client) ListGatewayClasses(opts metav1.ListOptions) (*GatewayClassList, error) {
+	list := &GatewayClassList{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(groupPath("gatewayclasses")).
+		VersionedParams(&opts, scheme.ParameterCodec), list)
+	return list, err
+}
+
+// CreateGatewayClass creates the GatewayClass
+func (c *Client) CreateGatewayClass(class *GatewayClass) (*GatewayClass, error) {
+	class.APIVersion = GroupName + "/" + Version
+	class.Kind = "GatewayClass"
+	req, err := restjson.Body(c.client.Post().AbsPath(groupPath("gatewayclasses")), class)
+	if err != nil {
+		return nil, err
+	}
+	result := &GatewayClass{}
+	err = restjson.Do(req, result)
+	return result, err
+}
+
+// UpdateGatewayClassStatus patches the status of GatewayClass
+func (c *Client) UpdateGatewayClassStatus(name string, status GatewayClassStatus) error {
+	return patchStatus(c.client, groupPath("gatewayclasses", name, "status"), status)
+}
+
+// GetGateway gets the Gateway
+func (c *Client) GetGateway(namespace, name string) (*Gateway, error) {
+	gw := &Gateway{}
+	err := restjson.Do(c.client.Get().AbsPath(gatewaysPath(namespace, name)), gw)
+	return gw, err
+}
+
+// ListGateways lists Gateways in the namespace, NamespaceAll means all namespaces
+func (c *Client) ListGateways(namespace string, opts metav1.ListOptions) (*GatewayList, error) {
+	list := &GatewayList{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(gatewaysPath(namespace)).
+		VersionedParams(&opts, scheme.ParameterCodec), list)
+	return list, err
+}
+
+// UpdateGatewayStatus patches the status of Gateway
+func (c *Client) UpdateGatewayStatus(namespace, name string, status GatewayStatus) error {
+	return patchStatus(c.client, gatewaysPath(namespace, name, "status"), status)
+}
+
+// patchStatus replaces the status with a merge patch, other fields which
+// are not defined in this package are left untouched
+func patchStatus(client rest.Interface, absPath string, status interface{}) error {
+	req, err := restjson.Body(client.Patch(types.MergePatchType).AbsPath(absPath), map[string]interface{}{
+		"status": status,
+	})
+	if err != nil {
+		return err
+	}
+	// the content type is overwritten by restjson.Body
+	return restjson.Do(req.SetHeader("Content-Type", string(types.MergePatchType)), nil)
+}
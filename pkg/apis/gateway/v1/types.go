@@ -0,0 +1,278 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GroupName is the group name of gateway.networking.k8s.io
+	GroupName = "gateway.networking.k8s.io"
+	// Version is the version of the API
+	Version = "v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GatewayClass describes a class of Gateways available to the user for creating
+// Gateway resources.
+type GatewayClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewayClassSpec   `json:"spec"`
+	Status GatewayClassStatus `json:"status,omitempty"`
+}
+
+// GatewayClassSpec reflects the configuration of a class of Gateways.
+type GatewayClassSpec struct {
+	// ControllerName is the name of the controller that is managing Gateways of this class.
+	ControllerName string `json:"controllerName"`
+	// ParametersRef is a reference to a resource that contains the configuration
+	// parameters corresponding to the GatewayClass.
+	ParametersRef *ParametersReference `json:"parametersRef,omitempty"`
+	// Description helps describe a GatewayClass with more details.
+	Description *string `json:"description,omitempty"`
+}
+
+// ParametersReference identifies an API object containing controller-specific
+// configuration resource within the cluster.
+type ParametersReference struct {
+	Group     string  `json:"group"`
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// GatewayClassStatus is the current status for the GatewayClass.
+type GatewayClassStatus struct {
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GatewayClassList contains a list of GatewayClass
+type GatewayClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []GatewayClass `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Gateway represents an instance of a service-traffic handling infrastructure
+// by binding Listeners to a set of IP addresses.
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GatewaySpec   `json:"spec"`
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// GatewaySpec defines the desired state of Gateway.
+type GatewaySpec struct {
+	// GatewayClassName used for this Gateway.
+	GatewayClassName string `json:"gatewayClassName"`
+	// Listeners associated with this Gateway.
+	Listeners []Listener `json:"listeners"`
+	// Addresses requested for this Gateway.
+	Addresses []GatewayAddress `json:"addresses,omitempty"`
+	// Infrastructure defines infrastructure level attributes about this Gateway instance.
+	Infrastructure *GatewayInfrastructure `json:"infrastructure,omitempty"`
+}
+
+// GatewayInfrastructure defines infrastructure level attributes about a Gateway instance.
+type GatewayInfrastructure struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// ParametersRef is a reference to a resource that contains the configuration
+	// parameters corresponding to the Gateway.
+	ParametersRef *LocalParametersReference `json:"parametersRef,omitempty"`
+}
+
+// LocalParametersReference identifies an API object in the namespace of the Gateway.
+type LocalParametersReference struct {
+	Group string `json:"group"`
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+}
+
+// ProtocolType defines the application protocol accepted by a Listener.
+type ProtocolType string
+
+const (
+	// HTTPProtocolType accepts cleartext HTTP/1.1 sessions over TCP.
+	HTTPProtocolType ProtocolType = "HTTP"
+	// HTTPSProtocolType accepts HTTP/1.1 or HTTP/2 sessions over TLS.
+	HTTPSProtocolType ProtocolType = "HTTPS"
+	// TLSProtocolType accepts TLS sessions over TCP.
+	TLSProtocolType ProtocolType = "TLS"
+	// TCPProtocolType accepts a TCP stream.
+	TCPProtocolType ProtocolType = "TCP"
+	// UDPProtocolType accepts a UDP packet.
+	UDPProtocolType ProtocolType = "UDP"
+)
+
+// Listener embodies the concept of a logical endpoint where a Gateway accepts
+// network connections.
+type Listener struct {
+	Name     string       `json:"name"`
+	Hostname *string      `json:"hostname,omitempty"`
+	Port     int32        `json:"port"`
+	Protocol ProtocolType `json:"protocol"`
+}
+
+// AddressType defines how a network address is represented as a text string.
+type AddressType string
+
+const (
+	// IPAddressType is a textual representation of a numeric IP address.
+	IPAddressType AddressType = "IPAddress"
+	// HostnameAddressType represents a DNS based ingress point.
+	HostnameAddressType AddressType = "Hostname"
+)
+
+// GatewayAddress describes an address that can be bound to a Gateway.
+type GatewayAddress struct {
+	Type  *AddressType `json:"type,omitempty"`
+	Value string       `json:"value"`
+}
+
+// GatewayStatus defines the observed state of Gateway.
+type GatewayStatus struct {
+	Addresses  []GatewayAddress `json:"addresses,omitempty"`
+	Conditions []Condition      `json:"conditions,omitempty"`
+	Listeners  []ListenerStatus `json:"listeners,omitempty"`
+}
+
+// ListenerStatus is the status associated with a Listener.
+type ListenerStatus struct {
+	Name           string           `json:"name"`
+	SupportedKinds []RouteGroupKind `json:"supportedKinds"`
+	AttachedRoutes int32            `json:"attachedRoutes"`
+	Conditions     []Condition      `json:"conditions"`
+}
+
+// RouteGroupKind indicates the group and kind of a Route resource.
+type RouteGroupKind struct {
+	Group *string `json:"group,omitempty"`
+	Kind  string  `json:"kind"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GatewayList contains a list of Gateways.
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Gateway `json:"items"`
+}
+
+// ParentReference identifies an API object (usually a Gateway) that can be
+// considered a parent of a Route.
+type ParentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+// BackendRef defines how a Route should forward a request to a Kubernetes resource.
+type BackendRef struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+	Weight    *int32  `json:"weight,omitempty"`
+}
+
+// ConditionStatus is the status of a condition, the vendored apimachinery
+// predates metav1.Condition
+type ConditionStatus string
+
+const (
+	// ConditionTrue means the condition is satisfied
+	ConditionTrue ConditionStatus = "True"
+	// ConditionFalse means the condition is not satisfied
+	ConditionFalse ConditionStatus = "False"
+	// ConditionUnknown means the controller can not decide
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// Condition contains details for one aspect of the current state of an API Resource.
+type Condition struct {
+	Type               string          `json:"type"`
+	Status             ConditionStatus `json:"status"`
+	ObservedGeneration int64           `json:"observedGeneration,omitempty"`
+	LastTransitionTime metav1.Time     `json:"lastTransitionTime"`
+	Reason             string          `json:"reason"`
+	Message            string          `json:"message"`
+}
+
+// Condition types and reasons used by the controller
+const (
+	ConditionAccepted     = "Accepted"
+	ConditionProgrammed   = "Programmed"
+	ConditionResolvedRefs = "ResolvedRefs"
+	ConditionConflicted   = "Conflicted"
+
+	ReasonAccepted            = "Accepted"
+	ReasonProgrammed          = "Programmed"
+	ReasonPending             = "Pending"
+	ReasonInvalid             = "Invalid"
+	ReasonInvalidParameters   = "InvalidParameters"
+	ReasonResolvedRefs        = "ResolvedRefs"
+	ReasonNoConflicts         = "NoConflicts"
+	ReasonPortUnavailable     = "PortUnavailable"
+	ReasonProtocolConflict    = "ProtocolConflict"
+	ReasonUnsupportedProtocol = "UnsupportedProtocol"
+	ReasonBackendNotFound     = "BackendNotFound"
+	ReasonInvalidRouteKinds   = "InvalidRouteKinds"
+)
+
+// SetCondition adds or updates the condition in conditions, LastTransitionTime
+// is only changed when the status changes
+func SetCondition(conditions []Condition, condition Condition) []Condition {
+	for i := range conditions {
+		if conditions[i].Type != condition.Type {
+			continue
+		}
+		if conditions[i].Status == condition.Status {
+			condition.LastTransitionTime = conditions[i].LastTransitionTime
+		}
+		conditions[i] = condition
+		return conditions
+	}
+	return append(conditions, condition)
+}
+
+// FindCondition returns the condition with the type in conditions, or nil
+func FindCondition(conditions []Condition, conditionType string) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
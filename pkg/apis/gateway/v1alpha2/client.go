@@ -0,0 +1,63 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"path"
+
+	gatewayv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1"
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// Client reads TCPRoutes and UDPRoutes through a rest client with json
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func routesPath(namespace, resource string) string {
+	if namespace == metav1.NamespaceAll {
+		return path.Join("/apis", gatewayv1.GroupName, Version, resource)
+	}
+	return path.Join("/apis", gatewayv1.GroupName, Version, "namespaces", namespace, resource)
+}
+
+// ListTCPRoutes lists TCPRoutes in the namespace, NamespaceAll means all namespaces
+func (c *Client) ListTCPRoutes(namespace string, opts metav1.ListOptions) (*TCPRouteList, error) {
+	list := &TCPRouteList{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(routesPath(namespace, "tcproutes")).
+		VersionedParams(&opts, scheme.ParameterCodec), list)
+	return list, err
+}
+
+// ListUDPRoutes lists UDPRoutes in the namespace, NamespaceAll means all namespaces
+func (c *Client) ListUDPRoutes(namespace string, opts metav1.ListOptions) (*UDPRouteList, error) {
+	list := &UDPRouteList{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(routesPath(namespace, "udproutes")).
+		VersionedParams(&opts, scheme.ParameterCodec), list)
+	return list, err
+}
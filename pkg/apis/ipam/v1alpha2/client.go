@@ -0,0 +1,69 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"path"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	"k8s.io/client-go/rest"
+)
+
+// Client reads and writes VIPPools through a rest client with json
+// +k8s:deepcopy-gen=false
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used,
+// such as clientset.LoadbalanceV1alpha2().RESTClient()
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func poolsPath(elem ...string) string {
+	return path.Join(append([]string{"/apis", GroupName, Version, Plural}, elem...)...)
+}
+
+// ListVIPPools lists all VIPPools
+func (c *Client) ListVIPPools() (*VIPPoolList, error) {
+	list := &VIPPoolList{}
+	err := restjson.Do(c.client.Get().AbsPath(poolsPath()), list)
+	return list, err
+}
+
+// GetVIPPool gets the VIPPool
+func (c *Client) GetVIPPool(name string) (*VIPPool, error) {
+	pool := &VIPPool{}
+	err := restjson.Do(c.client.Get().AbsPath(poolsPath(name)), pool)
+	return pool, err
+}
+
+// UpdateVIPPoolStatus updates the status of VIPPool, status is a subresource
+// so spec changed by user is not overwritten
+func (c *Client) UpdateVIPPoolStatus(pool *VIPPool) (*VIPPool, error) {
+	pool.APIVersion = GroupName + "/" + Version
+	pool.Kind = Kind
+	req, err := restjson.Body(c.client.Put().AbsPath(poolsPath(pool.Name, "status")), pool)
+	if err != nil {
+		return nil, err
+	}
+	result := &VIPPool{}
+	err = restjson.Do(req, result)
+	return result, err
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// GroupName is the group name of VIPPool
+	GroupName = lbapi.GroupName
+	// Version is the version of the API
+	Version = "v1alpha2"
+	// Plural is the resource name of VIPPool
+	Plural = "vippools"
+	// Kind is the kind of VIPPool
+	Kind = "VIPPool"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VIPPool is a cluster-scoped range of vips. LoadBalancers referencing the pool
+// get vips allocated automatically, and vips set by hand in the range are
+// recorded too, so that a vip can't be used by two LoadBalancers.
+type VIPPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VIPPoolSpec   `json:"spec,omitempty"`
+	Status VIPPoolStatus `json:"status,omitempty"`
+}
+
+// VIPPoolSpec is the address range of a pool
+type VIPPoolSpec struct {
+	// CIDRs are the address ranges of vips, the network and broadcast
+	// addresses of ipv4 ranges are never allocated
+	CIDRs []string `json:"cidrs"`
+	// Exclude contains the addresses or CIDRs which are never allocated
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+	// Namespaces are the namespaces of LoadBalancers which can use the pool,
+	// empty means all namespaces
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// VIPPoolStatus records the vips in use
+type VIPPoolStatus struct {
+	// Allocations are the vips used by LoadBalancers
+	Allocations []VIPAllocation `json:"allocations,omitempty"`
+	// Allocated is the number of allocations
+	Allocated int32 `json:"allocated"`
+	// Message is the error of spec, such as an invalid CIDR
+	Message string `json:"message,omitempty"`
+}
+
+// VIPAllocation is a vip used by a LoadBalancer
+type VIPAllocation struct {
+	IP        string    `json:"ip"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	// Manual means the vip is set by hand instead of allocated by pool
+	// +optional
+	Manual bool `json:"manual,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// VIPPoolList contains a list of VIPPool
+type VIPPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []VIPPool `json:"items"`
+}
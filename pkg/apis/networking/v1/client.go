@@ -0,0 +1,126 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"path"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// Client reads and writes networking.k8s.io/v1 Ingresses and IngressClasses
+// through a rest client with json
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used,
+// such as clientset.NetworkingV1().RESTClient()
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func groupPath(elem ...string) string {
+	return path.Join(append([]string{"/apis", GroupName, Version}, elem...)...)
+}
+
+func ingressesPath(namespace string, elem ...string) string {
+	if namespace == metav1.NamespaceAll {
+		return groupPath(append([]string{"ingresses"}, elem...)...)
+	}
+	return groupPath(append([]string{"namespaces", namespace, "ingresses"}, elem...)...)
+}
+
+func ingressClassesPath(elem ...string) string {
+	return groupPath(append([]string{"ingressclasses"}, elem...)...)
+}
+
+// ListIngresses lists Ingresses in the namespace, NamespaceAll means all namespaces
+func (c *Client) ListIngresses(namespace string, opts metav1.ListOptions) (*IngressList, error) {
+	list := &IngressList{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(ingressesPath(namespace)).
+		VersionedParams(&opts, scheme.ParameterCodec), list)
+	return list, err
+}
+
+// PatchIngress patches the Ingress
+func (c *Client) PatchIngress(namespace, name string, pt types.PatchType, data []byte) (*Ingress, error) {
+	ing := &Ingress{}
+	err := restjson.Do(c.client.Patch(pt).
+		AbsPath(ingressesPath(namespace, name)).
+		Body(data), ing)
+	return ing, err
+}
+
+// DeleteIngress deletes the Ingress
+func (c *Client) DeleteIngress(namespace, name string) error {
+	return c.client.Delete().
+		AbsPath(ingressesPath(namespace, name)).
+		Do().Error()
+}
+
+// GetIngressClass gets the IngressClass
+func (c *Client) GetIngressClass(name string) (*IngressClass, error) {
+	class := &IngressClass{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(ingressClassesPath(name)), class)
+	return class, err
+}
+
+// ListIngressClasses lists IngressClasses
+func (c *Client) ListIngressClasses(opts metav1.ListOptions) (*IngressClassList, error) {
+	list := &IngressClassList{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(ingressClassesPath()).
+		VersionedParams(&opts, scheme.ParameterCodec), list)
+	return list, err
+}
+
+// CreateIngressClass creates the IngressClass
+func (c *Client) CreateIngressClass(class *IngressClass) (*IngressClass, error) {
+	return c.writeIngressClass(c.client.Post().AbsPath(ingressClassesPath()), class)
+}
+
+// UpdateIngressClass updates the IngressClass
+func (c *Client) UpdateIngressClass(class *IngressClass) (*IngressClass, error) {
+	return c.writeIngressClass(c.client.Put().AbsPath(ingressClassesPath(class.Name)), class)
+}
+
+func (c *Client) writeIngressClass(req *rest.Request, class *IngressClass) (*IngressClass, error) {
+	class.APIVersion = GroupName + "/" + Version
+	class.Kind = "IngressClass"
+	req, err := restjson.Body(req, class)
+	if err != nil {
+		return nil, err
+	}
+	result := &IngressClass{}
+	err = restjson.Do(req, result)
+	return result, err
+}
+
+// DeleteIngressClass deletes the IngressClass
+func (c *Client) DeleteIngressClass(name string) error {
+	return c.client.Delete().
+		AbsPath(ingressClassesPath(name)).
+		Do().Error()
+}
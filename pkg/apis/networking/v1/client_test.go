@@ -0,0 +1,72 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func TestListIngresses(t *testing.T) {
+	className := "default.lb"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/apis/networking.k8s.io/v1/ingresses" {
+			t.Errorf("unexpected path %v", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("labelSelector"); got != "a=b" {
+			t.Errorf("labelSelector = %v, want a=b", got)
+		}
+		json.NewEncoder(w).Encode(&IngressList{
+			Items: []Ingress{
+				{ObjectMeta: metav1.ObjectMeta{Name: "spec"}, Spec: IngressSpec{IngressClassName: &className}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "annotation", Annotations: map[string]string{AnnotationIngressClass: className}}},
+			},
+		})
+	}))
+	defer server.Close()
+
+	restClient, err := rest.RESTClientFor(&rest.Config{
+		Host: server.URL,
+		ContentConfig: rest.ContentConfig{
+			GroupVersion:         &schema.GroupVersion{Group: GroupName, Version: Version},
+			NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := New(restClient).ListIngresses(metav1.NamespaceAll, metav1.ListOptions{LabelSelector: "a=b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 2 {
+		t.Fatalf("got %d ingresses, want 2", len(list.Items))
+	}
+	for _, ing := range list.Items {
+		if ing.ClassName() != className {
+			t.Errorf("ingress %v class = %v, want %v", ing.Name, ing.ClassName(), className)
+		}
+	}
+}
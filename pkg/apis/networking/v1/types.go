@@ -0,0 +1,108 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1 contains the networking.k8s.io/v1 Ingress and IngressClass types
+// used by the controller. The vendored k8s.io/api predates them, so only the
+// fields the controller reads or writes are defined here. Ingresses must be
+// modified with patches to avoid dropping the fields not defined.
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GroupName is the group name of networking.k8s.io
+	GroupName = "networking.k8s.io"
+	// Version is the version of the API
+	Version = "v1"
+
+	// AnnotationIsDefaultIngressClass marks an IngressClass as the default class of cluster
+	AnnotationIsDefaultIngressClass = "ingressclass.kubernetes.io/is-default-class"
+	// AnnotationIngressClass is the deprecated annotation to specify the class of an Ingress
+	AnnotationIngressClass = "kubernetes.io/ingress.class"
+
+	// IngressClassParametersReferenceScopeNamespace means the parameters resource is namespace-scoped
+	IngressClassParametersReferenceScopeNamespace = "Namespace"
+	// IngressClassParametersReferenceScopeCluster means the parameters resource is cluster-scoped
+	IngressClassParametersReferenceScopeCluster = "Cluster"
+)
+
+// Ingress is a collection of rules that allow inbound connections to reach the endpoints
+// defined by a backend.
+type Ingress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IngressSpec `json:"spec,omitempty"`
+}
+
+// IngressSpec describes the Ingress the user wishes to exist.
+type IngressSpec struct {
+	// IngressClassName is the name of the IngressClass cluster resource.
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+}
+
+// IngressList is a collection of Ingress.
+type IngressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Ingress `json:"items"`
+}
+
+// ClassName returns the class of the Ingress, either from spec or from the
+// deprecated annotation
+func (ing *Ingress) ClassName() string {
+	if ing.Spec.IngressClassName != nil {
+		return *ing.Spec.IngressClassName
+	}
+	return ing.Annotations[AnnotationIngressClass]
+}
+
+// IngressClass represents the class of the Ingress, referenced by the Ingress Spec.
+type IngressClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IngressClassSpec `json:"spec,omitempty"`
+}
+
+// IngressClassSpec provides information about the class of an Ingress.
+type IngressClassSpec struct {
+	// Controller refers to the name of the controller that should handle this class.
+	Controller string `json:"controller,omitempty"`
+	// Parameters is a link to a custom resource containing additional
+	// configuration for the controller.
+	Parameters *IngressClassParametersReference `json:"parameters,omitempty"`
+}
+
+// IngressClassParametersReference identifies an API object.
+type IngressClassParametersReference struct {
+	APIGroup  *string `json:"apiGroup,omitempty"`
+	Kind      string  `json:"kind"`
+	Name      string  `json:"name"`
+	Scope     *string `json:"scope,omitempty"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// IngressClassList is a collection of IngressClasses.
+type IngressClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []IngressClass `json:"items"`
+}
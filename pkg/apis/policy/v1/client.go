@@ -0,0 +1,94 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1 accesses policy/v1 PodDisruptionBudgets. The vendored clientset
+// only has policy/v1beta1 which is removed since kubernetes 1.25, the schema
+// of v1beta1 is the same as v1, so its types are reused here.
+package v1
+
+import (
+	"path"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// GroupName is the group name of policy
+	GroupName = "policy"
+	// Version is the version of the API
+	Version = "v1"
+)
+
+// PodDisruptionBudget is a policy/v1 PodDisruptionBudget
+type PodDisruptionBudget = policyv1beta1.PodDisruptionBudget
+
+// PodDisruptionBudgetSpec is the spec of a PodDisruptionBudget
+type PodDisruptionBudgetSpec = policyv1beta1.PodDisruptionBudgetSpec
+
+// Client reads and writes policy/v1 PodDisruptionBudgets through a rest client with json
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used,
+// such as clientset.PolicyV1beta1().RESTClient()
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func pdbsPath(namespace string, elem ...string) string {
+	return path.Join(append([]string{"/apis", GroupName, Version, "namespaces", namespace, "poddisruptionbudgets"}, elem...)...)
+}
+
+// GetPodDisruptionBudget gets the PodDisruptionBudget
+func (c *Client) GetPodDisruptionBudget(namespace, name string) (*PodDisruptionBudget, error) {
+	pdb := &PodDisruptionBudget{}
+	err := restjson.Do(c.client.Get().
+		AbsPath(pdbsPath(namespace, name)), pdb)
+	return pdb, err
+}
+
+// CreatePodDisruptionBudget creates the PodDisruptionBudget
+func (c *Client) CreatePodDisruptionBudget(pdb *PodDisruptionBudget) (*PodDisruptionBudget, error) {
+	return c.write(c.client.Post().AbsPath(pdbsPath(pdb.Namespace)), pdb)
+}
+
+// UpdatePodDisruptionBudget updates the PodDisruptionBudget
+func (c *Client) UpdatePodDisruptionBudget(pdb *PodDisruptionBudget) (*PodDisruptionBudget, error) {
+	return c.write(c.client.Put().AbsPath(pdbsPath(pdb.Namespace, pdb.Name)), pdb)
+}
+
+func (c *Client) write(req *rest.Request, pdb *PodDisruptionBudget) (*PodDisruptionBudget, error) {
+	pdb.APIVersion = GroupName + "/" + Version
+	pdb.Kind = "PodDisruptionBudget"
+	req, err := restjson.Body(req, pdb)
+	if err != nil {
+		return nil, err
+	}
+	result := &PodDisruptionBudget{}
+	err = restjson.Do(req, result)
+	return result, err
+}
+
+// DeletePodDisruptionBudget deletes the PodDisruptionBudget
+func (c *Client) DeletePodDisruptionBudget(namespace, name string) error {
+	return c.client.Delete().
+		AbsPath(pdbsPath(namespace, name)).
+		Do().Error()
+}
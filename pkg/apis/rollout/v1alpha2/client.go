@@ -0,0 +1,83 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"path"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/restjson"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
+
+// Client reads and writes ImageRollouts through a rest client with json
+// +k8s:deepcopy-gen=false
+type Client struct {
+	client rest.Interface
+}
+
+// New returns a Client, any rest client of the kubernetes clientset can be used,
+// such as clientset.LoadbalanceV1alpha2().RESTClient()
+func New(client rest.Interface) *Client {
+	return &Client{client: client}
+}
+
+func rolloutsPath(elem ...string) string {
+	return path.Join(append([]string{"/apis", GroupName, Version, Plural}, elem...)...)
+}
+
+// GetImageRollout gets the ImageRollout
+func (c *Client) GetImageRollout(name string) (*ImageRollout, error) {
+	rollout := &ImageRollout{}
+	err := restjson.Do(c.client.Get().AbsPath(rolloutsPath(name)), rollout)
+	return rollout, err
+}
+
+// CreateImageRollout creates the ImageRollout
+func (c *Client) CreateImageRollout(rollout *ImageRollout) (*ImageRollout, error) {
+	rollout.APIVersion = GroupName + "/" + Version
+	rollout.Kind = Kind
+	req, err := restjson.Body(c.client.Post().AbsPath(rolloutsPath()), rollout)
+	if err != nil {
+		return nil, err
+	}
+	result := &ImageRollout{}
+	err = restjson.Do(req, result)
+	return result, err
+}
+
+// UpdateImageRolloutStatus updates the status of ImageRollout, status is a subresource
+// so spec changed by user is not overwritten
+func (c *Client) UpdateImageRolloutStatus(rollout *ImageRollout) (*ImageRollout, error) {
+	rollout.APIVersion = GroupName + "/" + Version
+	rollout.Kind = Kind
+	req, err := restjson.Body(c.client.Put().AbsPath(rolloutsPath(rollout.Name, "status")), rollout)
+	if err != nil {
+		return nil, err
+	}
+	result := &ImageRollout{}
+	err = restjson.Do(req, result)
+	return result, err
+}
+
+// PatchImageRollout patches the ImageRollout
+func (c *Client) PatchImageRollout(name string, pt types.PatchType, data []byte) (*ImageRollout, error) {
+	result := &ImageRollout{}
+	err := restjson.Do(c.client.Patch(pt).AbsPath(rolloutsPath(name)).Body(data), result)
+	return result, err
+}
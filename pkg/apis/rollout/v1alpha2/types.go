@@ -0,0 +1,120 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GroupName is the group name of ImageRollout
+	GroupName = lbapi.GroupName
+	// Version is the version of the API
+	Version = "v1alpha2"
+	// Plural is the resource name of ImageRollout
+	Plural = "imagerollouts"
+	// Kind is the kind of ImageRollout
+	Kind = "ImageRollout"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImageRollout upgrades the image of a proxy or provider in LoadBalancers wave by
+// wave, when the image set by controller flags changes. It is cluster-scoped and
+// named after the proxy or provider, such as nginx and ipvsdr.
+type ImageRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageRolloutSpec   `json:"spec,omitempty"`
+	Status ImageRolloutStatus `json:"status,omitempty"`
+}
+
+// ImageRolloutSpec is the user settings of a rollout
+type ImageRolloutSpec struct {
+	// Paused stops starting new waves
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+	// WaveSize is the max number of LoadBalancers upgraded in a wave, default is 1
+	// +optional
+	WaveSize int32 `json:"waveSize,omitempty"`
+	// SoakSeconds is how long all LoadBalancers in a wave must stay ready
+	// before the next wave starts, default is 300
+	// +optional
+	SoakSeconds int32 `json:"soakSeconds,omitempty"`
+	// ProgressDeadlineSeconds is how long a LoadBalancer in a wave can take to be
+	// ready, the rollout is halted when it is exceeded, default is 600
+	// +optional
+	ProgressDeadlineSeconds int32 `json:"progressDeadlineSeconds,omitempty"`
+	// OrderLabel is a label of LoadBalancers, LoadBalancers with the same priority
+	// are upgraded in the order of its values
+	// +optional
+	OrderLabel string `json:"orderLabel,omitempty"`
+	// Attempt resumes a halted rollout when it is changed
+	// +optional
+	Attempt int32 `json:"attempt,omitempty"`
+}
+
+// ImageRolloutPhase is the phase of a rollout
+type ImageRolloutPhase string
+
+const (
+	// ImageRolloutProgressing means LoadBalancers in current wave are upgrading
+	ImageRolloutProgressing ImageRolloutPhase = "Progressing"
+	// ImageRolloutSoaking means LoadBalancers in current wave are ready and soaking
+	ImageRolloutSoaking ImageRolloutPhase = "Soaking"
+	// ImageRolloutPaused means the rollout is paused by user
+	ImageRolloutPaused ImageRolloutPhase = "Paused"
+	// ImageRolloutHalted means a LoadBalancer failed readiness, change spec.attempt to resume
+	ImageRolloutHalted ImageRolloutPhase = "Halted"
+	// ImageRolloutCompleted means all LoadBalancers are upgraded
+	ImageRolloutCompleted ImageRolloutPhase = "Completed"
+)
+
+// ImageRolloutStatus is the progress of a rollout
+type ImageRolloutStatus struct {
+	// Image is the image which LoadBalancers are upgraded to
+	Image   string            `json:"image,omitempty"`
+	Phase   ImageRolloutPhase `json:"phase,omitempty"`
+	Message string            `json:"message,omitempty"`
+	// Total is the number of LoadBalancers in the rollout
+	Total int32 `json:"total"`
+	// Upgraded is the number of LoadBalancers which have been granted the image
+	Upgraded int32 `json:"upgraded"`
+	// Wave is the sequence number of current wave, starting from 1
+	Wave int32 `json:"wave"`
+	// CurrentWave contains the namespace/name of LoadBalancers in current wave
+	CurrentWave []string `json:"currentWave,omitempty"`
+	// WaveStartTime is when current wave started
+	WaveStartTime *metav1.Time `json:"waveStartTime,omitempty"`
+	// SoakStartTime is when all LoadBalancers in current wave became ready
+	SoakStartTime *metav1.Time `json:"soakStartTime,omitempty"`
+	// HaltedAttempt is the spec.attempt when the rollout was halted
+	HaltedAttempt int32 `json:"haltedAttempt,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ImageRolloutList contains a list of ImageRollout
+type ImageRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ImageRollout `json:"items"`
+}
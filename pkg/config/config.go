@@ -18,6 +18,7 @@ package config
 
 import (
 	"strings"
+	"time"
 
 	"github.com/caicloud/clientset/kubernetes"
 	"github.com/caicloud/loadbalancer-controller/pkg/toleration"
@@ -25,11 +26,22 @@ import (
 )
 
 const (
-	defaultIpvsdrImage             = "cargo.caicloud.io/caicloud/loadbalancer-provider-ipvsdr:v0.3.2"
+	defaultIpvsdrImage             = "cargo.caicloud.io/caicloud/loadbalancer-provider-ipvsdr:v0.5.0"
 	defaultAzureProviderImage      = "cargo.caicloud.io/caicloud/loadbalancer-provider-azure:v0.3.2"
+	defaultAliyunSLBEndpoint       = "https://slb.aliyuncs.com"
+	defaultAliyunResyncPeriod      = time.Minute
 	defaultNginxIngressImage       = "cargo.caicloud.io/caicloud/nginx-ingress-controller:0.12.0"
 	defaultIngressSidecarImage     = "cargo.caicloud.io/caicloud/loadbalancer-provider-ingress:v0.3.2"
 	defaultIngressAnnotationPrefix = "ingress.kubernetes.io"
+	defaultGatewayClass            = "caicloud-loadbalancer"
+	defaultGatewayResyncPeriod     = 30 * time.Second
+	defaultRolloutResyncPeriod     = 15 * time.Second
+	defaultVRIDSegmentPrefix       = 24
+	defaultIPAMResyncPeriod        = 10 * time.Second
+	defaultAWSResyncPeriod         = 30 * time.Second
+	defaultOpenStackResyncPeriod   = 30 * time.Second
+	defaultOpenStackActiveTimeout  = 2 * time.Minute
+	defaultF5ResyncPeriod          = time.Minute
 )
 
 type additionalTolerations []string
@@ -57,8 +69,14 @@ func (a *additionalTolerations) Type() string {
 type Configuration struct {
 	Client                kubernetes.Interface
 	AdditionalTolerations additionalTolerations
-	Proxies               Proxies
-	Providers             Providers
+	// AllowedImages are the image patterns which LoadBalancers can use to override
+	// the images of proxies and providers
+	AllowedImages []string
+	Proxies       Proxies
+	Providers     Providers
+	Gateway       Gateway
+	Rollout       Rollout
+	IPAM          IPAM
 }
 
 // Proxies contains all cli flags of proxies
@@ -77,8 +95,13 @@ type ProxyNginx struct {
 
 // Providers contains all cli flags of providers
 type Providers struct {
-	Ipvsdr ProviderIpvsdr
-	Azure  ProviderAzure
+	Ipvsdr    ProviderIpvsdr
+	Azure     ProviderAzure
+	BGP       ProviderBGP
+	Aliyun    ProviderAliyun
+	AWS       ProviderAWS
+	OpenStack ProviderOpenStack
+	F5        ProviderF5
 }
 
 // ProviderIpvsdr contains all cli flags of ipvsdr providers
@@ -86,6 +109,18 @@ type ProviderIpvsdr struct {
 	Image            string
 	NodeIPLabel      string
 	NodeIPAnnotation string
+	// NodeIPv6Label and NodeIPv6Annotation store the ipv6 address of node
+	// if it is not in NodeIPLabel and NodeIPAnnotation
+	NodeIPv6Label      string
+	NodeIPv6Annotation string
+	// VRIDSegmentPrefix is the prefix length of ipv4 network segments, vrids are unique in a segment
+	VRIDSegmentPrefix int
+}
+
+// ProviderBGP contains all cli flags of bgp providers
+type ProviderBGP struct {
+	// Image is the speaker following the contract in docs/images.md, there is no default one
+	Image string
 }
 
 // ProviderAzure contains all cli flags of azure providers
@@ -93,11 +128,69 @@ type ProviderAzure struct {
 	Image string
 }
 
+// ProviderAliyun contains all cli flags of aliyun providers
+type ProviderAliyun struct {
+	// Image is the provider following the contract in docs/images.md, there is no default one
+	Image string
+	// SLBEndpoint is the endpoint of aliyun SLB OpenAPI
+	SLBEndpoint string
+	// ResyncPeriod is the interval to retry the SLBs not running and correct their drift
+	ResyncPeriod time.Duration
+}
+
+// ProviderAWS contains all cli flags of aws providers
+type ProviderAWS struct {
+	// ELBEndpoint is the endpoint of ELBv2 API, empty means the endpoint of region
+	ELBEndpoint string
+	// ResyncPeriod is the interval to check the NLBs being provisioned
+	ResyncPeriod time.Duration
+}
+
+// ProviderOpenStack contains all cli flags of openstack providers
+type ProviderOpenStack struct {
+	// ResyncPeriod is the interval to check the load balancers not running
+	ResyncPeriod time.Duration
+	// ActiveTimeout is the max time to wait for a load balancer to be active after changes
+	ActiveTimeout time.Duration
+}
+
+// ProviderF5 contains all cli flags of f5 providers
+type ProviderF5 struct {
+	// ResyncPeriod is the interval to correct the drift of virtual servers
+	ResyncPeriod time.Duration
+}
+
+// Gateway contains all cli flags of Gateway API support
+type Gateway struct {
+	// Enabled means Gateways of the GatewayClass are mapped to LoadBalancers
+	Enabled bool
+	// ClassName is the name of GatewayClass registered by controller, empty means disabled
+	ClassName string
+	// ResyncPeriod is the interval to list Gateways, Gateway API has no informer in clientset
+	ResyncPeriod time.Duration
+}
+
+// Rollout contains all cli flags of staged image rollout
+type Rollout struct {
+	// Enabled means LoadBalancers are upgraded in waves when images change
+	Enabled bool
+	// ResyncPeriod is the interval to move rollouts forward
+	ResyncPeriod time.Duration
+}
+
+// IPAM contains all cli flags of vip allocation
+type IPAM struct {
+	// ResyncPeriod is the interval to allocate vips from VIPPools
+	ResyncPeriod time.Duration
+}
+
 // AddFlags add flags to app
 func (c *Configuration) AddFlags(fs *pflag.FlagSet) {
 
 	fs.Var(&c.AdditionalTolerations, "additional-tolerations", "A comma separated list of k8s `TolerationKeys`")
 
+	fs.StringSliceVar(&c.AllowedImages, "allowed-images", nil, "A comma separated list of image `Patterns` which LoadBalancers can use, a pattern without tag permits all tags of the repository")
+
 	fs.StringVar(&c.Proxies.Sidecar, "proxy-sidecar", defaultIngressSidecarImage, "`Image` of ingress controller sidecar")
 
 	fs.StringVar(&c.Proxies.Nginx.Image, "proxy-nginx", defaultNginxIngressImage, "`Image` of nginx ingress controller image")
@@ -109,7 +202,33 @@ func (c *Configuration) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.Providers.Ipvsdr.Image, "provider-ipvsdr", defaultIpvsdrImage, "`Image` of ipvsdr provider")
 	fs.StringVar(&c.Providers.Ipvsdr.NodeIPLabel, "nodeip-label", "", "tell provider which label of node stores node ip")
 	fs.StringVar(&c.Providers.Ipvsdr.NodeIPAnnotation, "nodeip-annotation", "", "tell provider which annotation of node stores node ip")
+	fs.StringVar(&c.Providers.Ipvsdr.NodeIPv6Label, "nodeipv6-label", "", "tell provider which label of node stores node ipv6 address")
+	fs.StringVar(&c.Providers.Ipvsdr.NodeIPv6Annotation, "nodeipv6-annotation", "", "tell provider which annotation of node stores node ipv6 address")
+	fs.IntVar(&c.Providers.Ipvsdr.VRIDSegmentPrefix, "ipvsdr-vrid-segment-prefix", defaultVRIDSegmentPrefix, "Prefix `Length` of ipv4 network segments of vips, vrids of ipvsdr are unique in a segment, ipv6 segments are /64")
 
 	fs.StringVar(&c.Providers.Azure.Image, "provider-azure", defaultAzureProviderImage, "`Image` of azure provider")
 
+	fs.StringVar(&c.Providers.Aliyun.Image, "provider-aliyun", "", "`Image` of aliyun provider, it is required by LoadBalancers using aliyun provider without an image in spec")
+	fs.StringVar(&c.Providers.Aliyun.SLBEndpoint, "aliyun-slb-endpoint", defaultAliyunSLBEndpoint, "`URL` of aliyun SLB OpenAPI")
+	fs.DurationVar(&c.Providers.Aliyun.ResyncPeriod, "aliyun-resync-period", defaultAliyunResyncPeriod, "Interval to retry the aliyun SLBs not running and correct their drift")
+
+	fs.StringVar(&c.Providers.AWS.ELBEndpoint, "aws-elb-endpoint", "", "`URL` of aws ELBv2 API, default is the endpoint of region")
+	fs.DurationVar(&c.Providers.AWS.ResyncPeriod, "aws-resync-period", defaultAWSResyncPeriod, "Interval to check the aws NLBs being provisioned")
+
+	fs.DurationVar(&c.Providers.OpenStack.ResyncPeriod, "openstack-resync-period", defaultOpenStackResyncPeriod, "Interval to check the openstack load balancers not running")
+	fs.DurationVar(&c.Providers.OpenStack.ActiveTimeout, "openstack-active-timeout", defaultOpenStackActiveTimeout, "Max time to wait for an openstack load balancer to be active after changes")
+
+	fs.DurationVar(&c.Providers.F5.ResyncPeriod, "f5-resync-period", defaultF5ResyncPeriod, "Interval to correct the drift of virtual servers on f5 BIG-IPs")
+
+	fs.StringVar(&c.Providers.BGP.Image, "provider-bgp", "", "`Image` of bgp speaker, it is required by LoadBalancers using bgp provider without an image in spec")
+
+	fs.BoolVar(&c.Gateway.Enabled, "gateway", false, "Map Gateways of the GatewayClass to LoadBalancers, Gateway API support is disabled by default")
+	fs.StringVar(&c.Gateway.ClassName, "gateway-class", defaultGatewayClass, "`Name` of GatewayClass registered by controller, set empty to disable Gateway API support")
+	fs.DurationVar(&c.Gateway.ResyncPeriod, "gateway-resync-period", defaultGatewayResyncPeriod, "Interval to resync Gateways")
+
+	fs.BoolVar(&c.Rollout.Enabled, "image-rollout", false, "Upgrade LoadBalancers in waves when images of nginx proxy or ipvsdr provider change")
+	fs.DurationVar(&c.Rollout.ResyncPeriod, "image-rollout-resync-period", defaultRolloutResyncPeriod, "Interval to move image rollouts forward")
+
+	fs.DurationVar(&c.IPAM.ResyncPeriod, "vip-pool-resync-period", defaultIPAMResyncPeriod, "Interval to allocate vips from VIPPools")
+
 }
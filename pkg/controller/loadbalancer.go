@@ -27,10 +27,15 @@ import (
 	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
 	"github.com/caicloud/clientset/util/syncqueue"
 	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	"github.com/caicloud/loadbalancer-controller/pkg/gateway"
+	"github.com/caicloud/loadbalancer-controller/pkg/ipam"
 	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
 	"github.com/caicloud/loadbalancer-controller/pkg/provider"
 	"github.com/caicloud/loadbalancer-controller/pkg/proxy"
+	"github.com/caicloud/loadbalancer-controller/pkg/rollout"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
 
+	v1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -42,13 +47,16 @@ import (
 // LoadBalancerController is responsible for synchronizing LoadBalancer objects stored
 // in the system with actual running proxies and providers.
 type LoadBalancerController struct {
-	client    kubernetes.Interface
-	factory   informers.SharedInformerFactory
-	lbLister  lblisters.LoadBalancerLister
-	nodeCtl   *nodeController
-	queue     *syncqueue.SyncQueue
-	proxies   *plugin.Registry
-	providers *plugin.Registry
+	client     kubernetes.Interface
+	factory    informers.SharedInformerFactory
+	lbLister   lblisters.LoadBalancerLister
+	nodeCtl    *nodeController
+	gatewayCtl *gateway.Controller
+	rolloutCtl *rollout.Controller
+	ipamCtl    *ipam.Controller
+	queue      *syncqueue.SyncQueue
+	proxies    *plugin.Registry
+	providers  *plugin.Registry
 }
 
 // NewLoadBalancerController creates a new LoadBalancerController.
@@ -80,6 +88,11 @@ func NewLoadBalancerController(cfg config.Configuration) *LoadBalancerController
 		DeleteFunc: lbc.deleteLoadBalancer,
 	})
 
+	// setup gateway api support
+	lbc.gatewayCtl = gateway.NewController(cfg, factory)
+	lbc.rolloutCtl = rollout.NewController(cfg, factory)
+	lbc.ipamCtl = ipam.NewController(cfg, factory)
+
 	// setup proxies
 	lbc.proxies.InitAll(cfg, factory)
 	// setup providers
@@ -117,6 +130,13 @@ func (lbc *LoadBalancerController) Run(workers int, stopCh <-chan struct{}) {
 		lbc.queue.ShutDown()
 	}()
 
+	// run vip allocation before syncing, vips are validated against pools
+	lbc.ipamCtl.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, lbc.ipamCtl.HasSynced) {
+		log.Error("Wait for VIPPools synced error")
+		return
+	}
+
 	// start loadbalancer worker
 	lbc.queue.Run(workers)
 
@@ -124,6 +144,10 @@ func (lbc *LoadBalancerController) Run(workers int, stopCh <-chan struct{}) {
 	lbc.proxies.RunAll(stopCh)
 	// run providers
 	lbc.providers.RunAll(stopCh)
+	// run gateway controller
+	lbc.gatewayCtl.Run(stopCh)
+	// run image rollout controller
+	lbc.rolloutCtl.Run(stopCh)
 
 	<-stopCh
 }
@@ -207,12 +231,6 @@ func (lbc *LoadBalancerController) syncLoadBalancer(obj interface{}) error {
 		return fmt.Errorf("expect loadbalancer, got %v", obj)
 	}
 
-	// Validate loadbalancer scheme
-	if err := lbapi.ValidateLoadBalancer(lb); err != nil {
-		log.Errorf("invalid loadbalancer scheme: %v", err)
-		return err
-	}
-
 	key, _ := cache.DeletionHandlingMetaNamespaceKeyFunc(lb)
 
 	startTime := time.Now()
@@ -238,6 +256,13 @@ func (lbc *LoadBalancerController) syncLoadBalancer(obj interface{}) error {
 	}
 	lb = nlb
 
+	// Validate loadbalancer scheme, deleted loadbalancer is cleaned up even if it is invalid
+	if err := lbapi.ValidateLoadBalancer(lb, lbc.ipamCtl.VIPChecker(lb)); err != nil {
+		log.Errorf("invalid loadbalancer scheme: %v", err)
+		lbutil.RecordEvent(lbc.client, lb, v1.EventTypeWarning, "InvalidSpec", "%v", err)
+		return err
+	}
+
 	return lbc.sync(lb, false)
 }
 
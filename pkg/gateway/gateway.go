@@ -0,0 +1,489 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gateway implements Gateway API on LoadBalancers. It registers a GatewayClass
+// for the controller and maps each Gateway of the class to a LoadBalancer, the proxy
+// and providers of the LoadBalancer do the data-plane work.
+package gateway
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/clientset/util/syncqueue"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	gatewayv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1"
+	gatewayv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	log "k8s.io/klog"
+)
+
+const (
+	// ControllerName is the controllerName of GatewayClasses managed by this controller
+	ControllerName = lbapi.GroupName + "/gateway-controller"
+	// annotationGateway is set on the LoadBalancer which a Gateway maps to,
+	// the value is the name of Gateway in the same namespace
+	annotationGateway = lbapi.GroupName + "/gateway"
+)
+
+// Controller syncs Gateways of the GatewayClasses managed by this controller
+// to LoadBalancers. Gateway API has no informer in clientset, so Gateways are
+// listed periodically, and synced again when the mapped LoadBalancer changes.
+type Controller struct {
+	enabled      bool
+	className    string
+	resyncPeriod time.Duration
+
+	client        kubernetes.Interface
+	gatewayClient *gatewayv1.Client
+	routeClient   *gatewayv1alpha2.Client
+	lbLister      lblisters.LoadBalancerLister
+	queue         *syncqueue.SyncQueue
+}
+
+// NewController creates a new Gateway controller
+func NewController(cfg config.Configuration, factory informers.SharedInformerFactory) *Controller {
+	lbInformer := factory.Custom().Loadbalance().V1alpha2().LoadBalancers()
+	restClient := cfg.Client.Native().NetworkingV1().RESTClient()
+	c := &Controller{
+		enabled:       cfg.Gateway.Enabled && cfg.Gateway.ClassName != "",
+		className:     cfg.Gateway.ClassName,
+		resyncPeriod:  cfg.Gateway.ResyncPeriod,
+		client:        cfg.Client,
+		gatewayClient: gatewayv1.New(restClient),
+		routeClient:   gatewayv1alpha2.New(restClient),
+		lbLister:      lbInformer.Lister(),
+	}
+	c.queue = syncqueue.NewSyncQueue(&gatewayv1.Gateway{}, c.syncGateway)
+
+	if !c.enabled {
+		return c
+	}
+	lbInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateLoadBalancer,
+		DeleteFunc: c.enqueueForLoadBalancer,
+	})
+	return c
+}
+
+// Run starts syncing Gateways, it does nothing if Gateway API support is disabled
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	if !c.enabled {
+		log.Info("Gateway API support is disabled")
+		return
+	}
+	log.Infof("Startting gateway controller, GatewayClass %v", c.className)
+
+	c.queue.Run(1)
+	go wait.Until(c.resync, c.resyncPeriod, stopCh)
+	go func() {
+		<-stopCh
+		c.queue.ShutDown()
+	}()
+}
+
+// resync ensures the GatewayClass, and enqueues all Gateways of the managed classes
+func (c *Controller) resync() {
+	if err := c.ensureGatewayClass(); err != nil {
+		if errors.IsNotFound(err) {
+			log.V(3).Infof("Gateway API is not installed, skip syncing Gateways")
+			return
+		}
+		log.Errorf("Ensure GatewayClass %v error: %v", c.className, err)
+		return
+	}
+
+	classes, err := c.syncGatewayClasses()
+	if err != nil {
+		log.Errorf("Sync GatewayClasses error: %v", err)
+		return
+	}
+
+	gateways, err := c.gatewayClient.ListGateways(metav1.NamespaceAll, metav1.ListOptions{})
+	if err != nil {
+		log.Errorf("List Gateways error: %v", err)
+		return
+	}
+	existing := sets.NewString()
+	for i := range gateways.Items {
+		gw := &gateways.Items[i]
+		if _, ok := classes[gw.Spec.GatewayClassName]; !ok {
+			continue
+		}
+		existing.Insert(gw.Namespace + "/" + gw.Name)
+		c.queue.Enqueue(gw)
+	}
+
+	c.releaseLoadBalancers(existing)
+}
+
+// ensureGatewayClass creates the GatewayClass of controller if it does not exist
+func (c *Controller) ensureGatewayClass() error {
+	class, err := c.gatewayClient.GetGatewayClass(c.className)
+	if err == nil {
+		if class.Spec.ControllerName != ControllerName {
+			log.Warningf("GatewayClass %v is managed by %v, not %v", c.className, class.Spec.ControllerName, ControllerName)
+		}
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	description := "Gateways of this class are implemented by LoadBalancers"
+	class = &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: c.className,
+		},
+		Spec: gatewayv1.GatewayClassSpec{
+			ControllerName: ControllerName,
+			Description:    &description,
+		},
+	}
+	log.Infof("About to create GatewayClass %v", c.className)
+	_, err = c.gatewayClient.CreateGatewayClass(class)
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// syncGatewayClasses accepts the GatewayClasses managed by this controller,
+// and returns the accepted ones by name
+func (c *Controller) syncGatewayClasses() (map[string]*gatewayv1.GatewayClass, error) {
+	list, err := c.gatewayClient.ListGatewayClasses(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make(map[string]*gatewayv1.GatewayClass)
+	for i := range list.Items {
+		class := &list.Items[i]
+		if class.Spec.ControllerName != ControllerName {
+			continue
+		}
+
+		cond := gatewayv1.Condition{
+			Type:               gatewayv1.ConditionAccepted,
+			Status:             gatewayv1.ConditionTrue,
+			ObservedGeneration: class.Generation,
+			LastTransitionTime: metav1.Now(),
+			Reason:             gatewayv1.ReasonAccepted,
+		}
+		if _, err := c.getTemplate(class); err != nil {
+			cond.Status = gatewayv1.ConditionFalse
+			cond.Reason = gatewayv1.ReasonInvalidParameters
+			cond.Message = err.Error()
+		} else {
+			accepted[class.Name] = class
+		}
+
+		status := class.Status.DeepCopy()
+		status.Conditions = gatewayv1.SetCondition(status.Conditions, cond)
+		if reflect.DeepEqual(class.Status, *status) {
+			continue
+		}
+		log.Infof("Update GatewayClass %v status, accepted: %v", class.Name, cond.Status)
+		if err := c.gatewayClient.UpdateGatewayClassStatus(class.Name, *status); err != nil {
+			log.Errorf("Update GatewayClass %v status error: %v", class.Name, err)
+		}
+	}
+	return accepted, nil
+}
+
+// getTemplate returns the LoadBalancer referred by the parametersRef of GatewayClass,
+// its spec is used to create LoadBalancers for Gateways. It returns nil if the
+// parametersRef is not set.
+func (c *Controller) getTemplate(class *gatewayv1.GatewayClass) (*lbapi.LoadBalancer, error) {
+	ref := class.Spec.ParametersRef
+	if ref == nil {
+		return nil, nil
+	}
+	if ref.Group != lbapi.GroupName || ref.Kind != api.ControllerKind.Kind {
+		return nil, fmt.Errorf("parametersRef must refer to a %s %s", lbapi.GroupName, api.ControllerKind.Kind)
+	}
+	if ref.Namespace == nil {
+		return nil, fmt.Errorf("namespace of parametersRef is required")
+	}
+	return c.lbLister.LoadBalancers(*ref.Namespace).Get(ref.Name)
+}
+
+// syncGateway syncs the Gateway with the given key
+func (c *Controller) syncGateway(obj interface{}) error {
+	key, ok := obj.(string)
+	if !ok {
+		return fmt.Errorf("expect gateway key, got %v", obj)
+	}
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	startTime := time.Now()
+	defer func() {
+		log.V(5).Infof("Finished syncing gateway, key: %v, uesdTime: %v", key, time.Since(startTime))
+	}()
+
+	gw, err := c.gatewayClient.GetGateway(namespace, name)
+	if errors.IsNotFound(err) {
+		// the LoadBalancer is released in resync
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	class, err := c.gatewayClient.GetGatewayClass(gw.Spec.GatewayClassName)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if class.Spec.ControllerName != ControllerName {
+		return nil
+	}
+
+	tcpRoutes, udpRoutes, err := c.listRoutes(namespace)
+	if err != nil {
+		return err
+	}
+	t := translateListeners(gw, tcpRoutes, udpRoutes)
+
+	lb, err := c.ensureLoadBalancer(gw, class, t)
+	if err != nil {
+		if _, ok := err.(*rejectedError); !ok {
+			return err
+		}
+		return c.updateGatewayStatus(gw, nil, t, err)
+	}
+	return c.updateGatewayStatus(gw, lb, t, nil)
+}
+
+// listRoutes lists TCPRoutes and UDPRoutes in the namespace, routes are
+// optional so a missing CRD is not an error
+func (c *Controller) listRoutes(namespace string) ([]streamRoute, []streamRoute, error) {
+	var tcpRoutes, udpRoutes []streamRoute
+	tcpList, err := c.routeClient.ListTCPRoutes(namespace, metav1.ListOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, nil, err
+	}
+	for _, r := range tcpList.Items {
+		tcpRoutes = append(tcpRoutes, streamRoute{r.Namespace, r.Name, r.Spec})
+	}
+	udpList, err := c.routeClient.ListUDPRoutes(namespace, metav1.ListOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, nil, err
+	}
+	for _, r := range udpList.Items {
+		udpRoutes = append(udpRoutes, streamRoute{r.Namespace, r.Name, r.Spec})
+	}
+	return tcpRoutes, udpRoutes, nil
+}
+
+// rejectedError means the Gateway can not be mapped to a LoadBalancer,
+// it is reported in Gateway status instead of being retried
+type rejectedError struct {
+	reason  string
+	message string
+}
+
+func (e *rejectedError) Error() string {
+	return e.message
+}
+
+// ensureLoadBalancer binds the Gateway to the LoadBalancer in its infrastructure
+// parametersRef, or creates one with the same name of Gateway, then applies
+// the translated listeners to the LoadBalancer
+func (c *Controller) ensureLoadBalancer(gw *gatewayv1.Gateway, class *gatewayv1.GatewayClass, t *translation) (*lbapi.LoadBalancer, error) {
+	name := gw.Name
+	bind := false
+	if gw.Spec.Infrastructure != nil && gw.Spec.Infrastructure.ParametersRef != nil {
+		ref := gw.Spec.Infrastructure.ParametersRef
+		if ref.Group != lbapi.GroupName || ref.Kind != api.ControllerKind.Kind {
+			return nil, &rejectedError{gatewayv1.ReasonInvalidParameters,
+				fmt.Sprintf("parametersRef must refer to a %s %s", lbapi.GroupName, api.ControllerKind.Kind)}
+		}
+		name = ref.Name
+		bind = true
+	}
+
+	lb, err := c.lbLister.LoadBalancers(gw.Namespace).Get(name)
+	if errors.IsNotFound(err) {
+		if bind {
+			return nil, &rejectedError{gatewayv1.ReasonInvalidParameters,
+				fmt.Sprintf("LoadBalancer %s/%s not found", gw.Namespace, name)}
+		}
+		lb, err = c.createLoadBalancer(gw, class)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if owner, ok := lb.Annotations[annotationGateway]; ok && owner != gw.Name {
+		return nil, &rejectedError{gatewayv1.ReasonInvalidParameters,
+			fmt.Sprintf("LoadBalancer %s/%s is used by Gateway %s", lb.Namespace, lb.Name, owner)}
+	}
+	if !bind && lb.Annotations[annotationGateway] != gw.Name {
+		return nil, &rejectedError{gatewayv1.ReasonInvalidParameters,
+			fmt.Sprintf("LoadBalancer %s/%s already exists and is not created for the Gateway", lb.Namespace, lb.Name)}
+	}
+
+	apply := func(lb *lbapi.LoadBalancer) {
+		if lb.Annotations == nil {
+			lb.Annotations = make(map[string]string)
+		}
+		lb.Annotations[annotationGateway] = gw.Name
+		applyTranslation(lb, t)
+	}
+	desired := lb.DeepCopy()
+	apply(desired)
+	if reflect.DeepEqual(lb.Annotations, desired.Annotations) && reflect.DeepEqual(lb.Spec, desired.Spec) {
+		return lb, nil
+	}
+
+	log.Infof("About to update LoadBalancer %v/%v for Gateway %v", lb.Namespace, lb.Name, gw.Name)
+	return lbutil.UpdateLBWithRetries(
+		c.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+		c.lbLister,
+		lb.Namespace,
+		lb.Name,
+		func(lb *lbapi.LoadBalancer) error {
+			apply(lb)
+			return nil
+		},
+	)
+}
+
+// createLoadBalancer creates a LoadBalancer owned by the Gateway, the spec is copied
+// from the template in GatewayClass, or a nginx proxy with one replica by default
+func (c *Controller) createLoadBalancer(gw *gatewayv1.Gateway, class *gatewayv1.GatewayClass) (*lbapi.LoadBalancer, error) {
+	template, err := c.getTemplate(class)
+	if err != nil {
+		return nil, err
+	}
+
+	t := true
+	replicas := int32(1)
+	lb := &lbapi.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gw.Name,
+			Namespace: gw.Namespace,
+			Annotations: map[string]string{
+				annotationGateway: gw.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         gatewayv1.GroupName + "/" + gatewayv1.Version,
+					Kind:               "Gateway",
+					Name:               gw.Name,
+					UID:                gw.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				},
+			},
+		},
+		Spec: lbapi.LoadBalancerSpec{
+			Nodes: lbapi.NodesSpec{
+				Replicas: &replicas,
+			},
+			Proxy: lbapi.ProxySpec{
+				Type: lbapi.ProxyTypeNginx,
+			},
+		},
+	}
+	if template != nil {
+		lb.Spec = *template.Spec.DeepCopy()
+	}
+
+	log.Infof("About to create LoadBalancer %v/%v for Gateway", lb.Namespace, lb.Name)
+	return c.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace).Create(lb)
+}
+
+// releaseLoadBalancers removes the annotations and the applied proxy fields from the
+// LoadBalancers whose Gateway has gone, LoadBalancers created for Gateways are garbage collected
+func (c *Controller) releaseLoadBalancers(existing sets.String) {
+	lbs, err := c.lbLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("list loadbalancers error: %v", err)
+		return
+	}
+	for _, lb := range lbs {
+		name, ok := lb.Annotations[annotationGateway]
+		if !ok || existing.Has(lb.Namespace+"/"+name) {
+			continue
+		}
+		log.Infof("Gateway %v/%v has gone, release LoadBalancer %v", lb.Namespace, name, lb.Name)
+		_, err := lbutil.UpdateLBWithRetries(
+			c.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+			c.lbLister,
+			lb.Namespace,
+			lb.Name,
+			func(lb *lbapi.LoadBalancer) error {
+				delete(lb.Annotations, annotationGateway)
+				applyTranslation(lb, nil)
+				return nil
+			},
+		)
+		if err != nil {
+			log.Errorf("Release LoadBalancer %v/%v error: %v", lb.Namespace, lb.Name, err)
+		}
+	}
+}
+
+// enqueueForLoadBalancer enqueues the Gateway which the LoadBalancer maps to
+func (c *Controller) enqueueForLoadBalancer(obj interface{}) {
+	lb, ok := obj.(*lbapi.LoadBalancer)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Couldn't get object from tombstone %#v", obj))
+			return
+		}
+		lb, ok = tombstone.Obj.(*lbapi.LoadBalancer)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Tombstone contained object that is not a LoadBalancer %#v", obj))
+			return
+		}
+	}
+	name, ok := lb.Annotations[annotationGateway]
+	if !ok || strings.TrimSpace(name) == "" {
+		return
+	}
+	c.queue.Enqueue(cache.ExplicitKey(lb.Namespace + "/" + name))
+}
+
+func (c *Controller) updateLoadBalancer(oldObj, curObj interface{}) {
+	old := oldObj.(*lbapi.LoadBalancer)
+	cur := curObj.(*lbapi.LoadBalancer)
+	if old.ResourceVersion == cur.ResourceVersion {
+		return
+	}
+	c.enqueueForLoadBalancer(cur)
+}
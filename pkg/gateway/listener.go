@@ -0,0 +1,265 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"sort"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	gatewayv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1"
+	gatewayv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// streamRoute is a TCPRoute or UDPRoute
+type streamRoute struct {
+	namespace string
+	name      string
+	spec      gatewayv1alpha2.StreamRouteSpec
+}
+
+// translation is the proxy config translated from the listeners of a Gateway
+type translation struct {
+	// httpPort and httpsPort are 0 if there is no HTTP or HTTPS listener
+	httpPort   int
+	httpsPort  int
+	portRanges []lbapi.PortRange
+	streams    []lbapi.StreamSpec
+	// listeners are the statuses of listeners without Programmed condition,
+	// in the same order as spec
+	listeners []gatewayv1.ListenerStatus
+}
+
+func condition(gw *gatewayv1.Gateway, conditionType string, status gatewayv1.ConditionStatus, reason, message string) gatewayv1.Condition {
+	return gatewayv1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: gw.Generation,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// rejectListener returns the status of a listener which is not accepted
+func rejectListener(gw *gatewayv1.Gateway, listener gatewayv1.Listener, conflicted bool, reason, message string) gatewayv1.ListenerStatus {
+	conditions := []gatewayv1.Condition{
+		condition(gw, gatewayv1.ConditionAccepted, gatewayv1.ConditionFalse, reason, message),
+	}
+	if conflicted {
+		conditions = append(conditions, condition(gw, gatewayv1.ConditionConflicted, gatewayv1.ConditionTrue, reason, message))
+	}
+	return gatewayv1.ListenerStatus{
+		Name:           listener.Name,
+		SupportedKinds: []gatewayv1.RouteGroupKind{},
+		Conditions:     conditions,
+	}
+}
+
+// acceptListener returns the status of an accepted listener
+func acceptListener(gw *gatewayv1.Gateway, listener gatewayv1.Listener, kinds []gatewayv1.RouteGroupKind) gatewayv1.ListenerStatus {
+	return gatewayv1.ListenerStatus{
+		Name:           listener.Name,
+		SupportedKinds: kinds,
+		Conditions: []gatewayv1.Condition{
+			condition(gw, gatewayv1.ConditionAccepted, gatewayv1.ConditionTrue, gatewayv1.ReasonAccepted, ""),
+			condition(gw, gatewayv1.ConditionConflicted, gatewayv1.ConditionFalse, gatewayv1.ReasonNoConflicts, ""),
+			condition(gw, gatewayv1.ConditionResolvedRefs, gatewayv1.ConditionTrue, gatewayv1.ReasonResolvedRefs, ""),
+		},
+	}
+}
+
+// translateListeners translates the listeners of Gateway to proxy ports, port ranges and streams.
+// HTTP and HTTPS (or TLS) listeners set the http and https port of proxy, the proxy only
+// listens on one http and one https port, and HTTP routing is still configured by Ingresses
+// of the LoadBalancer's IngressClass. TCP and UDP listeners become streams whose backends
+// come from the TCPRoutes and UDPRoutes attached to the listener.
+func translateListeners(gw *gatewayv1.Gateway, tcpRoutes, udpRoutes []streamRoute) *translation {
+	t := &translation{
+		listeners: make([]gatewayv1.ListenerStatus, len(gw.Spec.Listeners)),
+	}
+
+	// HTTP and HTTPS listeners first, stream listeners must not use their ports
+	for i, listener := range gw.Spec.Listeners {
+		port := int(listener.Port)
+		var target *int
+		switch listener.Protocol {
+		case gatewayv1.HTTPProtocolType:
+			target = &t.httpPort
+		case gatewayv1.HTTPSProtocolType, gatewayv1.TLSProtocolType:
+			target = &t.httpsPort
+		default:
+			continue
+		}
+		switch {
+		case *target == 0 && (port == t.httpPort || port == t.httpsPort):
+			t.listeners[i] = rejectListener(gw, listener, true, gatewayv1.ReasonProtocolConflict,
+				fmt.Sprintf("port %d is used by listener of another protocol", port))
+		case *target != 0 && *target != port:
+			t.listeners[i] = rejectListener(gw, listener, true, gatewayv1.ReasonPortUnavailable,
+				fmt.Sprintf("proxy only listens on one %s port %d", listener.Protocol, *target))
+		default:
+			*target = port
+			t.listeners[i] = acceptListener(gw, listener, []gatewayv1.RouteGroupKind{})
+		}
+	}
+
+	lb := &lbapi.LoadBalancer{}
+	lb.Spec.Proxy.HTTPPort = t.httpPort
+	lb.Spec.Proxy.HTTPSPort = t.httpsPort
+	httpPort, httpsPort := lbutil.HTTPPorts(lb)
+
+	used := make(map[string]bool)
+	for i, listener := range gw.Spec.Listeners {
+		var protocol v1.Protocol
+		var routes []streamRoute
+		var kind string
+		switch listener.Protocol {
+		case gatewayv1.TCPProtocolType:
+			protocol, routes, kind = v1.ProtocolTCP, tcpRoutes, "TCPRoute"
+		case gatewayv1.UDPProtocolType:
+			protocol, routes, kind = v1.ProtocolUDP, udpRoutes, "UDPRoute"
+		case gatewayv1.HTTPProtocolType, gatewayv1.HTTPSProtocolType, gatewayv1.TLSProtocolType:
+			continue
+		default:
+			t.listeners[i] = rejectListener(gw, listener, false, gatewayv1.ReasonUnsupportedProtocol,
+				fmt.Sprintf("protocol %s is not supported", listener.Protocol))
+			continue
+		}
+
+		port := int(listener.Port)
+		key := fmt.Sprintf("%s/%d", protocol, port)
+		if port == httpPort || port == httpsPort {
+			t.listeners[i] = rejectListener(gw, listener, true, gatewayv1.ReasonProtocolConflict,
+				fmt.Sprintf("port %d is used by http or https", port))
+			continue
+		}
+		if used[key] {
+			t.listeners[i] = rejectListener(gw, listener, true, gatewayv1.ReasonPortUnavailable,
+				fmt.Sprintf("%s port %d is used by another listener", protocol, port))
+			continue
+		}
+		used[key] = true
+
+		group := gatewayv1.GroupName
+		status := acceptListener(gw, listener, []gatewayv1.RouteGroupKind{{Group: &group, Kind: kind}})
+		attached := attachedRoutes(gw, listener, routes)
+		status.AttachedRoutes = int32(len(attached))
+		t.portRanges = append(t.portRanges, lbapi.PortRange{Start: listener.Port, End: listener.Port})
+
+		if len(attached) > 0 {
+			// a stream has only one backend, the first attached route wins
+			stream, err := routeStream(attached[0], listener.Port, protocol)
+			if err != nil {
+				status.Conditions = gatewayv1.SetCondition(status.Conditions, condition(gw, gatewayv1.ConditionResolvedRefs, gatewayv1.ConditionFalse, gatewayv1.ReasonBackendNotFound, err.Error()))
+			} else {
+				t.streams = append(t.streams, *stream)
+			}
+		}
+		t.listeners[i] = status
+	}
+
+	t.portRanges = mergePortRanges(t.portRanges)
+	return t
+}
+
+// attachedRoutes returns the routes attached to the listener sorted by namespace and name.
+// Only routes in the same namespace of Gateway are allowed.
+func attachedRoutes(gw *gatewayv1.Gateway, listener gatewayv1.Listener, routes []streamRoute) []streamRoute {
+	attached := make([]streamRoute, 0)
+	for _, route := range routes {
+		if route.namespace != gw.Namespace {
+			continue
+		}
+		for _, ref := range route.spec.ParentRefs {
+			if ref.Group != nil && *ref.Group != gatewayv1.GroupName {
+				continue
+			}
+			if ref.Kind != nil && *ref.Kind != "Gateway" {
+				continue
+			}
+			if ref.Namespace != nil && *ref.Namespace != gw.Namespace {
+				continue
+			}
+			if ref.Name != gw.Name {
+				continue
+			}
+			if ref.SectionName != nil && *ref.SectionName != listener.Name {
+				continue
+			}
+			if ref.Port != nil && *ref.Port != listener.Port {
+				continue
+			}
+			attached = append(attached, route)
+			break
+		}
+	}
+	sort.Slice(attached, func(i, j int) bool {
+		return attached[i].name < attached[j].name
+	})
+	return attached
+}
+
+// routeStream returns the stream of the first backend in route
+func routeStream(route streamRoute, port int32, protocol v1.Protocol) (*lbapi.StreamSpec, error) {
+	if len(route.spec.Rules) == 0 || len(route.spec.Rules[0].BackendRefs) == 0 {
+		return nil, fmt.Errorf("route %s/%s has no backend", route.namespace, route.name)
+	}
+	ref := route.spec.Rules[0].BackendRefs[0]
+	if (ref.Group != nil && *ref.Group != "") || (ref.Kind != nil && *ref.Kind != "Service") {
+		return nil, fmt.Errorf("route %s/%s: only Service backend is supported", route.namespace, route.name)
+	}
+	if ref.Namespace != nil && *ref.Namespace != route.namespace {
+		return nil, fmt.Errorf("route %s/%s: cross namespace backend is not permitted", route.namespace, route.name)
+	}
+	if ref.Port == nil {
+		return nil, fmt.Errorf("route %s/%s: backend port is required", route.namespace, route.name)
+	}
+	return &lbapi.StreamSpec{
+		Port:     port,
+		Protocol: protocol,
+		Service: lbapi.StreamServiceReference{
+			Namespace: route.namespace,
+			Name:      ref.Name,
+			Port:      *ref.Port,
+		},
+	}, nil
+}
+
+// mergePortRanges sorts the ranges and merges the overlapping or adjacent ones
+func mergePortRanges(ranges []lbapi.PortRange) []lbapi.PortRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start < ranges[j].Start
+	})
+	merged := []lbapi.PortRange{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.Start <= last.End+1 {
+			if r.End > last.End {
+				last.End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
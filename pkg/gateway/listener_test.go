@@ -0,0 +1,106 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"reflect"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	gatewayv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1"
+	gatewayv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTranslateListeners(t *testing.T) {
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"},
+		Spec: gatewayv1.GatewaySpec{
+			Listeners: []gatewayv1.Listener{
+				{Name: "http", Port: 8080, Protocol: gatewayv1.HTTPProtocolType},
+				{Name: "http-2", Port: 8081, Protocol: gatewayv1.HTTPProtocolType},
+				{Name: "https", Port: 8443, Protocol: gatewayv1.HTTPSProtocolType},
+				{Name: "mysql", Port: 20001, Protocol: gatewayv1.TCPProtocolType},
+				{Name: "mysql-2", Port: 20001, Protocol: gatewayv1.TCPProtocolType},
+				{Name: "dns", Port: 20002, Protocol: gatewayv1.UDPProtocolType},
+				{Name: "conflict", Port: 8080, Protocol: gatewayv1.TCPProtocolType},
+				{Name: "sctp", Port: 20003, Protocol: "SCTP"},
+			},
+		},
+	}
+	port := int32(3306)
+	section := "mysql"
+	tcpRoutes := []streamRoute{
+		{
+			namespace: "default",
+			name:      "mysql",
+			spec: gatewayv1alpha2.StreamRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw", SectionName: &section}},
+				Rules:      []gatewayv1alpha2.StreamRouteRule{{BackendRefs: []gatewayv1.BackendRef{{Name: "mysql", Port: &port}}}},
+			},
+		},
+		{
+			// other namespace is not allowed
+			namespace: "other",
+			name:      "mysql",
+			spec: gatewayv1alpha2.StreamRouteSpec{
+				ParentRefs: []gatewayv1.ParentReference{{Name: "gw"}},
+			},
+		},
+	}
+
+	tr := translateListeners(gw, tcpRoutes, nil)
+
+	if tr.httpPort != 8080 || tr.httpsPort != 8443 {
+		t.Errorf("translateListeners() ports = %v, %v, want 8080, 8443", tr.httpPort, tr.httpsPort)
+	}
+	wantRanges := []lbapi.PortRange{{Start: 20001, End: 20002}}
+	if !reflect.DeepEqual(tr.portRanges, wantRanges) {
+		t.Errorf("translateListeners() port ranges = %v, want %v", tr.portRanges, wantRanges)
+	}
+	wantStreams := []lbapi.StreamSpec{
+		{Port: 20001, Protocol: v1.ProtocolTCP, Service: lbapi.StreamServiceReference{Namespace: "default", Name: "mysql", Port: 3306}},
+	}
+	if !reflect.DeepEqual(tr.streams, wantStreams) {
+		t.Errorf("translateListeners() streams = %v, want %v", tr.streams, wantStreams)
+	}
+
+	wantAccepted := map[string]bool{
+		"http":     true,
+		"http-2":   false,
+		"https":    true,
+		"mysql":    true,
+		"mysql-2":  false,
+		"dns":      true,
+		"conflict": false,
+		"sctp":     false,
+	}
+	for i, status := range tr.listeners {
+		if status.Name != gw.Spec.Listeners[i].Name {
+			t.Errorf("listener %d name = %v, want %v", i, status.Name, gw.Spec.Listeners[i].Name)
+		}
+		cond := gatewayv1.FindCondition(status.Conditions, gatewayv1.ConditionAccepted)
+		if got := cond != nil && cond.Status == gatewayv1.ConditionTrue; got != wantAccepted[status.Name] {
+			t.Errorf("listener %v accepted = %v, want %v", status.Name, got, wantAccepted[status.Name])
+		}
+	}
+	if tr.listeners[3].AttachedRoutes != 1 {
+		t.Errorf("listener mysql attached routes = %v, want 1", tr.listeners[3].AttachedRoutes)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+	log "k8s.io/klog"
+)
+
+const (
+	// annotationGatewayApplied records the proxy fields applied by the Gateway,
+	// they are replaced on the next sync and the other fields are kept
+	annotationGatewayApplied = lbapi.GroupName + "/gateway-applied"
+)
+
+// appliedFields are the proxy fields owned by a Gateway
+type appliedFields struct {
+	HTTPPort   int                `json:"httpPort,omitempty"`
+	HTTPSPort  int                `json:"httpsPort,omitempty"`
+	PortRanges []lbapi.PortRange  `json:"portRanges,omitempty"`
+	Streams    []lbapi.StreamSpec `json:"streams,omitempty"`
+}
+
+func lastApplied(lb *lbapi.LoadBalancer) appliedFields {
+	applied := appliedFields{}
+	value, ok := lb.Annotations[annotationGatewayApplied]
+	if !ok {
+		return applied
+	}
+	if err := json.Unmarshal([]byte(value), &applied); err != nil {
+		log.Warningf("Invalid annotation %v of LoadBalancer %v/%v: %v", annotationGatewayApplied, lb.Namespace, lb.Name, err)
+	}
+	return applied
+}
+
+func streamKey(stream lbapi.StreamSpec) string {
+	protocol := stream.Protocol
+	if protocol == "" {
+		protocol = v1.ProtocolTCP
+	}
+	return fmt.Sprintf("%s/%d", protocol, stream.Port)
+}
+
+// applyTranslation replaces the proxy fields last applied by the Gateway with
+// the translation, the ports, port ranges and streams set by others are kept.
+// A nil translation removes all fields applied by the Gateway.
+func applyTranslation(lb *lbapi.LoadBalancer, t *translation) {
+	last := lastApplied(lb)
+	desired := appliedFields{}
+	if t != nil {
+		desired = appliedFields{t.httpPort, t.httpsPort, t.portRanges, t.streams}
+	}
+	proxy := &lb.Spec.Proxy
+
+	mergePort := func(current *int, last, desired int) {
+		switch {
+		case desired != 0:
+			*current = desired
+		case last != 0 && *current == last:
+			*current = 0
+		}
+	}
+	mergePort(&proxy.HTTPPort, last.HTTPPort, desired.HTTPPort)
+	mergePort(&proxy.HTTPSPort, last.HTTPSPort, desired.HTTPSPort)
+
+	owned := make(map[lbapi.PortRange]bool)
+	for _, r := range last.PortRanges {
+		owned[r] = true
+	}
+	for _, r := range desired.PortRanges {
+		owned[r] = true
+	}
+	var portRanges []lbapi.PortRange
+	for _, r := range proxy.PortRanges {
+		if !owned[r] {
+			portRanges = append(portRanges, r)
+		}
+	}
+	proxy.PortRanges = append(portRanges, desired.PortRanges...)
+
+	ownedStreams := make(map[string]bool)
+	for _, s := range last.Streams {
+		ownedStreams[streamKey(s)] = true
+	}
+	for _, s := range desired.Streams {
+		ownedStreams[streamKey(s)] = true
+	}
+	var streams []lbapi.StreamSpec
+	for _, s := range proxy.Streams {
+		if !ownedStreams[streamKey(s)] {
+			streams = append(streams, s)
+		}
+	}
+	proxy.Streams = append(streams, desired.Streams...)
+
+	if t == nil {
+		delete(lb.Annotations, annotationGatewayApplied)
+		return
+	}
+	value, _ := json.Marshal(desired)
+	if lb.Annotations == nil {
+		lb.Annotations = make(map[string]string)
+	}
+	lb.Annotations[annotationGatewayApplied] = string(value)
+}
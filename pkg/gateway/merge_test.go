@@ -0,0 +1,70 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"reflect"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestApplyTranslation(t *testing.T) {
+	userStream := lbapi.StreamSpec{Port: 3306, Service: lbapi.StreamServiceReference{Name: "mysql", Port: 3306}}
+	lb := &lbapi.LoadBalancer{}
+	lb.Spec.Proxy.HTTPSPort = 9443
+	lb.Spec.Proxy.PortRanges = []lbapi.PortRange{{Start: 3306, End: 3306}}
+	lb.Spec.Proxy.Streams = []lbapi.StreamSpec{userStream}
+
+	dnsStream := lbapi.StreamSpec{Port: 53, Protocol: v1.ProtocolUDP, Service: lbapi.StreamServiceReference{Name: "dns", Port: 53}}
+	applyTranslation(lb, &translation{
+		httpPort:   8080,
+		portRanges: []lbapi.PortRange{{Start: 53, End: 53}},
+		streams:    []lbapi.StreamSpec{dnsStream},
+	})
+	proxy := lb.Spec.Proxy
+	if proxy.HTTPPort != 8080 || proxy.HTTPSPort != 9443 {
+		t.Errorf("got ports %v %v, want 8080 9443", proxy.HTTPPort, proxy.HTTPSPort)
+	}
+	if want := []lbapi.PortRange{{Start: 3306, End: 3306}, {Start: 53, End: 53}}; !reflect.DeepEqual(proxy.PortRanges, want) {
+		t.Errorf("got port ranges %v, want %v", proxy.PortRanges, want)
+	}
+	if want := []lbapi.StreamSpec{userStream, dnsStream}; !reflect.DeepEqual(proxy.Streams, want) {
+		t.Errorf("got streams %v, want %v", proxy.Streams, want)
+	}
+
+	// listeners removed from Gateway are removed from LoadBalancer
+	applyTranslation(lb, &translation{})
+	proxy = lb.Spec.Proxy
+	if proxy.HTTPPort != 0 || proxy.HTTPSPort != 9443 {
+		t.Errorf("got ports %v %v, want 0 9443", proxy.HTTPPort, proxy.HTTPSPort)
+	}
+	if want := []lbapi.PortRange{{Start: 3306, End: 3306}}; !reflect.DeepEqual(proxy.PortRanges, want) {
+		t.Errorf("got port ranges %v, want %v", proxy.PortRanges, want)
+	}
+	if want := []lbapi.StreamSpec{userStream}; !reflect.DeepEqual(proxy.Streams, want) {
+		t.Errorf("got streams %v, want %v", proxy.Streams, want)
+	}
+
+	// released LoadBalancer has no applied fields
+	applyTranslation(lb, nil)
+	if _, ok := lb.Annotations[annotationGatewayApplied]; ok {
+		t.Errorf("got annotations %v after release", lb.Annotations)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"reflect"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	gatewayv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/gateway/v1"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	log "k8s.io/klog"
+)
+
+// loadBalancerAddresses returns the addresses of LoadBalancer, they are the VIPs
+// and hostnames of providers, or the nodes running the proxy if there is no provider
+func loadBalancerAddresses(lb *lbapi.LoadBalancer) []gatewayv1.GatewayAddress {
+	ips := make([]string, 0)
+	providers := lb.Spec.Providers
+	if providers.External != nil {
+		ips = append(ips, providers.External.VIP)
+		ips = append(ips, providers.External.VIPs...)
+	}
+	if providers.Ipvsdr != nil {
+		ips = append(ips, providers.Ipvsdr.VIP)
+		ips = append(ips, providers.Ipvsdr.VIPs...)
+	}
+	if providers.BGP != nil {
+		ips = append(ips, providers.BGP.VIPs...)
+	}
+	if providers.F5 != nil {
+		ips = append(ips, providers.F5.VIPAddress)
+	}
+	if aliyun := lb.Status.ProvidersStatuses.Aliyun; aliyun != nil {
+		ips = append(ips, aliyun.Address)
+	}
+	if azure := lb.Status.ProvidersStatuses.Azure; azure != nil && azure.PublicIPAddress != nil {
+		ips = append(ips, *azure.PublicIPAddress)
+	}
+	if openstack := lb.Status.ProvidersStatuses.OpenStack; openstack != nil {
+		ips = append(ips, openstack.FloatingIP, openstack.VIPAddress)
+	}
+	hostnames := make([]string, 0)
+	if aws := lb.Status.ProvidersStatuses.AWS; aws != nil {
+		ips = append(ips, aws.Addresses...)
+		hostnames = append(hostnames, aws.DNSName)
+	}
+
+	addresses := make([]gatewayv1.GatewayAddress, 0)
+	ipType := gatewayv1.IPAddressType
+	seen := sets.NewString()
+	for _, ip := range ips {
+		if ip == "" || seen.Has(ip) {
+			continue
+		}
+		seen.Insert(ip)
+		addresses = append(addresses, gatewayv1.GatewayAddress{Type: &ipType, Value: ip})
+	}
+	hostnameType := gatewayv1.HostnameAddressType
+	for _, hostname := range hostnames {
+		if hostname == "" || seen.Has(hostname) {
+			continue
+		}
+		seen.Insert(hostname)
+		addresses = append(addresses, gatewayv1.GatewayAddress{Type: &hostnameType, Value: hostname})
+	}
+	if len(addresses) > 0 {
+		return addresses
+	}
+
+	for _, pod := range lb.Status.ProxyStatus.Statuses {
+		if pod.NodeName == "" || seen.Has(pod.NodeName) {
+			continue
+		}
+		seen.Insert(pod.NodeName)
+		addresses = append(addresses, gatewayv1.GatewayAddress{Type: &hostnameType, Value: pod.NodeName})
+	}
+	return addresses
+}
+
+// unresolvedStream returns the message if the stream on the port is not resolved by proxy
+func unresolvedStream(lb *lbapi.LoadBalancer, port int32, protocol v1.Protocol) string {
+	for _, stream := range lb.Status.ProxyStatus.Streams {
+		if stream.Port == port && stream.Protocol == protocol && !stream.Resolved {
+			return fmt.Sprintf("backend %s: %s", stream.Service, stream.Message)
+		}
+	}
+	return ""
+}
+
+// updateGatewayStatus writes Gateway and listener status from the LoadBalancer status,
+// lb is nil if the Gateway is rejected with rejectErr
+func (c *Controller) updateGatewayStatus(gw *gatewayv1.Gateway, lb *lbapi.LoadBalancer, t *translation, rejectErr error) error {
+	now := metav1.Now()
+	status := gw.Status.DeepCopy()
+	set := func(conditions []gatewayv1.Condition, cond gatewayv1.Condition) []gatewayv1.Condition {
+		cond.LastTransitionTime = now
+		return gatewayv1.SetCondition(conditions, cond)
+	}
+
+	programmed := false
+	if rejectErr != nil {
+		reason := gatewayv1.ReasonInvalid
+		if e, ok := rejectErr.(*rejectedError); ok {
+			reason = e.reason
+		}
+		status.Addresses = nil
+		status.Conditions = set(status.Conditions, condition(gw, gatewayv1.ConditionAccepted, gatewayv1.ConditionFalse, reason, rejectErr.Error()))
+		status.Conditions = set(status.Conditions, condition(gw, gatewayv1.ConditionProgrammed, gatewayv1.ConditionFalse, gatewayv1.ReasonInvalid, rejectErr.Error()))
+	} else {
+		message := fmt.Sprintf("mapped to LoadBalancer %s/%s", lb.Namespace, lb.Name)
+		status.Addresses = loadBalancerAddresses(lb)
+		status.Conditions = set(status.Conditions, condition(gw, gatewayv1.ConditionAccepted, gatewayv1.ConditionTrue, gatewayv1.ReasonAccepted, message))
+		programmed = lb.Status.ProxyStatus.ReadyReplicas > 0
+		if programmed {
+			status.Conditions = set(status.Conditions, condition(gw, gatewayv1.ConditionProgrammed, gatewayv1.ConditionTrue, gatewayv1.ReasonProgrammed, message))
+		} else {
+			status.Conditions = set(status.Conditions, condition(gw, gatewayv1.ConditionProgrammed, gatewayv1.ConditionFalse, gatewayv1.ReasonPending,
+				fmt.Sprintf("waiting for proxy of LoadBalancer %s/%s to be ready", lb.Namespace, lb.Name)))
+		}
+	}
+
+	oldListeners := make(map[string]gatewayv1.ListenerStatus)
+	for _, l := range gw.Status.Listeners {
+		oldListeners[l.Name] = l
+	}
+	listeners := make([]gatewayv1.ListenerStatus, 0, len(t.listeners))
+	for i, desired := range t.listeners {
+		spec := gw.Spec.Listeners[i]
+		old := oldListeners[desired.Name]
+		listener := gatewayv1.ListenerStatus{
+			Name:           desired.Name,
+			SupportedKinds: desired.SupportedKinds,
+			AttachedRoutes: desired.AttachedRoutes,
+		}
+		accepted := false
+		conditions := make([]gatewayv1.Condition, 0, len(desired.Conditions)+1)
+		for _, cond := range desired.Conditions {
+			if cond.Type == gatewayv1.ConditionAccepted && cond.Status == gatewayv1.ConditionTrue {
+				accepted = true
+			}
+			if cond.Type == gatewayv1.ConditionResolvedRefs && cond.Status == gatewayv1.ConditionTrue && lb != nil {
+				if message := unresolvedStream(lb, spec.Port, v1.Protocol(spec.Protocol)); message != "" {
+					cond = condition(gw, gatewayv1.ConditionResolvedRefs, gatewayv1.ConditionFalse, gatewayv1.ReasonBackendNotFound, message)
+				}
+			}
+			conditions = append(conditions, cond)
+		}
+		if accepted && programmed {
+			conditions = append(conditions, condition(gw, gatewayv1.ConditionProgrammed, gatewayv1.ConditionTrue, gatewayv1.ReasonProgrammed, ""))
+		} else {
+			conditions = append(conditions, condition(gw, gatewayv1.ConditionProgrammed, gatewayv1.ConditionFalse, gatewayv1.ReasonPending, ""))
+		}
+		// only keep the conditions of current state, with the transition time of old ones
+		for _, cond := range conditions {
+			cond.LastTransitionTime = now
+			if o := gatewayv1.FindCondition(old.Conditions, cond.Type); o != nil && o.Status == cond.Status {
+				cond.LastTransitionTime = o.LastTransitionTime
+			}
+			listener.Conditions = append(listener.Conditions, cond)
+		}
+		listeners = append(listeners, listener)
+	}
+	status.Listeners = listeners
+
+	if reflect.DeepEqual(gw.Status, *status) {
+		return nil
+	}
+	log.Infof("Update Gateway %v/%v status", gw.Namespace, gw.Name)
+	return c.gatewayClient.UpdateGatewayStatus(gw.Namespace, gw.Name, *status)
+}
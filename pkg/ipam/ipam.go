@@ -0,0 +1,296 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ipam allocates vips of ipvsdr and external providers from the
+// cluster-scoped VIPPools. Allocations are recorded in the status of pools,
+// and an allocated vip is set to the provider spec of LoadBalancer, like the
+// cluster ip of Service.
+package ipam
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	ipamv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/ipam/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	log "k8s.io/klog"
+)
+
+// pool is a VIPPool with its parsed address range
+type pool struct {
+	ipamv1alpha2.VIPPool
+	r *addressRange
+}
+
+// Controller allocates vips from VIPPools
+type Controller struct {
+	resyncPeriod time.Duration
+
+	client     kubernetes.Interface
+	poolClient *ipamv1alpha2.Client
+	lbLister   lblisters.LoadBalancerLister
+
+	mu sync.RWMutex
+	// synced means pools have been listed once
+	synced bool
+	// pools are the valid pools observed in last resync
+	pools []pool
+	// conflicts are the reasons why LoadBalancers can't use their vips,
+	// they are recorded to avoid duplicated events
+	conflicts map[string]string
+}
+
+// NewController creates a new ipam controller
+func NewController(cfg config.Configuration, factory informers.SharedInformerFactory) *Controller {
+	return &Controller{
+		resyncPeriod: cfg.IPAM.ResyncPeriod,
+		client:       cfg.Client,
+		poolClient:   ipamv1alpha2.New(cfg.Client.Custom().LoadbalanceV1alpha2().RESTClient()),
+		lbLister:     factory.Custom().Loadbalance().V1alpha2().LoadBalancers().Lister(),
+		conflicts:    make(map[string]string),
+	}
+}
+
+// Run starts to allocate vips
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	if err := c.ensureResource(); err != nil {
+		log.Errorf("Ensure VIPPool resource error: %v", err)
+		// there is no pool to check vips against
+		c.mu.Lock()
+		c.synced = true
+		c.mu.Unlock()
+		return
+	}
+	log.Info("Startting ipam controller")
+	go wait.Until(c.resync, c.resyncPeriod, stopCh)
+}
+
+// HasSynced returns true if pools have been listed, VIPChecker does not know
+// the allocations before it
+func (c *Controller) HasSynced() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.synced
+}
+
+// VIPChecker returns a checker which rejects the vips allocated to other
+// LoadBalancers, or the vips of pools not allowed in namespace of lb
+func (c *Controller) VIPChecker(lb *lbapi.LoadBalancer) lbapi.VIPChecker {
+	return func(vip string) error {
+		ip := net.ParseIP(vip)
+		if ip == nil {
+			return nil
+		}
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		for _, p := range c.pools {
+			if !p.r.contains(ip) {
+				continue
+			}
+			if !namespaceAllowed(p.Spec, lb.Namespace) {
+				return fmt.Errorf("vip %v in pool %v is not allowed in namespace %v", vip, p.Name, lb.Namespace)
+			}
+			for _, a := range p.Status.Allocations {
+				if net.ParseIP(a.IP).Equal(ip) && (a.Namespace != lb.Namespace || a.Name != lb.Name) {
+					return fmt.Errorf("vip %v is allocated to loadbalancer %v/%v by pool %v", vip, a.Namespace, a.Name, p.Name)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func (c *Controller) resync() {
+	list, err := c.poolClient.ListVIPPools()
+	if err != nil {
+		log.Errorf("List VIPPools error: %v", err)
+		return
+	}
+	lbs, err := c.lbLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("List LoadBalancers error: %v", err)
+		return
+	}
+
+	pools := make([]pool, 0, len(list.Items))
+	conflicts := make(map[string]string)
+	for i := range list.Items {
+		p, err := c.sync(&list.Items[i], lbs, conflicts)
+		if err != nil {
+			log.Errorf("Sync VIPPool %v error: %v", list.Items[i].Name, err)
+		}
+		if p != nil {
+			pools = append(pools, *p)
+		}
+	}
+
+	byKey := make(map[string]*lbapi.LoadBalancer, len(lbs))
+	for _, lb := range lbs {
+		byKey[lb.Namespace+"/"+lb.Name] = lb
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pools = pools
+	c.synced = true
+	for key, message := range conflicts {
+		if c.conflicts[key] != message {
+			log.Warningf("Loadbalancer %v can't use vip: %v", key, message)
+			lbutil.RecordEvent(c.client, byKey[key], v1.EventTypeWarning, "VIPConflict", "%s", message)
+		}
+	}
+	c.conflicts = conflicts
+}
+
+// sync reconciles the allocations of pool, and sets the allocated vips to LoadBalancers
+func (c *Controller) sync(vp *ipamv1alpha2.VIPPool, lbs []*lbapi.LoadBalancer, conflicts map[string]string) (*pool, error) {
+	r, err := parseRange(vp.Spec)
+	if err != nil {
+		status := vp.Status.DeepCopy()
+		status.Message = err.Error()
+		if !reflect.DeepEqual(&vp.Status, status) {
+			vp.Status = *status
+			_, err = c.poolClient.UpdateVIPPoolStatus(vp)
+		}
+		return nil, err
+	}
+
+	status, assignments, poolConflicts := reconcile(vp, r, lbs)
+	for key, message := range poolConflicts {
+		conflicts[key] = message
+	}
+	// the allocations are recorded before they are set to LoadBalancers
+	if !reflect.DeepEqual(vp.Status, status) {
+		vp.Status = status
+		updated, err := c.poolClient.UpdateVIPPoolStatus(vp)
+		if err != nil {
+			return nil, err
+		}
+		vp = updated
+	}
+
+	for _, a := range assignments {
+		if err := c.assign(a.lb, vp.Name, a.ip); err != nil {
+			return nil, err
+		}
+	}
+	return &pool{VIPPool: *vp, r: r}, nil
+}
+
+// assign sets vip to the provider of lb referencing the pool
+func (c *Controller) assign(lb *lbapi.LoadBalancer, name, vip string) error {
+	log.Infof("Allocate vip %v to loadbalancer %v/%v from pool %v", vip, lb.Namespace, lb.Name, name)
+	_, err := lbutil.UpdateLBWithRetries(
+		c.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+		c.lbLister,
+		lb.Namespace,
+		lb.Name,
+		func(nlb *lbapi.LoadBalancer) error {
+			if nlb.UID != lb.UID {
+				return fmt.Errorf("loadbalancer %v/%v is recreated", lb.Namespace, lb.Name)
+			}
+			if vips, ref := loadBalancerVIPs(nlb); ref != name || len(vips) != 0 {
+				// changed by user
+				return nil
+			}
+			switch {
+			case nlb.Spec.Providers.Ipvsdr != nil:
+				nlb.Spec.Providers.Ipvsdr.VIPs = []string{vip}
+			case nlb.Spec.Providers.External != nil:
+				nlb.Spec.Providers.External.VIPs = []string{vip}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		log.Errorf("Allocate vip to loadbalancer %v/%v error: %v", lb.Namespace, lb.Name, err)
+		return err
+	}
+	lbutil.RecordEvent(c.client, lb, v1.EventTypeNormal, "VIPAllocated", "Vip %s is allocated from pool %s", vip, name)
+	return nil
+}
+
+// ensureResource creates the CustomResourceDefinition of VIPPool
+func (c *Controller) ensureResource() error {
+	xPreserveUnknownFields := true
+	crd := &apiextensions.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ipamv1alpha2.Plural + "." + ipamv1alpha2.GroupName,
+		},
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Group: ipamv1alpha2.GroupName,
+			Scope: apiextensions.ClusterScoped,
+			Names: apiextensions.CustomResourceDefinitionNames{
+				Plural:   ipamv1alpha2.Plural,
+				Singular: "vippool",
+				Kind:     ipamv1alpha2.Kind,
+				ListKind: ipamv1alpha2.Kind + "List",
+			},
+			Versions: []apiextensions.CustomResourceDefinitionVersion{
+				{
+					Name: ipamv1alpha2.Version,
+					AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+						{
+							Name:     "CIDRS",
+							Type:     "string",
+							JSONPath: ".spec.cidrs",
+						},
+						{
+							Name:     "ALLOCATED",
+							Type:     "integer",
+							JSONPath: ".status.allocated",
+						},
+					},
+					Schema: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type:                   "object",
+							XPreserveUnknownFields: &xPreserveUnknownFields,
+						},
+					},
+					Subresources: &apiextensions.CustomResourceSubresources{
+						Status: &apiextensions.CustomResourceSubresourceStatus{},
+					},
+					Served:  true,
+					Storage: true,
+				},
+			},
+		},
+	}
+	_, err := c.client.Apiextensions().ApiextensionsV1().CustomResourceDefinitions().Create(crd)
+	if errors.IsAlreadyExists(err) {
+		log.Info("Skip the creation for CustomResourceDefinition VIPPool because it has already been created")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Info("Create CustomResourceDefinition VIPPool successfully")
+	return nil
+}
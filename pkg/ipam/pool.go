@@ -0,0 +1,286 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	ipamv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/ipam/v1alpha2"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+// addressRange is the parsed address range of a VIPPool
+type addressRange struct {
+	cidrs   []*net.IPNet
+	exclude []*net.IPNet
+}
+
+func parseRange(spec ipamv1alpha2.VIPPoolSpec) (*addressRange, error) {
+	r := &addressRange{}
+	if len(spec.CIDRs) == 0 {
+		return nil, fmt.Errorf("cidrs is empty")
+	}
+	for _, s := range spec.CIDRs {
+		_, cidr, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		r.cidrs = append(r.cidrs, cidr)
+	}
+	for _, s := range spec.Exclude {
+		if ip := net.ParseIP(s); ip != nil {
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			r.exclude = append(r.exclude, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude %v", s)
+		}
+		r.exclude = append(r.exclude, cidr)
+	}
+	return r, nil
+}
+
+// contains checks whether ip is managed by the pool, the excluded addresses
+// are not managed
+func (r *addressRange) contains(ip net.IP) bool {
+	for _, cidr := range r.exclude {
+		if cidr.Contains(ip) {
+			return false
+		}
+	}
+	for _, cidr := range r.cidrs {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// next returns the lowest address which is not used, nil if the pool is exhausted
+func (r *addressRange) next(used map[string]string) net.IP {
+	for _, cidr := range r.cidrs {
+		ones, bits := cidr.Mask.Size()
+		ip := cidr.IP.Mask(cidr.Mask)
+		broadcast := lastIP(cidr)
+		for ; cidr.Contains(ip); ip = nextIP(ip) {
+			// the network and broadcast addresses of ipv4
+			if bits == 32 && ones <= 30 && (ip.Equal(cidr.IP.Mask(cidr.Mask)) || ip.Equal(broadcast)) {
+				continue
+			}
+			if _, ok := used[ip.String()]; ok || !r.contains(ip) {
+				continue
+			}
+			return ip
+		}
+	}
+	return nil
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
+
+func lastIP(cidr *net.IPNet) net.IP {
+	ip := make(net.IP, len(cidr.IP))
+	for i := range cidr.IP {
+		ip[i] = cidr.IP[i] | ^cidr.Mask[i]
+	}
+	return ip
+}
+
+func namespaceAllowed(spec ipamv1alpha2.VIPPoolSpec, namespace string) bool {
+	if len(spec.Namespaces) == 0 {
+		return true
+	}
+	for _, ns := range spec.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// loadBalancerVIPs returns the vips of ipvsdr or external provider in lb, and
+// the VIPPool referenced by the provider
+func loadBalancerVIPs(lb *lbapi.LoadBalancer) ([]string, string) {
+	var vip, pool string
+	var vips []string
+	switch {
+	case lb.Spec.Providers.Ipvsdr != nil:
+		provider := lb.Spec.Providers.Ipvsdr
+		vip, vips = provider.VIP, provider.VIPs
+		if provider.PoolRef != nil {
+			pool = provider.PoolRef.Name
+		}
+	case lb.Spec.Providers.External != nil:
+		provider := lb.Spec.Providers.External
+		vip, vips = provider.VIP, provider.VIPs
+		if provider.PoolRef != nil {
+			pool = provider.PoolRef.Name
+		}
+	}
+	if vip != "" {
+		vips = append([]string{vip}, vips...)
+	}
+	return vips, pool
+}
+
+// assignment is a vip allocated by pool to a LoadBalancer without vips
+type assignment struct {
+	lb *lbapi.LoadBalancer
+	ip string
+}
+
+// reconcile computes the allocations of pool from lbs. Allocations of deleted
+// LoadBalancers or unused vips are released, vips set by hand in the range are
+// recorded, and LoadBalancers referencing the pool without vips get new ones.
+// The LoadBalancers which can't use their vips are returned with the reasons.
+func reconcile(pool *ipamv1alpha2.VIPPool, r *addressRange, lbs []*lbapi.LoadBalancer) (ipamv1alpha2.VIPPoolStatus, []assignment, map[string]string) {
+	lbs = append([]*lbapi.LoadBalancer(nil), lbs...)
+	sort.Slice(lbs, func(i, j int) bool {
+		if !lbs[i].CreationTimestamp.Equal(&lbs[j].CreationTimestamp) {
+			return lbs[i].CreationTimestamp.Before(&lbs[j].CreationTimestamp)
+		}
+		return lbs[i].Namespace+"/"+lbs[i].Name < lbs[j].Namespace+"/"+lbs[j].Name
+	})
+	byKey := make(map[string]*lbapi.LoadBalancer, len(lbs))
+	for _, lb := range lbs {
+		key, _ := cache.MetaNamespaceKeyFunc(lb)
+		byKey[key] = lb
+	}
+
+	var allocations []ipamv1alpha2.VIPAllocation
+	// ip => owner key
+	used := make(map[string]string)
+	pending := make(map[string]string)
+	for _, a := range pool.Status.Allocations {
+		key := a.Namespace + "/" + a.Name
+		lb, ok := byKey[key]
+		if !ok || lb.UID != a.UID || lb.DeletionTimestamp != nil {
+			continue
+		}
+		vips, ref := loadBalancerVIPs(lb)
+		switch {
+		case containsString(vips, a.IP):
+		case !a.Manual && ref == pool.Name && len(vips) == 0:
+			// allocated, but not set to lb yet
+			pending[key] = a.IP
+		default:
+			continue
+		}
+		if _, ok := used[a.IP]; ok {
+			continue
+		}
+		used[a.IP] = key
+		allocations = append(allocations, a)
+	}
+
+	conflicts := make(map[string]string)
+	assignments := make([]assignment, 0)
+	for _, lb := range lbs {
+		if lb.DeletionTimestamp != nil {
+			continue
+		}
+		key, _ := cache.MetaNamespaceKeyFunc(lb)
+		vips, ref := loadBalancerVIPs(lb)
+		for _, vip := range vips {
+			ip := net.ParseIP(vip)
+			if ip == nil || !r.contains(ip) {
+				continue
+			}
+			owner, ok := used[ip.String()]
+			switch {
+			case ok && owner == key:
+			case ok:
+				conflicts[key] = fmt.Sprintf("vip %v is allocated to loadbalancer %v by pool %v", vip, owner, pool.Name)
+			case !namespaceAllowed(pool.Spec, lb.Namespace):
+				conflicts[key] = fmt.Sprintf("vip %v in pool %v is not allowed in namespace %v", vip, pool.Name, lb.Namespace)
+			default:
+				used[ip.String()] = key
+				allocations = append(allocations, ipamv1alpha2.VIPAllocation{
+					IP:        ip.String(),
+					Namespace: lb.Namespace,
+					Name:      lb.Name,
+					UID:       lb.UID,
+					Manual:    true,
+				})
+			}
+		}
+
+		if ref != pool.Name || len(vips) != 0 {
+			continue
+		}
+		if ip, ok := pending[key]; ok {
+			assignments = append(assignments, assignment{lb: lb, ip: ip})
+			continue
+		}
+		if !namespaceAllowed(pool.Spec, lb.Namespace) {
+			conflicts[key] = fmt.Sprintf("pool %v is not allowed in namespace %v", pool.Name, lb.Namespace)
+			continue
+		}
+		ip := r.next(used)
+		if ip == nil {
+			conflicts[key] = fmt.Sprintf("pool %v is exhausted", pool.Name)
+			continue
+		}
+		used[ip.String()] = key
+		allocations = append(allocations, ipamv1alpha2.VIPAllocation{
+			IP:        ip.String(),
+			Namespace: lb.Namespace,
+			Name:      lb.Name,
+			UID:       lb.UID,
+		})
+		assignments = append(assignments, assignment{lb: lb, ip: ip.String()})
+	}
+
+	sort.Slice(allocations, func(i, j int) bool {
+		a, b := net.ParseIP(allocations[i].IP), net.ParseIP(allocations[j].IP)
+		return bytes.Compare(a.To16(), b.To16()) < 0
+	})
+	status := ipamv1alpha2.VIPPoolStatus{
+		Allocations: allocations,
+		Allocated:   int32(len(allocations)),
+	}
+	return status, assignments, conflicts
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	ipamv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/ipam/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAddressRangeNext(t *testing.T) {
+	r, err := parseRange(ipamv1alpha2.VIPPoolSpec{
+		CIDRs:   []string{"10.0.0.0/30", "10.0.1.0/29"},
+		Exclude: []string{"10.0.1.1", "10.0.1.2/31"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	used := map[string]string{}
+	want := []string{"10.0.0.1", "10.0.0.2", "10.0.1.4", "10.0.1.5", "10.0.1.6"}
+	for _, w := range want {
+		ip := r.next(used)
+		if ip == nil || ip.String() != w {
+			t.Fatalf("next = %v, want %v", ip, w)
+		}
+		used[ip.String()] = "lb"
+	}
+	if ip := r.next(used); ip != nil {
+		t.Errorf("expected exhausted pool, got %v", ip)
+	}
+
+	if _, err := parseRange(ipamv1alpha2.VIPPoolSpec{CIDRs: []string{"10.0.0.1"}}); err == nil {
+		t.Errorf("expected error for invalid cidr")
+	}
+}
+
+func newLoadBalancer(namespace, name string, created int, pool string, vips ...string) *lbapi.LoadBalancer {
+	lb := &lbapi.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			UID:               types.UID(name),
+			CreationTimestamp: metav1.NewTime(time.Unix(int64(created), 0)),
+		},
+	}
+	lb.Spec.Providers.Ipvsdr = &lbapi.IpvsdrProvider{}
+	lb.Spec.Providers.Ipvsdr.VIPs = vips
+	if pool != "" {
+		lb.Spec.Providers.Ipvsdr.PoolRef = &lbapi.VIPPoolReference{Name: pool}
+	}
+	return lb
+}
+
+func TestReconcile(t *testing.T) {
+	pool := &ipamv1alpha2.VIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+		Spec: ipamv1alpha2.VIPPoolSpec{
+			CIDRs:      []string{"10.0.0.0/29"},
+			Namespaces: []string{"default"},
+		},
+		Status: ipamv1alpha2.VIPPoolStatus{
+			Allocations: []ipamv1alpha2.VIPAllocation{
+				{IP: "10.0.0.1", Namespace: "default", Name: "allocated", UID: "allocated"},
+				{IP: "10.0.0.2", Namespace: "default", Name: "deleted", UID: "deleted"},
+				{IP: "10.0.0.3", Namespace: "default", Name: "pending", UID: "pending"},
+			},
+		},
+	}
+	r, err := parseRange(pool.Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lbs := []*lbapi.LoadBalancer{
+		newLoadBalancer("default", "allocated", 1, "pool", "10.0.0.1"),
+		// allocated, but failed to set to lb
+		newLoadBalancer("default", "pending", 2, "pool"),
+		newLoadBalancer("default", "manual", 3, "", "10.0.0.4"),
+		newLoadBalancer("default", "conflict", 4, "", "10.0.0.1"),
+		newLoadBalancer("default", "new", 5, "pool"),
+		newLoadBalancer("other", "forbidden", 6, "pool"),
+		newLoadBalancer("default", "outside", 7, "", "192.168.0.1"),
+	}
+
+	status, assignments, conflicts := reconcile(pool, r, lbs)
+
+	want := map[string]string{
+		"10.0.0.1": "allocated",
+		"10.0.0.2": "new",
+		"10.0.0.3": "pending",
+		"10.0.0.4": "manual",
+	}
+	if int(status.Allocated) != len(want) {
+		t.Fatalf("got allocations %v, want %v", status.Allocations, want)
+	}
+	for _, a := range status.Allocations {
+		if want[a.IP] != a.Name {
+			t.Errorf("vip %v is allocated to %v, want %v", a.IP, a.Name, want[a.IP])
+		}
+		if a.Manual != (a.Name == "manual") {
+			t.Errorf("allocation %v manual = %v", a.Name, a.Manual)
+		}
+	}
+
+	assigned := map[string]string{}
+	for _, a := range assignments {
+		assigned[a.lb.Name] = a.ip
+	}
+	if len(assigned) != 2 || assigned["pending"] != "10.0.0.3" || assigned["new"] != "10.0.0.2" {
+		t.Errorf("unexpected assignments %v", assigned)
+	}
+
+	if len(conflicts) != 2 || conflicts["default/conflict"] == "" || conflicts["other/forbidden"] == "" {
+		t.Errorf("unexpected conflicts %v", conflicts)
+	}
+}
+
+func TestVIPChecker(t *testing.T) {
+	spec := ipamv1alpha2.VIPPoolSpec{CIDRs: []string{"10.0.0.0/24"}, Namespaces: []string{"default"}}
+	r, _ := parseRange(spec)
+	c := &Controller{
+		pools: []pool{{
+			VIPPool: ipamv1alpha2.VIPPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec:       spec,
+				Status: ipamv1alpha2.VIPPoolStatus{
+					Allocations: []ipamv1alpha2.VIPAllocation{{IP: "10.0.0.1", Namespace: "default", Name: "a"}},
+				},
+			},
+			r: r,
+		}},
+	}
+
+	lb := newLoadBalancer("default", "b", 0, "", "10.0.0.1")
+	err := lbapi.ValidateProviders(lb.Spec.Providers, c.VIPChecker(lb))
+	if err == nil || !strings.Contains(err.Error(), "allocated to loadbalancer default/a") {
+		t.Errorf("expected conflict with a, got %v", err)
+	}
+	if err := c.VIPChecker(newLoadBalancer("default", "a", 0, ""))("10.0.0.1"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := c.VIPChecker(newLoadBalancer("other", "c", 0, ""))("10.0.0.2"); err == nil {
+		t.Errorf("expected namespace not allowed")
+	}
+	if err := c.VIPChecker(newLoadBalancer("other", "c", 0, ""))("192.168.0.1"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
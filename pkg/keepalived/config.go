@@ -0,0 +1,293 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keepalived renders the keepalived and IPVS configuration of ipvsdr
+// provider from LoadBalancer, provider pods only apply the rendered files.
+package keepalived
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// MasterGroup is the name of the master keepalived group
+	MasterGroup = "master"
+
+	// the variables defined by provider on each node
+	nodeIfaceVar = "$NODE_IFACE"
+	nodeIPVar    = "$NODE_IP"
+	nodeIPv6Var  = "$NODE_IP6"
+
+	defaultVRRPVersion = 2
+)
+
+// NodeIPSource decides where the ips of a node come from, the annotation
+// takes precedence over the label, the internal ip is used at last. Values
+// of the label and annotation can be comma separated ips of both families.
+type NodeIPSource struct {
+	Label      string
+	Annotation string
+	// IPv6Label and IPv6Annotation store ipv6 addresses of node if they are
+	// not in Label and Annotation
+	IPv6Label      string
+	IPv6Annotation string
+}
+
+// IP returns the ip of family of node, annotation overrides the annotations of source
+func (s NodeIPSource) IP(node *v1.Node, annotation string, family lbapi.IPFamily) string {
+	candidates := []string{}
+	if annotation != "" {
+		candidates = append(candidates, node.Annotations[annotation])
+	} else {
+		candidates = append(candidates, node.Annotations[s.Annotation], node.Annotations[s.IPv6Annotation])
+	}
+	candidates = append(candidates, node.Labels[s.Label], node.Labels[s.IPv6Label])
+	for _, value := range candidates {
+		if ip := ipOfFamily(strings.Split(value, ","), family); ip != "" {
+			return ip
+		}
+	}
+
+	addresses := []string{}
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == v1.NodeInternalIP {
+			addresses = append(addresses, addr.Address)
+		}
+	}
+	return ipOfFamily(addresses, family)
+}
+
+// ipOfFamily returns the first ip of family in ips
+func ipOfFamily(ips []string, family lbapi.IPFamily) string {
+	for _, ip := range ips {
+		ip = strings.TrimSpace(ip)
+		if lbapi.IPFamilyOf(ip) == family {
+			return ip
+		}
+	}
+	return ""
+}
+
+// VirtualServer is a fwmark based IPVS virtual server of a vip
+type VirtualServer struct {
+	VIP    string
+	Fwmark int
+	// IPv6 means the vip is an ipv6 address
+	IPv6 bool
+}
+
+// Instance is a VRRP instance of a keepalived group announcing the vips of an
+// ip family, and the virtual servers of the vips
+type Instance struct {
+	Name           string
+	Family         lbapi.IPFamily
+	VRRPVersion    int
+	VIPs           []string
+	VirtualServers []VirtualServer
+	// RealServers are the ips of nodes, traffic of vips is forwarded to them
+	RealServers []string
+}
+
+// Group is a keepalived group, the VRRP instances of ipv4 and ipv6 share the vrid
+type Group struct {
+	Name      string
+	Vrid      int
+	Scheduler lbapi.IpvsScheduler
+	HAMode    lbapi.HAMode
+	Iface     string
+	Instances []Instance
+}
+
+// Config is the keepalived and IPVS configuration of a LoadBalancer
+type Config struct {
+	// Name is the key of LoadBalancer
+	Name string
+	// Chain is the iptables chain marking packets of vips
+	Chain  string
+	Groups []Group
+	// Ports are the proxy ports of vips in the format of iptables multiport,
+	// at most 15 ports a rule
+	Ports [][]string
+}
+
+// GroupName returns the name of the ith group, master is the first one
+func GroupName(i int) string {
+	if i == 0 {
+		return MasterGroup
+	}
+	return fmt.Sprintf("slave-%d", i-1)
+}
+
+// Fwmark returns the firewall mark of the ith vip of a group, fwmark of the
+// group is unique in cluster. vrids can't be used here, they are only unique in
+// a network segment but groups of different segments may run on the same node.
+func Fwmark(fwmark, i int) int {
+	return fwmark<<16 | (i + 1)
+}
+
+// NewConfig generates the configuration of lb. vrids and fwmarks are allocated for
+// the master and slave groups, nodes are the nodes which provider runs on.
+func NewConfig(lb *lbapi.LoadBalancer, vrids, fwmarks []int, nodes []*v1.Node, source NodeIPSource) (*Config, error) {
+	provider := lb.Spec.Providers.Ipvsdr
+	if provider == nil {
+		return nil, fmt.Errorf("loadbalancer %v has no ipvsdr provider", lb.Name)
+	}
+	specs := append([]lbapi.KeepalivedProvider{provider.KeepalivedProvider}, provider.Slaves...)
+	if len(vrids) != len(specs) {
+		return nil, fmt.Errorf("got %d vrids for %d keepalived groups", len(vrids), len(specs))
+	}
+	if len(fwmarks) != len(specs) {
+		return nil, fmt.Errorf("got %d fwmarks for %d keepalived groups", len(fwmarks), len(specs))
+	}
+
+	key := lb.Namespace + "/" + lb.Name
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	config := &Config{
+		Name:  key,
+		Chain: fmt.Sprintf("IPVSDR-%08X", h.Sum32()),
+		Ports: multiports(lbutil.ProxyPortRanges(lb)),
+	}
+
+	for i, spec := range specs {
+		vips := lbapi.KeepalivedVIPs(spec)
+		if len(vips) == 0 {
+			return nil, fmt.Errorf("group %v has no vip", GroupName(i))
+		}
+		if vrids[i] < 1 || vrids[i] > 255 {
+			return nil, fmt.Errorf("vrid %d of group %v is not in range [1, 255]", vrids[i], GroupName(i))
+		}
+		if fwmarks[i] < 1 || fwmarks[i] > 0xffff {
+			return nil, fmt.Errorf("fwmark %d of group %v is not in range [1, 65535]", fwmarks[i], GroupName(i))
+		}
+		g := Group{
+			Name:      GroupName(i),
+			Vrid:      vrids[i],
+			Scheduler: spec.Scheduler,
+			HAMode:    spec.HAMode,
+			Iface:     nodeIfaceVar,
+		}
+		if g.Scheduler == "" {
+			// slaves use the scheduler of ipvsdr by default
+			g.Scheduler = specs[0].Scheduler
+		}
+		if g.HAMode == "" {
+			g.HAMode = lbapi.ActivePassiveHA
+		}
+		annotation := ""
+		if spec.Bind != nil {
+			if spec.Bind.Iface != "" {
+				g.Iface = spec.Bind.Iface
+			}
+			annotation = spec.Bind.NodeIPAnnotation
+		}
+
+		// fwmarks are numbered by the index of vip in group
+		marks := make(map[string]int)
+		for j, vip := range vips {
+			if lbapi.IPFamilyOf(vip) == "" {
+				return nil, fmt.Errorf("vip %v of group %v is invalid", vip, g.Name)
+			}
+			marks[vip] = Fwmark(fwmarks[i], j)
+		}
+
+		for _, family := range lbapi.VIPFamilies(vips) {
+			instance := Instance{
+				Name:        fmt.Sprintf("%s_%s_%s", lb.Namespace, lb.Name, g.Name),
+				Family:      family.Family,
+				VRRPVersion: spec.VRRPVersion,
+				VIPs:        family.VIPs,
+			}
+			if instance.VRRPVersion == 0 {
+				instance.VRRPVersion = defaultVRRPVersion
+			}
+			nodeIP := nodeIPVar
+			if family.Family == lbapi.IPv6Family {
+				// VRRPv2 doesn't support ipv6
+				instance.Name += "_v6"
+				instance.VRRPVersion = 3
+				nodeIP = nodeIPv6Var
+			}
+			for _, vip := range family.VIPs {
+				instance.VirtualServers = append(instance.VirtualServers, VirtualServer{
+					VIP:    vip,
+					Fwmark: marks[vip],
+					IPv6:   family.Family == lbapi.IPv6Family,
+				})
+			}
+			if g.HAMode == lbapi.ActivePassiveHA {
+				// only the node holding vips serves
+				instance.RealServers = []string{nodeIP}
+			} else {
+				instance.RealServers = realServers(nodes, source, annotation, family.Family)
+			}
+			g.Instances = append(g.Instances, instance)
+		}
+		config.Groups = append(config.Groups, g)
+	}
+	return config, nil
+}
+
+// realServers returns the sorted and distinct ips of family of nodes
+func realServers(nodes []*v1.Node, source NodeIPSource, annotation string, family lbapi.IPFamily) []string {
+	seen := make(map[string]bool)
+	ips := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		ip := source.IP(node, annotation, family)
+		if ip == "" || seen[ip] {
+			continue
+		}
+		seen[ip] = true
+		ips = append(ips, ip)
+	}
+	sort.Strings(ips)
+	return ips
+}
+
+// multiports splits port ranges into groups of iptables multiport, a range
+// takes two of the 15 ports
+func multiports(ranges []lbapi.PortRange) [][]string {
+	const max = 15
+	groups := [][]string{}
+	var current []string
+	count := 0
+	for _, r := range ranges {
+		port := fmt.Sprintf("%d", r.Start)
+		n := 1
+		if r.Start != r.End {
+			port = fmt.Sprintf("%d:%d", r.Start, r.End)
+			n = 2
+		}
+		if count+n > max {
+			groups = append(groups, current)
+			current, count = nil, 0
+		}
+		current = append(current, port)
+		count += n
+	}
+	if len(current) > 0 {
+		groups = append(groups, current)
+	}
+	return groups
+}
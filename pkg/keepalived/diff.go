@@ -0,0 +1,97 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keepalived
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Diff returns the changed lines from old to new in the format of unified diff
+// without context, lines are prefixed by - or +
+func Diff(old, new string) []string {
+	a := strings.Split(strings.TrimSuffix(old, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(new, "\n"), "\n")
+	if old == "" {
+		a = nil
+	}
+	if new == "" {
+		b = nil
+	}
+
+	// lcs[i][j] is the length of longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	lines := []string{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] > lcs[i+1][j]):
+			lines = append(lines, "+"+b[j])
+			j++
+		default:
+			lines = append(lines, "-"+a[i])
+			i++
+		}
+	}
+	return lines
+}
+
+// DiffData returns the changed lines of each key from old to new ConfigMap data
+func DiffData(old, new map[string]string) []string {
+	keys := make(map[string]bool)
+	for k := range old {
+		keys[k] = true
+	}
+	for k := range new {
+		keys[k] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	lines := []string{}
+	for _, k := range sorted {
+		changed := Diff(old[k], new[k])
+		if len(changed) == 0 {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("--- %s", k))
+		lines = append(lines, changed...)
+	}
+	return lines
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keepalived
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+const (
+	// ConfigKey is the key of keepalived.conf in ConfigMap
+	ConfigKey = "keepalived.conf"
+	// RulesKey is the key of ipv4 fwmark rules in ConfigMap, in the format of iptables-restore
+	RulesKey = "fwmark.rules"
+	// Rules6Key is the key of ipv6 fwmark rules in ConfigMap, in the format of ip6tables-restore
+	Rules6Key = "fwmark6.rules"
+)
+
+var funcs = template.FuncMap{
+	"join":          strings.Join,
+	"activePassive": func(mode lbapi.HAMode) bool { return mode == lbapi.ActivePassiveHA },
+}
+
+var configTemplate = template.Must(template.New(ConfigKey).Funcs(funcs).Parse(
+	`# generated by loadbalancer-controller for {{.Name}}, do not edit
+# {{"$"}}NODE_IFACE, {{"$"}}NODE_PRIORITY, {{"$"}}NODE_IP and {{"$"}}NODE_IP6 are defined by provider on each node
+{{- range .Groups}}
+{{- $group := .}}
+{{- range .Instances}}
+{{- $instance := .}}
+
+vrrp_instance {{.Name}} {
+    state BACKUP
+    version {{.VRRPVersion}}
+    interface {{$group.Iface}}
+    virtual_router_id {{$group.Vrid}}
+    priority {{"$"}}NODE_PRIORITY
+{{- if activePassive $group.HAMode}}
+    nopreempt
+{{- end}}
+    advert_int 1
+    virtual_ipaddress {
+{{- range .VIPs}}
+        {{.}}
+{{- end}}
+    }
+}
+{{- range .VirtualServers}}
+
+virtual_server fwmark {{.Fwmark}} {
+    delay_loop 5
+    lb_algo {{$group.Scheduler}}
+    lb_kind DR
+{{- if .IPv6}}
+    ip_family inet6
+{{- end}}
+{{- range $instance.RealServers}}
+
+    real_server {{.}} 0 {
+        weight 1
+    }
+{{- end}}
+}
+{{- end}}
+{{- end}}
+{{- end}}
+`))
+
+var rulesTemplate = template.Must(template.New(RulesKey).Funcs(funcs).Parse(
+	`# generated by loadbalancer-controller for {{.Config.Name}}, do not edit
+*mangle
+:{{.Config.Chain}} - [0:0]
+-F {{.Config.Chain}}
+{{- $config := .Config}}
+{{- $ipv6 := .IPv6}}
+{{- range .Config.Groups}}
+{{- range .Instances}}
+{{- range .VirtualServers}}
+{{- if eq .IPv6 $ipv6}}
+{{- $vs := .}}
+{{- range $config.Ports}}
+-A {{$config.Chain}} -d {{$vs.VIP}} -p tcp -m multiport --dports {{join . ","}} -j MARK --set-mark {{$vs.Fwmark}}
+-A {{$config.Chain}} -d {{$vs.VIP}} -p udp -m multiport --dports {{join . ","}} -j MARK --set-mark {{$vs.Fwmark}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+COMMIT
+`))
+
+// Render renders the keepalived.conf and fwmark rules of config, the result
+// is the data of ConfigMap mounted into provider pods
+func Render(config *Config) (map[string]string, error) {
+	data := make(map[string]string)
+
+	buf := &bytes.Buffer{}
+	if err := configTemplate.Execute(buf, config); err != nil {
+		return nil, err
+	}
+	data[ConfigKey] = buf.String()
+
+	for key, ipv6 := range map[string]bool{RulesKey: false, Rules6Key: true} {
+		buf := &bytes.Buffer{}
+		err := rulesTemplate.Execute(buf, struct {
+			Config *Config
+			IPv6   bool
+		}{config, ipv6})
+		if err != nil {
+			return nil, err
+		}
+		data[key] = buf.String()
+	}
+	return data, nil
+}
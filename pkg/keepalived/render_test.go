@@ -0,0 +1,224 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keepalived
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func newNode(name string, internalIPs []string, labels, annotations map[string]string) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations},
+	}
+	for _, ip := range internalIPs {
+		node.Status.Addresses = append(node.Status.Addresses, v1.NodeAddress{Type: v1.NodeInternalIP, Address: ip})
+	}
+	return node
+}
+
+func newLoadBalancer(provider lbapi.IpvsdrProvider) *lbapi.LoadBalancer {
+	lb := &lbapi.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "lb"},
+	}
+	lb.Spec.Providers.Ipvsdr = &provider
+	return lb
+}
+
+// format joins the rendered files in the order of keys
+func format(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	b := &strings.Builder{}
+	for _, k := range keys {
+		b.WriteString("==> " + k + " <==\n")
+		b.WriteString(data[k])
+	}
+	return b.String()
+}
+
+func TestRender(t *testing.T) {
+	source := NodeIPSource{Label: "node-ip", Annotation: "node-ip", IPv6Annotation: "node-ip6"}
+	nodes := []*v1.Node{
+		newNode("node2", []string{"192.168.0.2", "fd00::2"}, nil, nil),
+		newNode("node1", []string{"192.168.0.1"}, map[string]string{"node-ip": "10.0.0.1,fd00::1"}, nil),
+		newNode("node3", []string{"192.168.0.3"}, nil, map[string]string{"node-ip": "10.0.0.3", "node-ip6": "fd00::3", "storage-ip": "10.0.1.3"}),
+	}
+
+	cases := []struct {
+		name     string
+		provider lbapi.IpvsdrProvider
+		vrids    []int
+	}{
+		{
+			name: "active-active",
+			provider: lbapi.IpvsdrProvider{
+				KeepalivedProvider: lbapi.KeepalivedProvider{
+					VIP:       "10.0.0.100",
+					Scheduler: lbapi.IpvsSchedulerRR,
+					HAMode:    lbapi.ActiveActiveHA,
+				},
+			},
+			vrids: []int{1},
+		},
+		{
+			name: "slaves",
+			provider: lbapi.IpvsdrProvider{
+				KeepalivedProvider: lbapi.KeepalivedProvider{
+					VIPs:      []string{"10.0.0.100", "fd00::100"},
+					Scheduler: lbapi.IpvsSchedulerWLC,
+				},
+				Slaves: []lbapi.KeepalivedProvider{
+					{
+						VIPs:      []string{"10.0.1.100"},
+						Scheduler: lbapi.IpvsSchedulerSH,
+						HAMode:    lbapi.ActiveActiveHA,
+						Bind:      &lbapi.KeepalivedBind{Iface: "eth1", NodeIPAnnotation: "storage-ip"},
+					},
+				},
+			},
+			vrids: []int{1, 2},
+		},
+		{
+			name: "dual-stack",
+			provider: lbapi.IpvsdrProvider{
+				KeepalivedProvider: lbapi.KeepalivedProvider{
+					VIPs:        []string{"fd00::100", "10.0.0.100"},
+					Scheduler:   lbapi.IpvsSchedulerRR,
+					HAMode:      lbapi.ActiveActiveHA,
+					VRRPVersion: 3,
+				},
+			},
+			vrids: []int{7},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config, err := NewConfig(newLoadBalancer(c.provider), c.vrids, c.vrids, nodes, source)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			data, err := Render(config)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			got := format(data)
+
+			golden := filepath.Join("testdata", c.name+".golden")
+			if *update {
+				if err := ioutil.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("rendered config differs from %v:\n%v", golden, strings.Join(Diff(string(want), got), "\n"))
+			}
+		})
+	}
+}
+
+func TestNewConfigErrors(t *testing.T) {
+	lb := newLoadBalancer(lbapi.IpvsdrProvider{
+		KeepalivedProvider: lbapi.KeepalivedProvider{VIP: "10.0.0.100", Scheduler: lbapi.IpvsSchedulerRR},
+	})
+	if _, err := NewConfig(lb, []int{1, 2}, []int{1}, nil, NodeIPSource{}); err == nil {
+		t.Errorf("expected error for mismatched vrids")
+	}
+	if _, err := NewConfig(lb, []int{0}, []int{1}, nil, NodeIPSource{}); err == nil {
+		t.Errorf("expected error for invalid vrid")
+	}
+	if _, err := NewConfig(lb, []int{1}, []int{1, 2}, nil, NodeIPSource{}); err == nil {
+		t.Errorf("expected error for mismatched fwmarks")
+	}
+	if _, err := NewConfig(lb, []int{1}, []int{0x10000}, nil, NodeIPSource{}); err == nil {
+		t.Errorf("expected error for invalid fwmark")
+	}
+}
+
+func TestNewConfigDefaultScheduler(t *testing.T) {
+	lb := newLoadBalancer(lbapi.IpvsdrProvider{
+		KeepalivedProvider: lbapi.KeepalivedProvider{VIP: "10.0.0.100", Scheduler: lbapi.IpvsSchedulerWLC},
+		Slaves:             []lbapi.KeepalivedProvider{{VIP: "10.0.1.100"}},
+	})
+	if err := lbapi.ValidateProviders(lb.Spec.Providers); err != nil {
+		t.Fatalf("slave without scheduler is invalid: %v", err)
+	}
+	config, err := NewConfig(lb, []int{1, 2}, []int{1, 2}, nil, NodeIPSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := config.Groups[1].Scheduler; got != lbapi.IpvsSchedulerWLC {
+		t.Errorf("got scheduler %v of slave, want %v", got, lbapi.IpvsSchedulerWLC)
+	}
+}
+
+func TestNodeIP(t *testing.T) {
+	source := NodeIPSource{Label: "node-ip", IPv6Label: "node-ip6"}
+	node := newNode("node", []string{"192.168.0.1", "fd00::1"}, map[string]string{"node-ip6": "fd01::1"}, nil)
+	if ip := source.IP(node, "", lbapi.IPv4Family); ip != "192.168.0.1" {
+		t.Errorf("got ipv4 %v, want the internal ip", ip)
+	}
+	if ip := source.IP(node, "", lbapi.IPv6Family); ip != "fd01::1" {
+		t.Errorf("got ipv6 %v, want the label", ip)
+	}
+	node.Status.Addresses = nil
+	if ip := source.IP(node, "", lbapi.IPv4Family); ip != "" {
+		t.Errorf("got ipv4 %v, want empty", ip)
+	}
+}
+
+func TestMultiports(t *testing.T) {
+	ranges := []lbapi.PortRange{{Start: 80, End: 80}, {Start: 443, End: 443}}
+	for i := 0; i < 7; i++ {
+		ranges = append(ranges, lbapi.PortRange{Start: int32(20000 + i*10), End: int32(20005 + i*10)})
+	}
+	got := multiports(ranges)
+	if len(got) != 2 || len(got[0]) != 8 || got[1][0] != "20060:20065" {
+		t.Errorf("unexpected multiports %v", got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	got := Diff("a\nb\nc\n", "a\nc\nd\n")
+	want := []string{"-b", "+d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := Diff("", "a\n"); !reflect.DeepEqual(got, []string{"+a"}) {
+		t.Errorf("got %v, want [+a]", got)
+	}
+}
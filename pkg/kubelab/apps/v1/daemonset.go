@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/InVisionApp/conjungo"
+	libcorev1 "github.com/caicloud/loadbalancer-controller/pkg/kubelab/core/v1"
+	libmetav1 "github.com/caicloud/loadbalancer-controller/pkg/kubelab/meta/v1"
+	"github.com/mattbaird/jsonpatch"
+
+	v1 "k8s.io/api/apps/v1"
+	"k8s.io/klog"
+	k8sappsv1 "k8s.io/kubernetes/pkg/apis/apps/v1"
+)
+
+// DaemonSetLab contains some utils for DaemonSets
+type DaemonSetLab interface {
+	// Merge merges the following fields from src to dst
+	// - ObjectMeta.Labels
+	// - ObjectMeta.Annotations
+	// - Spec
+	Merge(dst, src *v1.DaemonSet) error
+	// IsEqual checks if the given two daemonsets are equal
+	//
+	// If ignoreFields is provided, the function will be call on each
+	// daemonset's deepcopy(be free to mutate it) before comparing to
+	// ignore specified fields.
+	IsEqual(a, b *v1.DaemonSet, ignoreFields func(*v1.DaemonSet)) bool
+}
+
+type daemonSetImpl struct{}
+
+func (l *daemonSetImpl) Merge(dst, src *v1.DaemonSet) error {
+	setObjectDefaultsDaemonSets(src)
+	libmetav1.DefaultObjectMetaLab.Merge(&dst.ObjectMeta, &src.ObjectMeta)
+	// merge spec
+	return conjungo.Merge(&dst.Spec, src.Spec, newOptions())
+}
+
+func (l *daemonSetImpl) IsEqual(a, b *v1.DaemonSet, ignoreFields func(*v1.DaemonSet)) bool {
+	acopy := a.DeepCopy()
+	bcopy := b.DeepCopy()
+
+	setObjectDefaultsDaemonSets(acopy)
+	setObjectDefaultsDaemonSets(bcopy)
+
+	if ignoreFields != nil {
+		ignoreFields(acopy)
+		ignoreFields(bcopy)
+	}
+
+	if !libmetav1.DefaultObjectMetaLab.IsEqual(&acopy.ObjectMeta, &bcopy.ObjectMeta) {
+		klog.V(2).Infof("daemonset %v/%v metadata changed", a.Namespace, a.Name)
+		return false
+	}
+
+	aSpecBytes, _ := json.Marshal(acopy.Spec)
+	bSpecBytes, _ := json.Marshal(bcopy.Spec)
+
+	if !reflect.DeepEqual(aSpecBytes, bSpecBytes) {
+		if klog.V(2) {
+			if patch, err := jsonpatch.CreatePatch(aSpecBytes, bSpecBytes); err == nil {
+				klog.Infof("daemonset %v/%v spec changed, the patch is: %v", a.Namespace, a.Name, patch)
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func setObjectDefaultsDaemonSets(in *v1.DaemonSet) {
+	k8sappsv1.SetObjectDefaults_DaemonSet(in)
+	libcorev1.DefaultPodLab.DropDisabledAlphaFields(&in.Spec.Template.Spec)
+}
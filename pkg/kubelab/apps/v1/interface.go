@@ -3,6 +3,7 @@ package v1
 // Interface provides access to all the informers in this group version.
 type Interface interface {
 	Deployments() DeploymentLab
+	DaemonSets() DaemonSetLab
 }
 
 type version struct {
@@ -17,3 +18,8 @@ func New() Interface {
 func (g *version) Deployments() DeploymentLab {
 	return &deploymentImpl{}
 }
+
+// DaemonSets returns a DaemonSetLab.
+func (g *version) DaemonSets() DaemonSetLab {
+	return &daemonSetImpl{}
+}
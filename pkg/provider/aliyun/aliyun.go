@@ -0,0 +1,457 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aliyun
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	controllerutil "github.com/caicloud/clientset/util/controller"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	"github.com/caicloud/loadbalancer-controller/pkg/toleration"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	log "k8s.io/klog"
+)
+
+const (
+	providerNameSuffix = "-provider-aliyun"
+	providerName       = "aliyun"
+)
+
+type aliyun struct {
+	// NodeProvider syncs the SLB with the nodes of lb, and resyncs it to retry
+	// the SLB being provisioned and correct the drift made in console
+	*lbutil.NodeProvider
+	initialized bool
+	// image is the provider following the contract in docs/images.md, there is no default one
+	image         string
+	allowedImages []string
+
+	// slbEndpoint is the endpoint of SLB OpenAPI
+	slbEndpoint string
+
+	client kubernetes.Interface
+
+	lbLister  lblisters.LoadBalancerLister
+	dLister   appslisters.DeploymentLister
+	podLister corelisters.PodLister
+}
+
+// New creates a new aliyun provider plugin
+func New() plugin.Interface {
+	return &aliyun{}
+}
+
+func (f *aliyun) Init(cfg config.Configuration, sif informers.SharedInformerFactory) {
+	if f.initialized {
+		return
+	}
+	f.initialized = true
+
+	log.Info("Initialize the aliyun provider")
+
+	// set config
+	f.image = cfg.Providers.Aliyun.Image
+	f.allowedImages = cfg.AllowedImages
+	f.slbEndpoint = cfg.Providers.Aliyun.SLBEndpoint
+	f.client = cfg.Client
+
+	// initialize controller
+	dInformer := sif.Native().Apps().V1().Deployments()
+	podInfomer := sif.Native().Core().V1().Pods()
+
+	f.lbLister = sif.Custom().Loadbalance().V1alpha2().LoadBalancers().Lister()
+	f.dLister = dInformer.Lister()
+	f.podLister = podInfomer.Lister()
+	f.NodeProvider = lbutil.NewNodeProvider(providerName, sif, cfg.Providers.Aliyun.ResyncPeriod, f)
+
+	dInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDeployment(f.lbLister, f.Queue(), f.deploymentFiltered))
+	podInfomer.Informer().AddEventHandler(lbutil.NewEventHandlerForSyncStatusWithPod(f.lbLister, f.podLister, f.Queue(), f.podFiltered))
+}
+
+func (f *aliyun) selector(lb *lbapi.LoadBalancer) labels.Set {
+	return labels.Set{
+		lbapi.LabelKeyCreatedBy: fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name),
+		lbapi.LabelKeyProvider:  providerName,
+	}
+}
+
+// filter Deployment that controller does not care
+func (f *aliyun) deploymentFiltered(obj *appsv1.Deployment) bool {
+	return f.filteredByLabel(obj)
+}
+
+// filter pod that controller does not care
+func (f *aliyun) podFiltered(obj *v1.Pod) bool {
+	return f.filteredByLabel(obj)
+}
+
+func (f *aliyun) filteredByLabel(obj metav1.ObjectMetaAccessor) bool {
+	// obj.Labels
+	selector := labels.Set{lbapi.LabelKeyProvider: providerName}.AsSelector()
+	match := selector.Matches(labels.Set(obj.GetObjectMeta().GetLabels()))
+
+	return !match
+}
+
+// Enabled checks whether lb uses aliyun provider
+func (f *aliyun) Enabled(lb *lbapi.LoadBalancer) bool {
+	return lb.Spec.Providers.Aliyun != nil
+}
+
+// Pending is always true, the SLB may be provisioning or changed in console,
+// and nothing in cluster changes then
+func (f *aliyun) Pending(lb *lbapi.LoadBalancer) bool {
+	return true
+}
+
+// Release deletes the provider deployment and status of lb, SLB is left behind
+func (f *aliyun) Release(lb *lbapi.LoadBalancer) error {
+	err := f.deleteDeployments(lb)
+	if err != nil {
+		return err
+	}
+	if status := lb.Status.ProvidersStatuses.Aliyun; status != nil && status.LoadBalancerID != "" {
+		// the credentials are gone with the spec
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "SLBOrphaned", "SLB %v is not deleted, aliyun provider is removed from spec", status.LoadBalancerID)
+	}
+	return f.updateStatus(lb, nil)
+}
+
+// Sync syncs the provider deployment and the SLB of lb with the nodes of lb
+func (f *aliyun) Sync(lb *lbapi.LoadBalancer, nodes []*v1.Node) error {
+	if deployErr := f.syncDeployment(lb); deployErr != nil {
+		// SLB is not synced without the provider, the error is reported in status
+		if err := f.syncStatus(lb, nil, deployErr); err != nil {
+			return err
+		}
+		return deployErr
+	}
+
+	attr, syncErr := f.syncSLB(lb, nodes)
+	if err := f.syncStatus(lb, attr, syncErr); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+func (f *aliyun) getDeploymentsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv1.Deployment, error) {
+
+	// construct selector
+	selector := f.selector(lb).AsSelector()
+
+	// list all
+	dList, err := f.dLister.Deployments(lb.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	// If any adoptions are attempted, we should first recheck for deletion with
+	// an uncached quorum read sometime after listing deployment (see kubernetes#42639).
+	canAdoptFunc := controllerutil.RecheckDeletionTimestamp(func() (metav1.Object, error) {
+		// fresh lb
+		fresh, err := f.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace).Get(lb.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if fresh.UID != lb.UID {
+			return nil, fmt.Errorf("original LoadBalancer %v/%v is gone: got uid %v, wanted %v", lb.Namespace, lb.Name, fresh.UID, lb.UID)
+		}
+		return fresh, nil
+	})
+
+	cm := controllerutil.NewDeploymentControllerRefManager(f.client.Native(), lb, selector, api.ControllerKind, canAdoptFunc)
+	return cm.Claim(dList)
+}
+
+// syncDeployment generates desired deployment from lb and compares it with existing
+// deployment. There is no PodDisruptionBudget, the single provider pod is recreated
+// on changes and can't be kept available.
+func (f *aliyun) syncDeployment(lb *lbapi.LoadBalancer) error {
+	provider := lb.Spec.Providers.Aliyun
+	if f.image == "" && provider.Image == "" {
+		// there is no default provider, it is provided by users following docs/images.md
+		err := fmt.Errorf("no aliyun provider image, set it by --provider-aliyun or spec.providers.aliyun.image")
+		lbutil.RecordEventOnChange(f.client, lb, "aliyun/provider", v1.EventTypeWarning, "NoProviderImage", "%v", err)
+		return err
+	}
+	lbutil.ForgetEvent(lb, "aliyun/provider")
+
+	dps, err := f.getDeploymentsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+
+	desiredDeploy, err := f.generateDeployment(lb, f.image)
+	if err != nil {
+		log.Errorf("Generate deployment for loadbalancer %v error: %v", lb.Name, err)
+		return err
+	}
+
+	// update
+	updated := false
+
+	for _, dp := range dps {
+		// two conditions will trigger controller to scale down deployment
+		// 1. deployment does not have auto-generated prefix
+		// 2. if there are more than one active controllers, there may be many valid deployments.
+		//    But we only need one.
+		if !strings.HasPrefix(dp.Name, lb.Name+providerNameSuffix) || updated {
+			if *dp.Spec.Replicas == 0 {
+				continue
+			}
+			// scale unexpected deployment replicas to zero
+			copy := dp.DeepCopy()
+			replica := int32(0)
+			copy.Spec.Replicas = &replica
+			_, _ = f.client.Native().AppsV1().Deployments(lb.Namespace).Update(copy)
+			continue
+		}
+
+		updated = true
+		if !lbutil.IsStatic(lb) {
+			// do not change deployment if the loadbalancer is static
+			merged, changed := lbutil.MergeDeployment(dp, desiredDeploy, false)
+			if changed {
+				log.Infof("Sync aliyun deployment %v for lb %v", dp.Name, lb.Name)
+				_, err := f.client.Native().AppsV1().Deployments(lb.Namespace).Update(merged)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// len(dps) == 0 or no deployment's name match desired deployment
+	if !updated {
+		// create deployment
+		log.Infof("Create aliyun deployment %v for lb %v", desiredDeploy.Name, lb.Name)
+		_, err := f.client.Native().AppsV1().Deployments(lb.Namespace).Create(desiredDeploy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncSLB syncs the SLB of lb with the nodes of lb
+func (f *aliyun) syncSLB(lb *lbapi.LoadBalancer, nodes []*v1.Node) (*slbAttribute, error) {
+	c, err := f.newSLBClient(lb)
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "InvalidCredentials", "%v", err)
+		return nil, err
+	}
+	attr, created, err := ensureSLB(c, lb, backendServers(nodes))
+	if created && attr != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeNormal, "SLBCreated", "SLB %v is created", attr.LoadBalancerID)
+	}
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "SLBSyncFailed", "Sync SLB error: %v", err)
+		return attr, err
+	}
+	return attr, nil
+}
+
+// newSLBClient creates a SLB client with the credentials Secret of lb
+func (f *aliyun) newSLBClient(lb *lbapi.LoadBalancer) (*slbClient, error) {
+	spec := lb.Spec.Providers.Aliyun
+	secret, err := f.client.Native().CoreV1().Secrets(lb.Namespace).Get(spec.CredentialsSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get credentials secret %v error: %v", spec.CredentialsSecret, err)
+	}
+	id := string(secret.Data[lbapi.AliyunAccessKeyIDKey])
+	key := string(secret.Data[lbapi.AliyunAccessKeySecretKey])
+	if id == "" || key == "" {
+		return nil, fmt.Errorf("credentials secret %v must have %v and %v", spec.CredentialsSecret, lbapi.AliyunAccessKeyIDKey, lbapi.AliyunAccessKeySecretKey)
+	}
+	return newSLBClient(f.slbEndpoint, spec.RegionID, id, key), nil
+}
+
+// deleteDeployments deletes the provider deployments of lb
+func (f *aliyun) deleteDeployments(lb *lbapi.LoadBalancer) error {
+	ds, err := f.getDeploymentsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+
+	policy := metav1.DeletePropagationForeground
+	gracePeriodSeconds := int64(30)
+	for _, d := range ds {
+		_ = f.client.Native().AppsV1().Deployments(d.Namespace).Delete(d.Name, &metav1.DeleteOptions{
+			GracePeriodSeconds: &gracePeriodSeconds,
+			PropagationPolicy:  &policy,
+		})
+	}
+	return nil
+}
+
+// Cleanup deletes the provider deployment and the SLB of a deleted lb
+func (f *aliyun) Cleanup(lb *lbapi.LoadBalancer) error {
+	err := f.deleteDeployments(lb)
+	if err != nil {
+		return err
+	}
+
+	c, err := f.newSLBClient(lb)
+	if err != nil {
+		// retried by the queue, the SLB is left behind if credentials never come back
+		if status := lb.Status.ProvidersStatuses.Aliyun; status != nil && status.LoadBalancerID != "" {
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "SLBOrphaned", "SLB %v is not deleted: %v", status.LoadBalancerID, err)
+		}
+		log.Warningf("Delete SLB of lb %v/%v error: %v", lb.Namespace, lb.Name, err)
+		return err
+	}
+	return deleteSLB(c, lb)
+}
+
+// generateDeployment generates the deployment of lb, defaultImage is used
+// if lb does not override the image
+func (f *aliyun) generateDeployment(lb *lbapi.LoadBalancer, defaultImage string) (*appsv1.Deployment, error) {
+	image, err := lbutil.ResolveLoadBalancerImage(f.client, lb, "aliyun", defaultImage, lb.Spec.Providers.Aliyun.Image, lb.Spec.Providers.Aliyun.Version, f.allowedImages)
+	if err != nil {
+		return nil, err
+	}
+	terminationGracePeriodSeconds := int64(300)
+	replicas := int32(1)
+	t := true
+
+	labels := f.selector(lb)
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   lb.Name + providerNameSuffix + "-" + lbutil.RandStringBytesRmndr(5),
+			Labels: labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         api.ControllerKind.GroupVersion().String(),
+					Kind:               api.ControllerKind.Kind,
+					Name:               lb.Name,
+					UID:                lb.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				},
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Strategy: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: v1.PodSpec{
+					TerminationGracePeriodSeconds: &terminationGracePeriodSeconds,
+					// tolerate taints
+					Tolerations: toleration.GenerateTolerations(),
+					Containers: []v1.Container{
+						{
+							Name:            providerName,
+							Image:           image,
+							ImagePullPolicy: v1.PullAlways,
+							Resources: v1.ResourceRequirements{
+								Requests: v1.ResourceList{
+									v1.ResourceCPU:    resource.MustParse("20m"),
+									v1.ResourceMemory: resource.MustParse("50Mi"),
+								},
+								Limits: v1.ResourceList{
+									v1.ResourceCPU:    resource.MustParse("100m"),
+									v1.ResourceMemory: resource.MustParse("100Mi"),
+								},
+							},
+							Env: []v1.EnvVar{
+								{
+									Name: "POD_NAME",
+									ValueFrom: &v1.EnvVarSource{
+										FieldRef: &v1.ObjectFieldSelector{
+											FieldPath: "metadata.name",
+										},
+									},
+								},
+								{
+									Name: "POD_NAMESPACE",
+									ValueFrom: &v1.EnvVarSource{
+										FieldRef: &v1.ObjectFieldSelector{
+											FieldPath: "metadata.namespace",
+										},
+									},
+								},
+								{
+									Name:  "LOADBALANCER_NAMESPACE",
+									Value: lb.Namespace,
+								},
+								{
+									Name:  "LOADBALANCER_NAME",
+									Value: lb.Name,
+								},
+								{
+									Name:  "ALIYUN_REGION_ID",
+									Value: lb.Spec.Providers.Aliyun.RegionID,
+								},
+								{
+									Name:      "ALIYUN_ACCESS_KEY_ID",
+									ValueFrom: secretKeyRef(lb.Spec.Providers.Aliyun.CredentialsSecret, lbapi.AliyunAccessKeyIDKey),
+								},
+								{
+									Name:      "ALIYUN_ACCESS_KEY_SECRET",
+									ValueFrom: secretKeyRef(lb.Spec.Providers.Aliyun.CredentialsSecret, lbapi.AliyunAccessKeySecretKey),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// apply the pod template overlay
+	err = lbutil.ApplyPodTemplate(deploy, lb.Spec.Providers.Aliyun.PodTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return deploy, nil
+}
+
+func secretKeyRef(name, key string) *v1.EnvVarSource {
+	return &v1.EnvVarSource{
+		SecretKeyRef: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: name},
+			Key:                  key,
+		},
+	}
+}
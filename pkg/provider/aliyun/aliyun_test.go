@@ -0,0 +1,321 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aliyun
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/util/lb/lbtest"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	testAccessKeyID     = "id"
+	testAccessKeySecret = "secret"
+)
+
+// fakeSLB is a stand-in of SLB OpenAPI keeping SLBs in memory
+type fakeSLB struct {
+	lbtest.Mock
+	slbs map[string]*slbAttribute
+}
+
+func newFakeSLB() *fakeSLB {
+	return &fakeSLB{slbs: make(map[string]*slbAttribute)}
+}
+
+func (s *fakeSLB) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	params := make(map[string]string)
+	for k, v := range r.URL.Query() {
+		params[k] = v[0]
+	}
+	signature := params["Signature"]
+	delete(params, "Signature")
+	if params["AccessKeyId"] != testAccessKeyID || sign(r.Method, params, testAccessKeySecret) != signature {
+		s.fail(w, http.StatusBadRequest, "SignatureDoesNotMatch")
+		return
+	}
+
+	action := params["Action"]
+	id := params["LoadBalancerId"]
+	attr := s.slbs[id]
+	if attr == nil && action != "CreateLoadBalancer" && action != "DescribeLoadBalancers" {
+		s.fail(w, http.StatusNotFound, codeLoadBalancerNotFound)
+		return
+	}
+	s.Record(action)
+
+	var resp interface{} = struct{}{}
+	switch action {
+	case "DescribeLoadBalancers":
+		list := []map[string]string{}
+		for _, a := range s.slbs {
+			if a.LoadBalancerName == params["LoadBalancerName"] {
+				list = append(list, map[string]string{"LoadBalancerId": a.LoadBalancerID, "LoadBalancerName": a.LoadBalancerName})
+			}
+		}
+		resp = map[string]interface{}{"LoadBalancers": map[string]interface{}{"LoadBalancer": list}}
+	case "CreateLoadBalancer":
+		n := s.NextID()
+		attr = &slbAttribute{
+			LoadBalancerID:     fmt.Sprintf("lb-%d", n),
+			LoadBalancerName:   params["LoadBalancerName"],
+			LoadBalancerStatus: slbActiveStatus,
+			Address:            fmt.Sprintf("47.0.0.%d", n),
+		}
+		s.slbs[attr.LoadBalancerID] = attr
+		resp = map[string]string{"LoadBalancerId": attr.LoadBalancerID, "Address": attr.Address}
+	case "DeleteLoadBalancer":
+		delete(s.slbs, id)
+	case "DescribeLoadBalancerAttribute":
+		resp = attr
+	case "CreateLoadBalancerTCPListener", "CreateLoadBalancerUDPListener":
+		port, _ := strconv.Atoi(params["ListenerPort"])
+		protocol := "tcp"
+		if action == "CreateLoadBalancerUDPListener" {
+			protocol = "udp"
+		}
+		attr.Listeners.Listener = append(attr.Listeners.Listener, slbListener{Port: port, Protocol: protocol})
+	case "StartLoadBalancerListener":
+	case "DeleteLoadBalancerListener":
+		listeners := attr.Listeners.Listener[:0]
+		for _, l := range attr.Listeners.Listener {
+			if strconv.Itoa(l.Port) != params["ListenerPort"] || l.Protocol != params["ListenerProtocol"] {
+				listeners = append(listeners, l)
+			}
+		}
+		attr.Listeners.Listener = listeners
+	case "AddBackendServers":
+		backends := []backendServer{}
+		_ = json.Unmarshal([]byte(params["BackendServers"]), &backends)
+		for _, b := range backends {
+			attr.BackendServers.BackendServer = append(attr.BackendServers.BackendServer, struct {
+				ServerID string `json:"ServerId"`
+				Weight   int    `json:"Weight"`
+			}{ServerID: b.ServerID, Weight: 100})
+		}
+	case "RemoveBackendServers":
+		remove := []string{}
+		_ = json.Unmarshal([]byte(params["BackendServers"]), &remove)
+		servers := attr.BackendServers.BackendServer[:0]
+		for _, b := range attr.BackendServers.BackendServer {
+			if !contains(remove, b.ServerID) {
+				servers = append(servers, b)
+			}
+		}
+		attr.BackendServers.BackendServer = servers
+	default:
+		s.fail(w, http.StatusBadRequest, "InvalidAction")
+		return
+	}
+	lbtest.RespondJSON(w, resp)
+}
+
+func (s *fakeSLB) fail(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(slbError{RequestID: "req", Code: code, Message: code})
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func newLoadBalancer() *lbapi.LoadBalancer {
+	lb := lbtest.NewLoadBalancer()
+	lb.Spec.Providers.Aliyun = &lbapi.AliyunProvider{RegionID: "cn-hangzhou", CredentialsSecret: "aliyun"}
+	return lb
+}
+
+func listeners(attr *slbAttribute) []string {
+	ret := []string{}
+	for _, l := range attr.Listeners.Listener {
+		ret = append(ret, l.String())
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+func TestBackendServers(t *testing.T) {
+	nodes := []*v1.Node{
+		lbtest.NewNode("n1", "", "alicloud://cn-hangzhou.i-b"),
+		lbtest.NewNode("n2", "", "cn-hangzhou.i-a"),
+		lbtest.NewNode("n3", "", ""),
+		lbtest.NewNode("n4", "", "cn-hangzhou.i-a"),
+	}
+	got := backendServers(nodes)
+	if want := []string{"i-a", "i-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got backend servers %v, want %v", got, want)
+	}
+}
+
+func TestEnsureSLB(t *testing.T) {
+	fake := newFakeSLB()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	c := newSLBClient(server.URL, "cn-hangzhou", testAccessKeyID, testAccessKeySecret)
+
+	lb := newLoadBalancer()
+	attr, created, err := ensureSLB(c, lb, []string{"i-a", "i-b"})
+	if err != nil {
+		t.Fatalf("ensure SLB error: %v", err)
+	}
+	if !created || attr.LoadBalancerID != "lb-1" || attr.LoadBalancerName != "default-lb" {
+		t.Errorf("got SLB %v %v created %v, want lb-1 default-lb created", attr.LoadBalancerID, attr.LoadBalancerName, created)
+	}
+	if got, want := listeners(attr), []string{"tcp/20053", "tcp/20080", "tcp/443", "tcp/80", "udp/20053"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %v, want %v", got, want)
+	}
+	if got, want := attr.backendServers(), []string{"i-a", "i-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got backend servers %v, want %v", got, want)
+	}
+	fake.TakeActions()
+
+	// the SLB is found by name before status is saved, nothing changes
+	_, created, err = ensureSLB(c, lb, []string{"i-a", "i-b"})
+	if err != nil || created {
+		t.Fatalf("ensure SLB again got created %v error %v", created, err)
+	}
+	if got, want := fake.TakeActions(), []string{"DescribeLoadBalancers", "DescribeLoadBalancerAttribute"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got actions %v, want %v", got, want)
+	}
+
+	lb.Status.ProvidersStatuses.Aliyun = computeStatus(nil, attr, nil)
+	if status := lb.Status.ProvidersStatuses.Aliyun; status.Phase != lbapi.AliyunRunningPhase || status.Address != "47.0.0.1" {
+		t.Errorf("got status %v %v, want Running 47.0.0.1", status.Phase, status.Address)
+	}
+
+	// stream and node are removed
+	lb.Spec.Proxy.Streams = nil
+	attr, _, err = ensureSLB(c, lb, []string{"i-b"})
+	if err != nil {
+		t.Fatalf("ensure SLB error: %v", err)
+	}
+	if got, want := listeners(attr), []string{"tcp/443", "tcp/80"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %v, want %v", got, want)
+	}
+	if got, want := attr.backendServers(), []string{"i-b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got backend servers %v, want %v", got, want)
+	}
+
+	// SLB deleted out of band is recreated
+	delete(fake.slbs, "lb-1")
+	attr, created, err = ensureSLB(c, lb, []string{"i-b"})
+	if err != nil || !created || attr.LoadBalancerID != "lb-2" {
+		t.Fatalf("got SLB %v created %v error %v, want lb-2 created", attr.LoadBalancerID, created, err)
+	}
+
+	lb.Status.ProvidersStatuses.Aliyun = computeStatus(nil, attr, nil)
+	if err := deleteSLB(c, lb); err != nil {
+		t.Fatalf("delete SLB error: %v", err)
+	}
+	if len(fake.slbs) != 0 {
+		t.Errorf("SLB %v is not deleted", attr.LoadBalancerID)
+	}
+}
+
+func TestEnsureExistingSLB(t *testing.T) {
+	fake := newFakeSLB()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	c := newSLBClient(server.URL, "cn-hangzhou", testAccessKeyID, testAccessKeySecret)
+
+	// an existing SLB with a listener and backend server of others
+	id, _ := c.createLoadBalancer("shared", "internet", "", "")
+	if err := c.createListener(id, slbListener{Port: 8080, Protocol: "tcp"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.addBackendServers(id, []string{"i-other"}); err != nil {
+		t.Fatal(err)
+	}
+
+	lb := newLoadBalancer()
+	lb.Spec.Providers.Aliyun.LoadBalancerID = id
+	attr, created, err := ensureSLB(c, lb, []string{"i-a"})
+	if err != nil || created {
+		t.Fatalf("ensure SLB got created %v error %v", created, err)
+	}
+	if got, want := listeners(attr), []string{"tcp/20053", "tcp/20080", "tcp/443", "tcp/80", "tcp/8080", "udp/20053"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %v, want %v", got, want)
+	}
+	if got, want := attr.backendServers(), []string{"i-a", "i-other"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got backend servers %v, want %v", got, want)
+	}
+
+	lb.Status.ProvidersStatuses.Aliyun = computeStatus(nil, attr, nil)
+	lb.Status.ProvidersStatuses.Aliyun.BackendServers = []string{"i-a"}
+	if err := deleteSLB(c, lb); err != nil {
+		t.Fatalf("delete SLB error: %v", err)
+	}
+	attr, err = c.describeLoadBalancer(id)
+	if err != nil {
+		t.Fatalf("existing SLB is deleted: %v", err)
+	}
+	if got, want := listeners(attr), []string{"tcp/8080"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %v, want %v", got, want)
+	}
+	if got, want := attr.backendServers(), []string{"i-other"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got backend servers %v, want %v", got, want)
+	}
+
+	// a missing existing SLB is an error
+	lb.Spec.Providers.Aliyun.LoadBalancerID = "lb-missing"
+	if _, _, err := ensureSLB(c, lb, nil); !isNotFound(err) {
+		t.Errorf("got error %v, want %v", err, codeLoadBalancerNotFound)
+	}
+}
+
+func TestSLBSignature(t *testing.T) {
+	server := httptest.NewServer(newFakeSLB())
+	defer server.Close()
+
+	c := newSLBClient(server.URL, "cn-hangzhou", testAccessKeyID, "wrong")
+	_, err := c.findLoadBalancer("lb")
+	if e, ok := err.(*slbError); !ok || e.Code != "SignatureDoesNotMatch" {
+		t.Errorf("got error %v, want SignatureDoesNotMatch", err)
+	}
+}
+
+func TestComputeStatus(t *testing.T) {
+	old := &lbapi.AliyunProviderStatus{LoadBalancerID: "lb-1", Address: "47.0.0.1"}
+	status := computeStatus(old, nil, fmt.Errorf("boom"))
+	if status.Phase != lbapi.AliyunErrorPhase || status.Message != "boom" || status.Address != "47.0.0.1" {
+		t.Errorf("got status %+v, want Error boom with the old address", status)
+	}
+	attr := &slbAttribute{LoadBalancerID: "lb-1", LoadBalancerStatus: "inactive"}
+	status = computeStatus(old, attr, nil)
+	if status.Phase != lbapi.AliyunProgressingPhase || status.Message != "SLB is inactive" {
+		t.Errorf("got status %+v, want Progressing", status)
+	}
+}
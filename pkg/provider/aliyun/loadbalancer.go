@@ -0,0 +1,225 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aliyun
+
+import (
+	"fmt"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	log "k8s.io/klog"
+)
+
+// slbName returns the name of SLB created for lb
+func slbName(lb *lbapi.LoadBalancer) string {
+	if lb.Spec.Providers.Aliyun.Name != "" {
+		return lb.Spec.Providers.Aliyun.Name
+	}
+	return lb.Namespace + "-" + lb.Name
+}
+
+// desiredListeners returns the listeners of http, https port and streams of lb's proxy
+func desiredListeners(lb *lbapi.LoadBalancer) []slbListener {
+	listeners := make([]slbListener, 0)
+	for _, l := range lbutil.ProxyListeners(lb) {
+		// SLB names protocols in lower case
+		listeners = append(listeners, slbListener{Port: l.Port, Protocol: strings.ToLower(string(l.Protocol))})
+	}
+	return listeners
+}
+
+// instanceID returns the ECS instance id of node from its provider id,
+// which is in the format of alicloud://<region>.<instance> or <region>.<instance>
+func instanceID(node *v1.Node) string {
+	id := strings.TrimPrefix(node.Spec.ProviderID, "alicloud://")
+	if i := strings.LastIndex(id, "."); i >= 0 {
+		id = id[i+1:]
+	}
+	return id
+}
+
+// backendServers returns the sorted ECS instances of nodes
+func backendServers(nodes []*v1.Node) []string {
+	servers := sets.NewString()
+	for _, node := range nodes {
+		id := instanceID(node)
+		if id == "" {
+			log.Warningf("Node %v has no ECS instance id in provider id, skip it", node.Name)
+			continue
+		}
+		servers.Insert(id)
+	}
+	return servers.List()
+}
+
+// ensureSLB creates the SLB of lb if necessary, and syncs its listeners and
+// backend servers. It returns the fresh attribute of SLB and whether it is created.
+func ensureSLB(c *slbClient, lb *lbapi.LoadBalancer, servers []string) (*slbAttribute, bool, error) {
+	spec := lb.Spec.Providers.Aliyun
+	status := lb.Status.ProvidersStatuses.Aliyun
+	if status == nil {
+		status = &lbapi.AliyunProviderStatus{}
+	}
+	// SLB created by provider is owned, listeners and backend servers
+	// not managed by provider are removed
+	owned := spec.LoadBalancerID == ""
+
+	var err error
+	id := spec.LoadBalancerID
+	if id == "" {
+		id = status.LoadBalancerID
+	}
+	if id == "" {
+		// SLB may be created before status is saved
+		id, err = c.findLoadBalancer(slbName(lb))
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	var attr *slbAttribute
+	if id != "" {
+		attr, err = c.describeLoadBalancer(id)
+		if isNotFound(err) && owned {
+			log.Warningf("SLB %v of lb %v/%v is gone, create a new one", id, lb.Namespace, lb.Name)
+			attr, err = nil, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	created := false
+	if attr == nil {
+		addressType := spec.AddressType
+		if addressType == "" {
+			addressType = lbapi.AliyunInternetAddressType
+		}
+		id, err = c.createLoadBalancer(slbName(lb), string(addressType), spec.VSwitchID, spec.Spec)
+		if err != nil {
+			return nil, false, err
+		}
+		log.Infof("Create SLB %v for lb %v/%v", id, lb.Namespace, lb.Name)
+		created = true
+		attr, err = c.describeLoadBalancer(id)
+		if err != nil {
+			return nil, created, err
+		}
+	}
+
+	changed := false
+
+	desired := make(map[string]slbListener)
+	for _, l := range desiredListeners(lb) {
+		desired[l.String()] = l
+	}
+	existing := make(map[string]bool)
+	for _, l := range attr.Listeners.Listener {
+		existing[l.String()] = true
+		if _, ok := desired[l.String()]; ok || !owned {
+			continue
+		}
+		log.Infof("Delete listener %v of SLB %v", l, id)
+		if err := c.deleteListener(id, l); err != nil {
+			return attr, created, err
+		}
+		changed = true
+	}
+	for _, l := range desiredListeners(lb) {
+		if existing[l.String()] {
+			continue
+		}
+		log.Infof("Create listener %v of SLB %v", l, id)
+		if err := c.createListener(id, l); err != nil {
+			return attr, created, err
+		}
+		changed = true
+	}
+
+	want := sets.NewString(servers...)
+	have := sets.NewString(attr.backendServers()...)
+	// backend servers of an existing SLB are only removed if provider added them
+	removable := have
+	if !owned {
+		removable = have.Intersection(sets.NewString(status.BackendServers...))
+	}
+	if add := want.Difference(have).List(); len(add) > 0 {
+		log.Infof("Add backend servers %v to SLB %v", add, id)
+		if err := c.addBackendServers(id, add); err != nil {
+			return attr, created, err
+		}
+		changed = true
+	}
+	if remove := removable.Difference(want).List(); len(remove) > 0 {
+		log.Infof("Remove backend servers %v from SLB %v", remove, id)
+		if err := c.removeBackendServers(id, remove); err != nil {
+			return attr, created, err
+		}
+		changed = true
+	}
+
+	if changed {
+		attr, err = c.describeLoadBalancer(id)
+	}
+	return attr, created, err
+}
+
+// deleteSLB deletes the SLB created for lb, an existing SLB is kept and
+// only the listeners and backend servers added by provider are removed
+func deleteSLB(c *slbClient, lb *lbapi.LoadBalancer) error {
+	spec := lb.Spec.Providers.Aliyun
+	status := lb.Status.ProvidersStatuses.Aliyun
+	if spec == nil || status == nil || status.LoadBalancerID == "" {
+		return nil
+	}
+	id := status.LoadBalancerID
+	if spec.LoadBalancerID == "" {
+		log.Infof("Delete SLB %v of lb %v/%v", id, lb.Namespace, lb.Name)
+		return c.deleteLoadBalancer(id)
+	}
+
+	attr, err := c.describeLoadBalancer(id)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	desired := make(map[string]bool)
+	for _, l := range desiredListeners(lb) {
+		desired[l.String()] = true
+	}
+	for _, l := range attr.Listeners.Listener {
+		if !desired[l.String()] {
+			continue
+		}
+		if err := c.deleteListener(id, l); err != nil {
+			return err
+		}
+	}
+	remove := sets.NewString(attr.backendServers()...).Intersection(sets.NewString(status.BackendServers...)).List()
+	if len(remove) > 0 {
+		if err := c.removeBackendServers(id, remove); err != nil {
+			return fmt.Errorf("remove backend servers of SLB %v error: %v", id, err)
+		}
+	}
+	return nil
+}
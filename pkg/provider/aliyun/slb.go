@@ -0,0 +1,306 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aliyun
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	slbAPIVersion = "2014-05-15"
+	slbTimeout    = 10 * time.Second
+
+	// error code of SLB OpenAPI when the SLB does not exist
+	codeLoadBalancerNotFound = "InvalidLoadBalancerId.NotFound"
+)
+
+// slbError is the error responded by SLB OpenAPI
+type slbError struct {
+	RequestID string `json:"RequestId"`
+	Code      string `json:"Code"`
+	Message   string `json:"Message"`
+}
+
+func (e *slbError) Error() string {
+	return fmt.Sprintf("%v: %v (request id %v)", e.Code, e.Message, e.RequestID)
+}
+
+// isNotFound returns true if err means the SLB does not exist
+func isNotFound(err error) bool {
+	e, ok := err.(*slbError)
+	return ok && e.Code == codeLoadBalancerNotFound
+}
+
+// slbListener is a listener of SLB, the backend port is the same as the listener port
+type slbListener struct {
+	Port     int    `json:"ListenerPort"`
+	Protocol string `json:"ListenerProtocol"`
+}
+
+func (l slbListener) String() string {
+	return l.Protocol + "/" + strconv.Itoa(l.Port)
+}
+
+// slbAttribute is the attribute of SLB returned by DescribeLoadBalancerAttribute
+type slbAttribute struct {
+	LoadBalancerID     string `json:"LoadBalancerId"`
+	LoadBalancerName   string `json:"LoadBalancerName"`
+	LoadBalancerStatus string `json:"LoadBalancerStatus"`
+	Address            string `json:"Address"`
+	Listeners          struct {
+		Listener []slbListener `json:"ListenerPortAndProtocol"`
+	} `json:"ListenerPortsAndProtocol"`
+	BackendServers struct {
+		BackendServer []struct {
+			ServerID string `json:"ServerId"`
+			Weight   int    `json:"Weight"`
+		} `json:"BackendServer"`
+	} `json:"BackendServers"`
+}
+
+// backendServers returns the ids of backend servers
+func (a *slbAttribute) backendServers() []string {
+	ids := make([]string, 0, len(a.BackendServers.BackendServer))
+	for _, s := range a.BackendServers.BackendServer {
+		ids = append(ids, s.ServerID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// slbClient calls the RPC style SLB OpenAPI of aliyun
+type slbClient struct {
+	client          *http.Client
+	endpoint        string
+	regionID        string
+	accessKeyID     string
+	accessKeySecret string
+}
+
+func newSLBClient(endpoint, regionID, accessKeyID, accessKeySecret string) *slbClient {
+	return &slbClient{
+		client:          &http.Client{Timeout: slbTimeout},
+		endpoint:        endpoint,
+		regionID:        regionID,
+		accessKeyID:     accessKeyID,
+		accessKeySecret: accessKeySecret,
+	}
+}
+
+// percentEncode encodes s in the way of aliyun signature
+func percentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.Replace(s, "+", "%20", -1)
+	s = strings.Replace(s, "*", "%2A", -1)
+	return strings.Replace(s, "%7E", "~", -1)
+}
+
+// canonicalQuery sorts params by key and joins them with percent encoding
+func canonicalQuery(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, percentEncode(k)+"="+percentEncode(params[k]))
+	}
+	return strings.Join(pairs, "&")
+}
+
+// sign computes the signature of params with HMAC-SHA1
+func sign(method string, params map[string]string, secret string) string {
+	stringToSign := method + "&" + percentEncode("/") + "&" + percentEncode(canonicalQuery(params))
+	mac := hmac.New(sha1.New, []byte(secret+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func nonce() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// call invokes action with params and decodes the response into out
+func (c *slbClient) call(action string, params map[string]string, out interface{}) error {
+	query := map[string]string{
+		"Action":           action,
+		"Format":           "JSON",
+		"Version":          slbAPIVersion,
+		"RegionId":         c.regionID,
+		"AccessKeyId":      c.accessKeyID,
+		"SignatureMethod":  "HMAC-SHA1",
+		"SignatureVersion": "1.0",
+		"SignatureNonce":   nonce(),
+		"Timestamp":        time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	for k, v := range params {
+		query[k] = v
+	}
+	query["Signature"] = sign(http.MethodGet, query, c.accessKeySecret)
+
+	resp, err := c.client.Get(c.endpoint + "/?" + canonicalQuery(query))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		e := &slbError{}
+		if err := json.NewDecoder(resp.Body).Decode(e); err != nil || e.Code == "" {
+			return fmt.Errorf("%v responded %v", action, resp.Status)
+		}
+		return e
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// describeLoadBalancer gets the attribute of SLB
+func (c *slbClient) describeLoadBalancer(id string) (*slbAttribute, error) {
+	attr := &slbAttribute{}
+	err := c.call("DescribeLoadBalancerAttribute", map[string]string{"LoadBalancerId": id}, attr)
+	if err != nil {
+		return nil, err
+	}
+	return attr, nil
+}
+
+// findLoadBalancer returns the id of SLB with name, or empty string if not found
+func (c *slbClient) findLoadBalancer(name string) (string, error) {
+	resp := struct {
+		LoadBalancers struct {
+			LoadBalancer []struct {
+				LoadBalancerID   string `json:"LoadBalancerId"`
+				LoadBalancerName string `json:"LoadBalancerName"`
+			} `json:"LoadBalancer"`
+		} `json:"LoadBalancers"`
+	}{}
+	err := c.call("DescribeLoadBalancers", map[string]string{"LoadBalancerName": name}, &resp)
+	if err != nil {
+		return "", err
+	}
+	for _, lb := range resp.LoadBalancers.LoadBalancer {
+		if lb.LoadBalancerName == name {
+			return lb.LoadBalancerID, nil
+		}
+	}
+	return "", nil
+}
+
+// createLoadBalancer creates a SLB and returns its id
+func (c *slbClient) createLoadBalancer(name, addressType, vSwitchID, spec string) (string, error) {
+	params := map[string]string{
+		"LoadBalancerName": name,
+		"AddressType":      addressType,
+	}
+	if vSwitchID != "" {
+		params["VSwitchId"] = vSwitchID
+	}
+	if spec != "" {
+		params["LoadBalancerSpec"] = spec
+	}
+	resp := struct {
+		LoadBalancerID string `json:"LoadBalancerId"`
+	}{}
+	if err := c.call("CreateLoadBalancer", params, &resp); err != nil {
+		return "", err
+	}
+	return resp.LoadBalancerID, nil
+}
+
+// deleteLoadBalancer deletes the SLB, it is not an error if SLB does not exist
+func (c *slbClient) deleteLoadBalancer(id string) error {
+	err := c.call("DeleteLoadBalancer", map[string]string{"LoadBalancerId": id}, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// createListener creates and starts a tcp or udp listener forwarding to the same port of backends
+func (c *slbClient) createListener(id string, listener slbListener) error {
+	action := "CreateLoadBalancerTCPListener"
+	if listener.Protocol == "udp" {
+		action = "CreateLoadBalancerUDPListener"
+	}
+	port := strconv.Itoa(listener.Port)
+	err := c.call(action, map[string]string{
+		"LoadBalancerId":    id,
+		"ListenerPort":      port,
+		"BackendServerPort": port,
+		"Bandwidth":         "-1",
+	}, nil)
+	if err != nil {
+		return err
+	}
+	return c.call("StartLoadBalancerListener", map[string]string{
+		"LoadBalancerId": id,
+		"ListenerPort":   port,
+	}, nil)
+}
+
+// deleteListener deletes a listener of SLB
+func (c *slbClient) deleteListener(id string, listener slbListener) error {
+	return c.call("DeleteLoadBalancerListener", map[string]string{
+		"LoadBalancerId":   id,
+		"ListenerPort":     strconv.Itoa(listener.Port),
+		"ListenerProtocol": listener.Protocol,
+	}, nil)
+}
+
+type backendServer struct {
+	ServerID string `json:"ServerId"`
+	Weight   string `json:"Weight,omitempty"`
+}
+
+// addBackendServers adds ECS instances to SLB with the default weight
+func (c *slbClient) addBackendServers(id string, servers []string) error {
+	backends := make([]backendServer, 0, len(servers))
+	for _, s := range servers {
+		backends = append(backends, backendServer{ServerID: s, Weight: "100"})
+	}
+	data, _ := json.Marshal(backends)
+	return c.call("AddBackendServers", map[string]string{
+		"LoadBalancerId": id,
+		"BackendServers": string(data),
+	}, nil)
+}
+
+// removeBackendServers removes ECS instances from SLB
+func (c *slbClient) removeBackendServers(id string, servers []string) error {
+	data, _ := json.Marshal(servers)
+	return c.call("RemoveBackendServers", map[string]string{
+		"LoadBalancerId": id,
+		"BackendServers": string(data),
+	}, nil)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aliyun
+
+import (
+	"fmt"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	log "k8s.io/klog"
+)
+
+const slbActiveStatus = "active"
+
+// syncStatus updates the status of aliyun provider with provider pods and
+// the attribute of SLB, syncErr is the error of syncing SLB
+func (f *aliyun) syncStatus(lb *lbapi.LoadBalancer, attr *slbAttribute, syncErr error) error {
+	podList, err := f.podLister.List(f.selector(lb).AsSelector())
+	if err != nil {
+		log.Errorf("get pod list error: %v", err)
+		return err
+	}
+
+	status := computeStatus(lb.Status.ProvidersStatuses.Aliyun, attr, syncErr)
+	for _, pod := range podList {
+		s := lbutil.ComputePodStatus(pod)
+		if s.Phase != string(v1.PodRunning) && s.Phase != string(v1.PodSucceeded) && status.Phase != lbapi.AliyunErrorPhase {
+			status.Phase = lbapi.AliyunErrorPhase
+			status.Message = s.Message
+		}
+	}
+	status.RunningImages = lbutil.RunningImages(podList, providerName)
+
+	return f.updateStatus(lb, status)
+}
+
+// updateStatus updates the aliyun status of lb, status is nil to delete it
+func (f *aliyun) updateStatus(lb *lbapi.LoadBalancer, status *lbapi.AliyunProviderStatus) error {
+	return lbutil.SyncProviderStatus(f.client, f.lbLister, lb, providerName, lb.Status.ProvidersStatuses.Aliyun, status, func(lb *lbapi.LoadBalancer) {
+		lb.Status.ProvidersStatuses.Aliyun = status
+	})
+}
+
+// computeStatus computes the status of SLB, the SLB in old status is kept if
+// attr is nil, which means SLB can't be described
+func computeStatus(old *lbapi.AliyunProviderStatus, attr *slbAttribute, syncErr error) *lbapi.AliyunProviderStatus {
+	status := &lbapi.AliyunProviderStatus{}
+	if attr != nil {
+		status.LoadBalancerID = attr.LoadBalancerID
+		status.Address = attr.Address
+		status.BackendServers = attr.backendServers()
+	} else if old != nil {
+		status.LoadBalancerID = old.LoadBalancerID
+		status.Address = old.Address
+		status.BackendServers = old.BackendServers
+	}
+	if len(status.BackendServers) == 0 {
+		status.BackendServers = nil
+	}
+
+	switch {
+	case syncErr != nil:
+		status.Phase = lbapi.AliyunErrorPhase
+		status.Message = syncErr.Error()
+	case attr == nil:
+		status.Phase = lbapi.AliyunProgressingPhase
+	case attr.LoadBalancerStatus != slbActiveStatus:
+		status.Phase = lbapi.AliyunProgressingPhase
+		status.Message = fmt.Sprintf("SLB is %v", attr.LoadBalancerStatus)
+	default:
+		status.Phase = lbapi.AliyunRunningPhase
+	}
+	return status
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog"
+)
+
+const (
+	providerName = "aws"
+)
+
+type aws struct {
+	// NodeProvider syncs the NLB with the nodes of lb, and resyncs it until it is
+	// running because NLBs take minutes to be provisioned
+	*lbutil.NodeProvider
+	initialized bool
+
+	// elbEndpoint is the endpoint of ELBv2 API, empty means the endpoint of region
+	elbEndpoint string
+
+	client   kubernetes.Interface
+	lbLister lblisters.LoadBalancerLister
+}
+
+// New creates a new aws provider plugin
+func New() plugin.Interface {
+	return &aws{}
+}
+
+func (f *aws) Init(cfg config.Configuration, sif informers.SharedInformerFactory) {
+	if f.initialized {
+		return
+	}
+	f.initialized = true
+
+	log.Info("Initialize the aws provider")
+
+	// set config
+	f.elbEndpoint = cfg.Providers.AWS.ELBEndpoint
+	f.client = cfg.Client
+	f.lbLister = sif.Custom().Loadbalance().V1alpha2().LoadBalancers().Lister()
+	f.NodeProvider = lbutil.NewNodeProvider(providerName, sif, cfg.Providers.AWS.ResyncPeriod, f)
+}
+
+// Enabled checks whether lb uses aws provider
+func (f *aws) Enabled(lb *lbapi.LoadBalancer) bool {
+	return lb.Spec.Providers.AWS != nil
+}
+
+// Pending checks whether the NLB of lb is not running
+func (f *aws) Pending(lb *lbapi.LoadBalancer) bool {
+	status := lb.Status.ProvidersStatuses.AWS
+	return status == nil || status.Phase != lbapi.AWSRunningPhase
+}
+
+// Release deletes the status of lb, NLB is left behind
+func (f *aws) Release(lb *lbapi.LoadBalancer) error {
+	if status := lb.Status.ProvidersStatuses.AWS; status != nil && status.LoadBalancerARN != "" {
+		// the credentials are gone with the spec
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "NLBOrphaned", "NLB %v is not deleted, aws provider is removed from spec", status.LoadBalancerARN)
+	}
+	return f.syncStatus(lb, nil)
+}
+
+// Sync syncs the NLB of lb with the nodes of lb
+func (f *aws) Sync(lb *lbapi.LoadBalancer, nodes []*v1.Node) error {
+	state, syncErr := f.syncNLB(lb, nodes)
+	if err := f.syncStatus(lb, computeStatus(lb.Status.ProvidersStatuses.AWS, state, syncErr)); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+func (f *aws) syncNLB(lb *lbapi.LoadBalancer, nodes []*v1.Node) (*nlbState, error) {
+	c, err := f.newELBClient(lb)
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "InvalidCredentials", "%v", err)
+		return nil, err
+	}
+	state, created, err := ensureNLB(c, lb, instances(nodes))
+	if created {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeNormal, "NLBCreated", "NLB %v is created", state.loadBalancer.Name)
+	}
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "NLBSyncFailed", "Sync NLB error: %v", err)
+		return state, err
+	}
+	return state, nil
+}
+
+// syncStatus updates the aws status of lb, status is nil to delete it
+func (f *aws) syncStatus(lb *lbapi.LoadBalancer, status *lbapi.AWSProviderStatus) error {
+	return lbutil.SyncProviderStatus(f.client, f.lbLister, lb, providerName, lb.Status.ProvidersStatuses.AWS, status, func(lb *lbapi.LoadBalancer) {
+		lb.Status.ProvidersStatuses.AWS = status
+	})
+}
+
+// newELBClient creates an ELBv2 client with the credentials Secret of lb
+func (f *aws) newELBClient(lb *lbapi.LoadBalancer) (*elbClient, error) {
+	spec := lb.Spec.Providers.AWS
+	secret, err := f.client.Native().CoreV1().Secrets(lb.Namespace).Get(spec.CredentialsSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get credentials secret %v error: %v", spec.CredentialsSecret, err)
+	}
+	id := string(secret.Data[lbapi.AWSAccessKeyIDKey])
+	key := string(secret.Data[lbapi.AWSSecretAccessKeyKey])
+	if id == "" || key == "" {
+		return nil, fmt.Errorf("credentials secret %v must have %v and %v", spec.CredentialsSecret, lbapi.AWSAccessKeyIDKey, lbapi.AWSSecretAccessKeyKey)
+	}
+	return newELBClient(f.elbEndpoint, spec.Region, id, key), nil
+}
+
+// Cleanup deletes the NLB of a deleted lb
+func (f *aws) Cleanup(lb *lbapi.LoadBalancer) error {
+	c, err := f.newELBClient(lb)
+	if err != nil {
+		// retried by the queue, the NLB is left behind if credentials never come back
+		if status := lb.Status.ProvidersStatuses.AWS; status != nil && status.LoadBalancerARN != "" {
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "NLBOrphaned", "NLB %v is not deleted: %v", status.LoadBalancerARN, err)
+		}
+		log.Warningf("Delete NLB of lb %v/%v error: %v", lb.Namespace, lb.Name, err)
+		return err
+	}
+	return deleteNLB(c, lb)
+}
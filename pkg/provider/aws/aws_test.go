@@ -0,0 +1,400 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/util/lb/lbtest"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	testRegion          = "us-east-1"
+	testAccessKeyID     = "AKID"
+	testSecretAccessKey = "secret"
+)
+
+type mockLoadBalancer struct {
+	loadBalancer
+	subnets   []string
+	eips      []string
+	crossZone bool
+	listeners []listener
+}
+
+type mockTargetGroup struct {
+	targetGroup
+	targets sets.String
+}
+
+// mockELB is a stand-in of ELBv2 API keeping NLBs and target groups in memory
+type mockELB struct {
+	lbtest.Mock
+	loadBalancers map[string]*mockLoadBalancer
+	targetGroups  map[string]*mockTargetGroup
+}
+
+func newMockELB() *mockELB {
+	return &mockELB{
+		loadBalancers: make(map[string]*mockLoadBalancer),
+		targetGroups:  make(map[string]*mockTargetGroup),
+	}
+}
+
+func (m *mockELB) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.Lock()
+	defer m.Unlock()
+
+	body, _ := ioutil.ReadAll(r.Body)
+	now, err := time.Parse(amzDateFormat, r.Header.Get("X-Amz-Date"))
+	if err != nil {
+		m.fail(w, "MissingAuthenticationToken")
+		return
+	}
+	signed, _ := http.NewRequest(r.Method, "http://"+r.Host+r.URL.RequestURI(), bytes.NewReader(body))
+	signed.Header.Set("Content-Type", r.Header.Get("Content-Type"))
+	signV4(signed, body, elbService, testRegion, testAccessKeyID, testSecretAccessKey, now)
+	if signed.Header.Get("Authorization") != r.Header.Get("Authorization") {
+		m.fail(w, "SignatureDoesNotMatch")
+		return
+	}
+
+	params, _ := url.ParseQuery(string(body))
+	action := params.Get("Action")
+	m.Record(action)
+	result := ""
+	switch action {
+	case "DescribeLoadBalancers":
+		for _, lb := range m.loadBalancers {
+			if lb.Name == params.Get("Names.member.1") || lb.ARN == params.Get("LoadBalancerArns.member.1") {
+				result = "<LoadBalancers>" + lb.xml() + "</LoadBalancers>"
+			}
+		}
+		if result == "" {
+			m.fail(w, codeLoadBalancerNotFound)
+			return
+		}
+	case "CreateLoadBalancer":
+		id := m.NextID()
+		name := params.Get("Name")
+		lb := &mockLoadBalancer{loadBalancer: loadBalancer{
+			ARN:     fmt.Sprintf("arn:aws:elasticloadbalancing:%s:123456789012:loadbalancer/net/%s/%d", testRegion, name, id),
+			Name:    name,
+			DNSName: fmt.Sprintf("%s-%d.elb.%s.amazonaws.com", name, id, testRegion),
+			VPCID:   "vpc-1",
+			State:   nlbProvisioningState,
+		}}
+		for i := 1; params.Get(fmt.Sprintf("SubnetMappings.member.%d.SubnetId", i)) != ""; i++ {
+			lb.subnets = append(lb.subnets, params.Get(fmt.Sprintf("SubnetMappings.member.%d.SubnetId", i)))
+			if eip := params.Get(fmt.Sprintf("SubnetMappings.member.%d.AllocationId", i)); eip != "" {
+				lb.eips = append(lb.eips, eip)
+			}
+		}
+		m.loadBalancers[lb.ARN] = lb
+		result = "<LoadBalancers>" + lb.xml() + "</LoadBalancers>"
+	case "DeleteLoadBalancer":
+		delete(m.loadBalancers, params.Get("LoadBalancerArn"))
+	case "DescribeLoadBalancerAttributes":
+		lb := m.loadBalancers[params.Get("LoadBalancerArn")]
+		result = fmt.Sprintf("<Attributes><member><Key>deletion_protection.enabled</Key><Value>false</Value></member>"+
+			"<member><Key>%s</Key><Value>%v</Value></member></Attributes>", crossZoneAttribute, lb.crossZone)
+	case "ModifyLoadBalancerAttributes":
+		lb := m.loadBalancers[params.Get("LoadBalancerArn")]
+		lb.crossZone = params.Get("Attributes.member.1.Value") == "true"
+	case "DescribeListeners":
+		result = "<Listeners>"
+		for _, l := range m.loadBalancers[params.Get("LoadBalancerArn")].listeners {
+			result += fmt.Sprintf("<member><ListenerArn>%s</ListenerArn><Port>%d</Port><Protocol>%s</Protocol>"+
+				"<DefaultActions><member><Type>forward</Type><TargetGroupArn>%s</TargetGroupArn></member></DefaultActions></member>",
+				l.ARN, l.Port, l.Protocol, l.TargetGroupARN)
+		}
+		result += "</Listeners>"
+	case "CreateListener":
+		lb := m.loadBalancers[params.Get("LoadBalancerArn")]
+		port, _ := strconv.Atoi(params.Get("Port"))
+		for _, l := range lb.listeners {
+			if l.Port == port {
+				m.fail(w, "DuplicateListener")
+				return
+			}
+		}
+		id := m.NextID()
+		lb.listeners = append(lb.listeners, listener{
+			ARN:            fmt.Sprintf("%s/listener/%d", lb.ARN, id),
+			Port:           port,
+			Protocol:       params.Get("Protocol"),
+			TargetGroupARN: params.Get("DefaultActions.member.1.TargetGroupArn"),
+		})
+	case "DeleteListener":
+		for _, lb := range m.loadBalancers {
+			listeners := lb.listeners[:0]
+			for _, l := range lb.listeners {
+				if l.ARN != params.Get("ListenerArn") {
+					listeners = append(listeners, l)
+				}
+			}
+			lb.listeners = listeners
+		}
+	case "DescribeTargetGroups":
+		for _, tg := range m.targetGroups {
+			if tg.Name == params.Get("Names.member.1") {
+				result = "<TargetGroups>" + tg.xml() + "</TargetGroups>"
+			}
+		}
+		if result == "" {
+			m.fail(w, codeTargetGroupNotFound)
+			return
+		}
+	case "CreateTargetGroup":
+		id := m.NextID()
+		port, _ := strconv.Atoi(params.Get("Port"))
+		tg := &mockTargetGroup{
+			targetGroup: targetGroup{
+				ARN:      fmt.Sprintf("arn:aws:elasticloadbalancing:%s:123456789012:targetgroup/%s/%d", testRegion, params.Get("Name"), id),
+				Name:     params.Get("Name"),
+				Port:     port,
+				Protocol: params.Get("Protocol"),
+			},
+			targets: sets.NewString(),
+		}
+		m.targetGroups[tg.ARN] = tg
+		result = "<TargetGroups>" + tg.xml() + "</TargetGroups>"
+	case "DeleteTargetGroup":
+		arn := params.Get("TargetGroupArn")
+		for _, lb := range m.loadBalancers {
+			for _, l := range lb.listeners {
+				if l.TargetGroupARN == arn {
+					m.fail(w, "ResourceInUse")
+					return
+				}
+			}
+		}
+		delete(m.targetGroups, arn)
+	case "DescribeTargetHealth":
+		result = "<TargetHealthDescriptions>"
+		tg := m.targetGroups[params.Get("TargetGroupArn")]
+		for _, id := range tg.targets.List() {
+			result += fmt.Sprintf("<member><Target><Id>%s</Id><Port>%d</Port></Target><TargetHealth><State>healthy</State></TargetHealth></member>", id, tg.Port)
+		}
+		result += "</TargetHealthDescriptions>"
+	case "RegisterTargets", "DeregisterTargets":
+		tg := m.targetGroups[params.Get("TargetGroupArn")]
+		for i := 1; params.Get(fmt.Sprintf("Targets.member.%d.Id", i)) != ""; i++ {
+			id := params.Get(fmt.Sprintf("Targets.member.%d.Id", i))
+			if action == "RegisterTargets" {
+				tg.targets.Insert(id)
+			} else {
+				tg.targets.Delete(id)
+			}
+		}
+	default:
+		m.fail(w, "InvalidAction")
+		return
+	}
+	fmt.Fprintf(w, "<%sResponse><%sResult>%s</%sResult><ResponseMetadata><RequestId>req</RequestId></ResponseMetadata></%sResponse>",
+		action, action, result, action, action)
+}
+
+func (m *mockELB) fail(w http.ResponseWriter, code string) {
+	w.WriteHeader(http.StatusBadRequest)
+	fmt.Fprintf(w, "<ErrorResponse><Error><Type>Sender</Type><Code>%s</Code><Message>%s</Message></Error><RequestId>req</RequestId></ErrorResponse>", code, code)
+}
+
+func (lb *mockLoadBalancer) xml() string {
+	zones := ""
+	for i, subnet := range lb.subnets {
+		addresses := ""
+		if i < len(lb.eips) {
+			addresses = fmt.Sprintf("<LoadBalancerAddresses><member><IpAddress>3.0.0.%d</IpAddress><AllocationId>%s</AllocationId></member></LoadBalancerAddresses>", i+1, lb.eips[i])
+		}
+		zones += fmt.Sprintf("<member><ZoneName>us-east-1%c</ZoneName><SubnetId>%s</SubnetId>%s</member>", 'a'+i, subnet, addresses)
+	}
+	return fmt.Sprintf("<member><LoadBalancerArn>%s</LoadBalancerArn><LoadBalancerName>%s</LoadBalancerName><DNSName>%s</DNSName>"+
+		"<VpcId>%s</VpcId><Type>network</Type><State><Code>%s</Code></State><AvailabilityZones>%s</AvailabilityZones></member>",
+		lb.ARN, lb.Name, lb.DNSName, lb.VPCID, lb.State, zones)
+}
+
+func (tg *mockTargetGroup) xml() string {
+	return fmt.Sprintf("<member><TargetGroupArn>%s</TargetGroupArn><TargetGroupName>%s</TargetGroupName><Port>%d</Port><Protocol>%s</Protocol><TargetType>instance</TargetType></member>",
+		tg.ARN, tg.Name, tg.Port, tg.Protocol)
+}
+
+func newLoadBalancer() *lbapi.LoadBalancer {
+	lb := lbtest.NewLoadBalancer()
+	lb.Spec.Providers.AWS = &lbapi.AWSProvider{
+		Region:            testRegion,
+		CredentialsSecret: "aws",
+		Subnets:           []string{"subnet-a", "subnet-b"},
+		EIPAllocations:    []string{"eipalloc-a", "eipalloc-b"},
+		CrossZone:         true,
+	}
+	return lb
+}
+
+func TestSignV4(t *testing.T) {
+	// the example of signing process in aws documents
+	req, _ := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	now, _ := time.Parse(amzDateFormat, "20150830T123600Z")
+	signV4(req, nil, "iam", "us-east-1", "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", now)
+
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, " +
+		"SignedHeaders=content-type;host;x-amz-date, Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("got authorization %v, want %v", got, want)
+	}
+}
+
+func TestNames(t *testing.T) {
+	lb := newLoadBalancer()
+	lb.Namespace = "a-very-long-namespace"
+	lb.Name = "a.very.long.loadbalancer"
+	name := nlbName(lb)
+	if len(name) > 32 || !strings.HasPrefix(name, "a-very-long-namespace-a-") {
+		t.Errorf("got invalid nlb name %v", name)
+	}
+	tg := targetGroupName(lb, nlbListener{Protocol: "TCP_UDP", Port: 65535})
+	if len(tg) > 32 || strings.Contains(tg, "_") {
+		t.Errorf("got invalid target group name %v", tg)
+	}
+	if !ownedTargetGroup(lb, "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/"+tg+"/1") {
+		t.Errorf("target group %v is not owned by lb", tg)
+	}
+}
+
+func TestDesiredListeners(t *testing.T) {
+	got := desiredListeners(newLoadBalancer())
+	want := []nlbListener{
+		{Protocol: "TCP", Port: 80},
+		{Protocol: "TCP", Port: 443},
+		{Protocol: "TCP_UDP", Port: 20053},
+		{Protocol: "TCP", Port: 20080},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %v, want %v", got, want)
+	}
+}
+
+func TestEnsureNLB(t *testing.T) {
+	mock := newMockELB()
+	server := httptest.NewServer(mock)
+	defer server.Close()
+	c := newELBClient(server.URL, testRegion, testAccessKeyID, testSecretAccessKey)
+
+	lb := newLoadBalancer()
+	state, created, err := ensureNLB(c, lb, []string{"i-a", "i-b"})
+	if err != nil || !created {
+		t.Fatalf("ensure NLB got created %v error %v", created, err)
+	}
+	status := computeStatus(nil, state, nil)
+	if status.Phase != lbapi.AWSProgressingPhase || !strings.HasPrefix(status.DNSName, nlbName(lb)) {
+		t.Errorf("got status %v %v, want Progressing with dns name", status.Phase, status.DNSName)
+	}
+	if want := []string{"3.0.0.1", "3.0.0.2"}; !reflect.DeepEqual(status.Addresses, want) {
+		t.Errorf("got addresses %v, want %v", status.Addresses, want)
+	}
+	nlb := mock.loadBalancers[status.LoadBalancerARN]
+	if !nlb.crossZone {
+		t.Errorf("cross-zone load balancing is not enabled")
+	}
+	listeners := []string{}
+	for _, l := range nlb.listeners {
+		listeners = append(listeners, nlbListener{Protocol: l.Protocol, Port: l.Port}.String())
+		if tg := mock.targetGroups[l.TargetGroupARN]; tg == nil || !reflect.DeepEqual(tg.targets.List(), []string{"i-a", "i-b"}) {
+			t.Errorf("got target group %v of listener %v, want targets [i-a i-b]", tg, l.Port)
+		}
+	}
+	sort.Strings(listeners)
+	if want := []string{"TCP/20080", "TCP/443", "TCP/80", "TCP_UDP/20053"}; !reflect.DeepEqual(listeners, want) {
+		t.Errorf("got listeners %v, want %v", listeners, want)
+	}
+	if len(status.TargetGroups) != 4 {
+		t.Errorf("got target groups %v, want 4", status.TargetGroups)
+	}
+
+	// nothing changes once NLB is active
+	nlb.State = nlbActiveState
+	lb.Status.ProvidersStatuses.AWS = status
+	mock.TakeActions()
+	state, created, err = ensureNLB(c, lb, []string{"i-a", "i-b"})
+	if err != nil || created {
+		t.Fatalf("ensure NLB again got created %v error %v", created, err)
+	}
+	for _, action := range mock.TakeActions() {
+		if !strings.HasPrefix(action, "Describe") {
+			t.Errorf("unexpected action %v", action)
+		}
+	}
+	if status = computeStatus(status, state, nil); status.Phase != lbapi.AWSRunningPhase {
+		t.Errorf("got phase %v, want Running", status.Phase)
+	}
+
+	// stream and node are removed
+	lb.Spec.Proxy.Streams = lb.Spec.Proxy.Streams[:2]
+	lb.Spec.Providers.AWS.CrossZone = false
+	state, _, err = ensureNLB(c, lb, []string{"i-b"})
+	if err != nil {
+		t.Fatalf("ensure NLB error: %v", err)
+	}
+	if len(nlb.listeners) != 3 || len(mock.targetGroups) != 3 || nlb.crossZone {
+		t.Errorf("got %v listeners, %v target groups, cross zone %v, want 3, 3, false", len(nlb.listeners), len(mock.targetGroups), nlb.crossZone)
+	}
+	for _, tg := range mock.targetGroups {
+		if !reflect.DeepEqual(tg.targets.List(), []string{"i-b"}) {
+			t.Errorf("got targets %v of %v, want [i-b]", tg.targets.List(), tg.Name)
+		}
+	}
+
+	lb.Status.ProvidersStatuses.AWS = computeStatus(status, state, nil)
+	if err := deleteNLB(c, lb); err != nil {
+		t.Fatalf("delete NLB error: %v", err)
+	}
+	if len(mock.loadBalancers) != 0 || len(mock.targetGroups) != 0 {
+		t.Errorf("got %v NLBs and %v target groups left", len(mock.loadBalancers), len(mock.targetGroups))
+	}
+}
+
+func TestELBError(t *testing.T) {
+	server := httptest.NewServer(newMockELB())
+	defer server.Close()
+
+	c := newELBClient(server.URL, testRegion, testAccessKeyID, "wrong")
+	_, err := c.findLoadBalancer("lb")
+	if !isErrorCode(err, "SignatureDoesNotMatch") {
+		t.Errorf("got error %v, want SignatureDoesNotMatch", err)
+	}
+
+	state := &nlbState{loadBalancer: &loadBalancer{State: "failed", StateReason: "subnet is full"}}
+	status := computeStatus(nil, state, nil)
+	if status.Phase != lbapi.AWSErrorPhase || status.Message != "NLB is failed: subnet is full" {
+		t.Errorf("got status %v %v, want Error", status.Phase, status.Message)
+	}
+}
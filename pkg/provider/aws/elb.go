@@ -0,0 +1,353 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	elbService    = "elasticloadbalancing"
+	elbAPIVersion = "2015-12-01"
+	elbTimeout    = 10 * time.Second
+
+	// error codes of ELBv2 API when resources do not exist
+	codeLoadBalancerNotFound = "LoadBalancerNotFound"
+	codeTargetGroupNotFound  = "TargetGroupNotFound"
+	codeListenerNotFound     = "ListenerNotFound"
+
+	crossZoneAttribute = "load_balancing.cross_zone.enabled"
+)
+
+// elbError is the error responded by ELBv2 API
+type elbError struct {
+	Code      string `xml:"Error>Code"`
+	Message   string `xml:"Error>Message"`
+	RequestID string `xml:"RequestId"`
+}
+
+func (e *elbError) Error() string {
+	return fmt.Sprintf("%v: %v (request id %v)", e.Code, e.Message, e.RequestID)
+}
+
+// isErrorCode returns true if err is an ELBv2 error with code
+func isErrorCode(err error, code string) bool {
+	e, ok := err.(*elbError)
+	return ok && e.Code == code
+}
+
+// loadBalancer is a network load balancer
+type loadBalancer struct {
+	ARN         string   `xml:"LoadBalancerArn"`
+	Name        string   `xml:"LoadBalancerName"`
+	DNSName     string   `xml:"DNSName"`
+	VPCID       string   `xml:"VpcId"`
+	State       string   `xml:"State>Code"`
+	StateReason string   `xml:"State>Reason"`
+	Addresses   []string `xml:"AvailabilityZones>member>LoadBalancerAddresses>member>IpAddress"`
+}
+
+// listener forwards a port of NLB to a target group
+type listener struct {
+	ARN            string `xml:"ListenerArn"`
+	Port           int    `xml:"Port"`
+	Protocol       string `xml:"Protocol"`
+	TargetGroupARN string `xml:"DefaultActions>member>TargetGroupArn"`
+}
+
+// targetGroup is a group of instances receiving traffic of a listener
+type targetGroup struct {
+	ARN      string `xml:"TargetGroupArn"`
+	Name     string `xml:"TargetGroupName"`
+	Port     int    `xml:"Port"`
+	Protocol string `xml:"Protocol"`
+}
+
+// elbClient calls the query style ELBv2 API of aws
+type elbClient struct {
+	client          *http.Client
+	endpoint        string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+}
+
+func newELBClient(endpoint, region, accessKeyID, secretAccessKey string) *elbClient {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.%s.amazonaws.com", elbService, region)
+		if strings.HasPrefix(region, "cn-") {
+			endpoint += ".cn"
+		}
+	}
+	return &elbClient{
+		client:          &http.Client{Timeout: elbTimeout},
+		endpoint:        endpoint,
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+	}
+}
+
+// setMembers sets a list parameter in the format of name.member.N
+func setMembers(params url.Values, name string, values []string) {
+	for i, v := range values {
+		params.Set(fmt.Sprintf("%s.member.%d", name, i+1), v)
+	}
+}
+
+// call invokes action with params and decodes the response into out
+func (c *elbClient) call(action string, params url.Values, out interface{}) error {
+	params.Set("Action", action)
+	params.Set("Version", elbAPIVersion)
+	body := []byte(params.Encode())
+	req, err := http.NewRequest(http.MethodPost, c.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	signV4(req, body, elbService, c.region, c.accessKeyID, c.secretAccessKey, time.Now())
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		e := &elbError{}
+		if err := xml.NewDecoder(resp.Body).Decode(e); err != nil || e.Code == "" {
+			return fmt.Errorf("%v responded %v", action, resp.Status)
+		}
+		return e
+	}
+	if out == nil {
+		return nil
+	}
+	return xml.NewDecoder(resp.Body).Decode(out)
+}
+
+func (c *elbClient) describeLoadBalancers(params url.Values) (*loadBalancer, error) {
+	resp := struct {
+		LoadBalancers []loadBalancer `xml:"DescribeLoadBalancersResult>LoadBalancers>member"`
+	}{}
+	err := c.call("DescribeLoadBalancers", params, &resp)
+	if isErrorCode(err, codeLoadBalancerNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.LoadBalancers) == 0 {
+		return nil, nil
+	}
+	return &resp.LoadBalancers[0], nil
+}
+
+// findLoadBalancer returns the NLB with name, or nil if not found
+func (c *elbClient) findLoadBalancer(name string) (*loadBalancer, error) {
+	params := url.Values{}
+	setMembers(params, "Names", []string{name})
+	return c.describeLoadBalancers(params)
+}
+
+// describeLoadBalancer returns the NLB with arn, or nil if not found
+func (c *elbClient) describeLoadBalancer(arn string) (*loadBalancer, error) {
+	params := url.Values{}
+	setMembers(params, "LoadBalancerArns", []string{arn})
+	return c.describeLoadBalancers(params)
+}
+
+// createLoadBalancer creates a NLB in subnets, eips are the elastic ip
+// allocations of subnets in the same order
+func (c *elbClient) createLoadBalancer(name, scheme string, subnets, eips []string) (*loadBalancer, error) {
+	params := url.Values{}
+	params.Set("Name", name)
+	params.Set("Type", "network")
+	params.Set("Scheme", scheme)
+	for i, subnet := range subnets {
+		params.Set(fmt.Sprintf("SubnetMappings.member.%d.SubnetId", i+1), subnet)
+		if i < len(eips) {
+			params.Set(fmt.Sprintf("SubnetMappings.member.%d.AllocationId", i+1), eips[i])
+		}
+	}
+	resp := struct {
+		LoadBalancers []loadBalancer `xml:"CreateLoadBalancerResult>LoadBalancers>member"`
+	}{}
+	if err := c.call("CreateLoadBalancer", params, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.LoadBalancers) == 0 {
+		return nil, fmt.Errorf("no load balancer is created")
+	}
+	return &resp.LoadBalancers[0], nil
+}
+
+// deleteLoadBalancer deletes the NLB and its listeners
+func (c *elbClient) deleteLoadBalancer(arn string) error {
+	err := c.call("DeleteLoadBalancer", url.Values{"LoadBalancerArn": {arn}}, nil)
+	if isErrorCode(err, codeLoadBalancerNotFound) {
+		return nil
+	}
+	return err
+}
+
+// crossZone returns whether the cross-zone load balancing of NLB is enabled
+func (c *elbClient) crossZone(arn string) (bool, error) {
+	resp := struct {
+		Attributes []struct {
+			Key   string `xml:"Key"`
+			Value string `xml:"Value"`
+		} `xml:"DescribeLoadBalancerAttributesResult>Attributes>member"`
+	}{}
+	err := c.call("DescribeLoadBalancerAttributes", url.Values{"LoadBalancerArn": {arn}}, &resp)
+	if err != nil {
+		return false, err
+	}
+	for _, attr := range resp.Attributes {
+		if attr.Key == crossZoneAttribute {
+			return attr.Value == "true", nil
+		}
+	}
+	return false, nil
+}
+
+// setCrossZone enables or disables the cross-zone load balancing of NLB
+func (c *elbClient) setCrossZone(arn string, enabled bool) error {
+	return c.call("ModifyLoadBalancerAttributes", url.Values{
+		"LoadBalancerArn":           {arn},
+		"Attributes.member.1.Key":   {crossZoneAttribute},
+		"Attributes.member.1.Value": {strconv.FormatBool(enabled)},
+	}, nil)
+}
+
+// listeners returns the listeners of NLB
+func (c *elbClient) listeners(arn string) ([]listener, error) {
+	resp := struct {
+		Listeners []listener `xml:"DescribeListenersResult>Listeners>member"`
+	}{}
+	err := c.call("DescribeListeners", url.Values{"LoadBalancerArn": {arn}}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Listeners, nil
+}
+
+// createListener creates a listener forwarding to target group
+func (c *elbClient) createListener(arn, protocol string, port int, targetGroupARN string) error {
+	return c.call("CreateListener", url.Values{
+		"LoadBalancerArn":                        {arn},
+		"Protocol":                               {protocol},
+		"Port":                                   {strconv.Itoa(port)},
+		"DefaultActions.member.1.Type":           {"forward"},
+		"DefaultActions.member.1.TargetGroupArn": {targetGroupARN},
+	}, nil)
+}
+
+// deleteListener deletes a listener
+func (c *elbClient) deleteListener(arn string) error {
+	err := c.call("DeleteListener", url.Values{"ListenerArn": {arn}}, nil)
+	if isErrorCode(err, codeListenerNotFound) {
+		return nil
+	}
+	return err
+}
+
+// findTargetGroup returns the target group with name, or nil if not found
+func (c *elbClient) findTargetGroup(name string) (*targetGroup, error) {
+	params := url.Values{}
+	setMembers(params, "Names", []string{name})
+	resp := struct {
+		TargetGroups []targetGroup `xml:"DescribeTargetGroupsResult>TargetGroups>member"`
+	}{}
+	err := c.call("DescribeTargetGroups", params, &resp)
+	if isErrorCode(err, codeTargetGroupNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.TargetGroups) == 0 {
+		return nil, nil
+	}
+	return &resp.TargetGroups[0], nil
+}
+
+// createTargetGroup creates a target group of instances in vpc
+func (c *elbClient) createTargetGroup(name, protocol string, port int, vpcID string) (*targetGroup, error) {
+	resp := struct {
+		TargetGroups []targetGroup `xml:"CreateTargetGroupResult>TargetGroups>member"`
+	}{}
+	err := c.call("CreateTargetGroup", url.Values{
+		"Name":       {name},
+		"Protocol":   {protocol},
+		"Port":       {strconv.Itoa(port)},
+		"VpcId":      {vpcID},
+		"TargetType": {"instance"},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.TargetGroups) == 0 {
+		return nil, fmt.Errorf("no target group is created")
+	}
+	return &resp.TargetGroups[0], nil
+}
+
+// deleteTargetGroup deletes a target group
+func (c *elbClient) deleteTargetGroup(arn string) error {
+	err := c.call("DeleteTargetGroup", url.Values{"TargetGroupArn": {arn}}, nil)
+	if isErrorCode(err, codeTargetGroupNotFound) {
+		return nil
+	}
+	return err
+}
+
+// targets returns the instances registered to target group
+func (c *elbClient) targets(arn string) ([]string, error) {
+	resp := struct {
+		Targets []string `xml:"DescribeTargetHealthResult>TargetHealthDescriptions>member>Target>Id"`
+	}{}
+	err := c.call("DescribeTargetHealth", url.Values{"TargetGroupArn": {arn}}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Targets, nil
+}
+
+func targetParams(arn string, instances []string) url.Values {
+	params := url.Values{"TargetGroupArn": {arn}}
+	for i, id := range instances {
+		params.Set(fmt.Sprintf("Targets.member.%d.Id", i+1), id)
+	}
+	return params
+}
+
+// registerTargets registers instances to target group on the port of target group
+func (c *elbClient) registerTargets(arn string, instances []string) error {
+	return c.call("RegisterTargets", targetParams(arn, instances), nil)
+}
+
+// deregisterTargets deregisters instances from target group
+func (c *elbClient) deregisterTargets(arn string, instances []string) error {
+	return c.call("DeregisterTargets", targetParams(arn, instances), nil)
+}
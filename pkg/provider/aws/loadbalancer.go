@@ -0,0 +1,344 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	log "k8s.io/klog"
+)
+
+const (
+	nlbActiveState       = "active"
+	nlbProvisioningState = "provisioning"
+)
+
+// nlbListener is a listener of NLB, the port of targets is the same as the listener port
+type nlbListener struct {
+	Protocol string
+	Port     int
+}
+
+func (l nlbListener) String() string {
+	return fmt.Sprintf("%s/%d", l.Protocol, l.Port)
+}
+
+// nlbState is the state of NLB after sync
+type nlbState struct {
+	loadBalancer *loadBalancer
+	// targetGroups are the arns of target groups by listener
+	targetGroups map[string]string
+	targets      []string
+}
+
+func hash(s string) string {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// nlbName returns the name of NLB of lb, the generated name is at most 32 characters
+func nlbName(lb *lbapi.LoadBalancer) string {
+	if lb.Spec.Providers.AWS.Name != "" {
+		return lb.Spec.Providers.AWS.Name
+	}
+	base := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '-'
+	}, lb.Namespace+"-"+lb.Name)
+	if len(base) > 23 {
+		base = base[:23]
+	}
+	return strings.Trim(base, "-") + "-" + hash(lb.Namespace+"/"+lb.Name)
+}
+
+// targetGroupPrefix returns the name prefix of target groups of lb,
+// it is derived from the name of NLB which is unique in the region
+func targetGroupPrefix(lb *lbapi.LoadBalancer) string {
+	return "tg-" + hash(nlbName(lb)) + "-"
+}
+
+// targetGroupName returns the name of target group of listener
+func targetGroupName(lb *lbapi.LoadBalancer, l nlbListener) string {
+	protocol := strings.Replace(strings.ToLower(l.Protocol), "_", "", -1)
+	return fmt.Sprintf("%s%s-%d", targetGroupPrefix(lb), protocol, l.Port)
+}
+
+// ownedTargetGroup returns true if the target group with arn is created for lb,
+// the arn is in the format of arn:aws:elasticloadbalancing:region:account:targetgroup/name/id
+func ownedTargetGroup(lb *lbapi.LoadBalancer, arn string) bool {
+	return strings.Contains(arn, ":targetgroup/"+targetGroupPrefix(lb))
+}
+
+// desiredListeners returns the listeners of http, https port and streams of lb's proxy,
+// tcp and udp streams on the same port are merged into a TCP_UDP listener
+func desiredListeners(lb *lbapi.LoadBalancer) []nlbListener {
+	listeners := make([]nlbListener, 0)
+	// proxy listeners are sorted by port, so TCP and UDP on the same port are adjacent
+	for _, l := range lbutil.ProxyListeners(lb) {
+		if n := len(listeners); n > 0 && listeners[n-1].Port == l.Port {
+			listeners[n-1].Protocol = "TCP_UDP"
+			continue
+		}
+		listeners = append(listeners, nlbListener{Protocol: string(l.Protocol), Port: l.Port})
+	}
+	return listeners
+}
+
+// instanceID returns the EC2 instance id of node from its provider id,
+// which is in the format of aws:///<zone>/<instance>
+func instanceID(node *v1.Node) string {
+	if !strings.HasPrefix(node.Spec.ProviderID, "aws://") {
+		return ""
+	}
+	return node.Spec.ProviderID[strings.LastIndex(node.Spec.ProviderID, "/")+1:]
+}
+
+// instances returns the sorted EC2 instances of nodes
+func instances(nodes []*v1.Node) []string {
+	ids := sets.NewString()
+	for _, node := range nodes {
+		id := instanceID(node)
+		if id == "" {
+			log.Warningf("Node %v has no EC2 instance id in provider id, skip it", node.Name)
+			continue
+		}
+		ids.Insert(id)
+	}
+	return ids.List()
+}
+
+// ensureNLB creates the NLB of lb if necessary, and syncs its attributes, listeners,
+// target groups and targets. It returns the state of NLB and whether it is created.
+func ensureNLB(c *elbClient, lb *lbapi.LoadBalancer, targets []string) (*nlbState, bool, error) {
+	spec := lb.Spec.Providers.AWS
+	name := nlbName(lb)
+
+	var nlb *loadBalancer
+	var err error
+	if status := lb.Status.ProvidersStatuses.AWS; status != nil && status.LoadBalancerARN != "" {
+		nlb, err = c.describeLoadBalancer(status.LoadBalancerARN)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if nlb == nil {
+		// NLB may be created before status is saved
+		nlb, err = c.findLoadBalancer(name)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	created := false
+	if nlb == nil {
+		scheme := spec.Scheme
+		if scheme == "" {
+			scheme = lbapi.AWSInternetFacingScheme
+		}
+		nlb, err = c.createLoadBalancer(name, string(scheme), spec.Subnets, spec.EIPAllocations)
+		if err != nil {
+			return nil, false, err
+		}
+		log.Infof("Create NLB %v for lb %v/%v", nlb.ARN, lb.Namespace, lb.Name)
+		created = true
+	}
+	state := &nlbState{loadBalancer: nlb, targetGroups: make(map[string]string)}
+
+	crossZone, err := c.crossZone(nlb.ARN)
+	if err != nil {
+		return state, created, err
+	}
+	if crossZone != spec.CrossZone {
+		log.Infof("Set cross-zone load balancing of NLB %v to %v", nlb.Name, spec.CrossZone)
+		if err := c.setCrossZone(nlb.ARN, spec.CrossZone); err != nil {
+			return state, created, err
+		}
+	}
+
+	listeners, err := c.listeners(nlb.ARN)
+	if err != nil {
+		return state, created, err
+	}
+	desired := desiredListeners(lb)
+	wanted := make(map[string]bool)
+	for _, l := range desired {
+		wanted[l.String()] = true
+	}
+	existing := make(map[string]bool)
+	for _, l := range listeners {
+		key := nlbListener{Protocol: l.Protocol, Port: l.Port}.String()
+		if wanted[key] {
+			existing[key] = true
+			continue
+		}
+		log.Infof("Delete listener %v of NLB %v", key, nlb.Name)
+		if err := c.deleteListener(l.ARN); err != nil {
+			return state, created, err
+		}
+		if ownedTargetGroup(lb, l.TargetGroupARN) {
+			if err := c.deleteTargetGroup(l.TargetGroupARN); err != nil {
+				return state, created, err
+			}
+		}
+	}
+
+	for _, l := range desired {
+		tgName := targetGroupName(lb, l)
+		tg, err := c.findTargetGroup(tgName)
+		if err != nil {
+			return state, created, err
+		}
+		if tg == nil {
+			log.Infof("Create target group %v of NLB %v", tgName, nlb.Name)
+			tg, err = c.createTargetGroup(tgName, l.Protocol, l.Port, nlb.VPCID)
+			if err != nil {
+				return state, created, err
+			}
+		}
+		state.targetGroups[l.String()] = tg.ARN
+		if !existing[l.String()] {
+			log.Infof("Create listener %v of NLB %v", l, nlb.Name)
+			if err := c.createListener(nlb.ARN, l.Protocol, l.Port, tg.ARN); err != nil {
+				return state, created, err
+			}
+		}
+
+		registered, err := c.targets(tg.ARN)
+		if err != nil {
+			return state, created, err
+		}
+		want := sets.NewString(targets...)
+		have := sets.NewString(registered...)
+		if add := want.Difference(have).List(); len(add) > 0 {
+			log.Infof("Register targets %v to target group %v", add, tgName)
+			if err := c.registerTargets(tg.ARN, add); err != nil {
+				return state, created, err
+			}
+		}
+		if remove := have.Difference(want).List(); len(remove) > 0 {
+			log.Infof("Deregister targets %v from target group %v", remove, tgName)
+			if err := c.deregisterTargets(tg.ARN, remove); err != nil {
+				return state, created, err
+			}
+		}
+	}
+	state.targets = targets
+	return state, created, nil
+}
+
+// deleteNLB deletes the NLB of lb and the target groups created for it
+func deleteNLB(c *elbClient, lb *lbapi.LoadBalancer) error {
+	var nlb *loadBalancer
+	var err error
+	status := lb.Status.ProvidersStatuses.AWS
+	if status != nil && status.LoadBalancerARN != "" {
+		nlb, err = c.describeLoadBalancer(status.LoadBalancerARN)
+	} else {
+		nlb, err = c.findLoadBalancer(nlbName(lb))
+	}
+	if err != nil {
+		return err
+	}
+
+	targetGroups := sets.NewString()
+	if status != nil {
+		for _, arn := range status.TargetGroups {
+			targetGroups.Insert(arn)
+		}
+	}
+	if nlb != nil {
+		listeners, err := c.listeners(nlb.ARN)
+		if err != nil {
+			return err
+		}
+		for _, l := range listeners {
+			if ownedTargetGroup(lb, l.TargetGroupARN) {
+				targetGroups.Insert(l.TargetGroupARN)
+			}
+		}
+		log.Infof("Delete NLB %v of lb %v/%v", nlb.Name, lb.Namespace, lb.Name)
+		if err := c.deleteLoadBalancer(nlb.ARN); err != nil {
+			return err
+		}
+	}
+
+	// target groups can only be deleted after listeners are gone
+	for _, arn := range targetGroups.List() {
+		if err := c.deleteTargetGroup(arn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeStatus computes the status of NLB, the NLB in old status is kept if
+// state is nil, which means NLB can't be described
+func computeStatus(old *lbapi.AWSProviderStatus, state *nlbState, syncErr error) *lbapi.AWSProviderStatus {
+	status := &lbapi.AWSProviderStatus{}
+	if state != nil {
+		nlb := state.loadBalancer
+		status.LoadBalancerARN = nlb.ARN
+		status.DNSName = nlb.DNSName
+		status.Addresses = nlb.Addresses
+		if len(state.targetGroups) > 0 {
+			status.TargetGroups = state.targetGroups
+		}
+		status.Targets = state.targets
+	} else if old != nil {
+		status.LoadBalancerARN = old.LoadBalancerARN
+		status.DNSName = old.DNSName
+		status.Addresses = old.Addresses
+		status.TargetGroups = old.TargetGroups
+		status.Targets = old.Targets
+	}
+	if len(status.Addresses) == 0 {
+		status.Addresses = nil
+	}
+	if len(status.Targets) == 0 {
+		status.Targets = nil
+	}
+
+	switch {
+	case syncErr != nil:
+		status.Phase = lbapi.AWSErrorPhase
+		status.Message = syncErr.Error()
+	case state == nil:
+		status.Phase = lbapi.AWSProgressingPhase
+	case state.loadBalancer.State == nlbActiveState:
+		status.Phase = lbapi.AWSRunningPhase
+	case state.loadBalancer.State == nlbProvisioningState:
+		status.Phase = lbapi.AWSProgressingPhase
+		status.Message = "NLB is provisioning"
+	default:
+		status.Phase = lbapi.AWSErrorPhase
+		status.Message = fmt.Sprintf("NLB is %v", state.loadBalancer.State)
+		if state.loadBalancer.StateReason != "" {
+			status.Message += ": " + state.loadBalancer.StateReason
+		}
+	}
+	return status
+}
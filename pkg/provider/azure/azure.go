@@ -28,6 +28,7 @@ import (
 	controllerutil "github.com/caicloud/clientset/util/controller"
 	"github.com/caicloud/clientset/util/syncqueue"
 	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	policyv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/policy/v1"
 	"github.com/caicloud/loadbalancer-controller/pkg/config"
 	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
 	"github.com/caicloud/loadbalancer-controller/pkg/toleration"
@@ -52,11 +53,14 @@ const (
 )
 
 type azure struct {
-	initialized bool
-	image       string
+	initialized   bool
+	image         string
+	allowedImages []string
 
 	client kubernetes.Interface
 	queue  *syncqueue.SyncQueue
+	// policyClient manages policy/v1 PodDisruptionBudgets
+	policyClient *policyv1.Client
 
 	lbLister  lblisters.LoadBalancerLister
 	dLister   appslisters.DeploymentLister
@@ -78,7 +82,9 @@ func (f *azure) Init(cfg config.Configuration, sif informers.SharedInformerFacto
 
 	// set config
 	f.image = cfg.Providers.Azure.Image
+	f.allowedImages = cfg.AllowedImages
 	f.client = cfg.Client
+	f.policyClient = policyv1.New(cfg.Client.Native().PolicyV1beta1().RESTClient())
 
 	// initialize controller
 	lbInformer := sif.Custom().Loadbalance().V1alpha2().LoadBalancers()
@@ -90,7 +96,7 @@ func (f *azure) Init(cfg config.Configuration, sif informers.SharedInformerFacto
 	f.podLister = podInfomer.Lister()
 	f.queue = syncqueue.NewPassthroughSyncQueue(&lbapi.LoadBalancer{}, f.syncLoadBalancer)
 
-	dInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDeployment(f.lbLister, f.dLister, f.queue, f.deploymentFiltered))
+	dInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDeployment(f.lbLister, f.queue, f.deploymentFiltered))
 	podInfomer.Informer().AddEventHandler(lbutil.NewEventHandlerForSyncStatusWithPod(f.lbLister, f.podLister, f.queue, f.podFiltered))
 }
 
@@ -166,6 +172,8 @@ func (f *azure) syncLoadBalancer(obj interface{}) error {
 	nlb, err := f.lbLister.LoadBalancers(lb.Namespace).Get(lb.Name)
 	if errors.IsNotFound(err) {
 		log.Warningf("LoadBalancer %v has been deleted, clean up provider", key)
+		// events of a deleted lb are never changed again
+		lbutil.ForgetEvents(lb)
 
 		return f.cleanup(lb, false)
 	}
@@ -230,7 +238,11 @@ func (f *azure) getDeploymentsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv1
 
 // sync generate desired deployment from lb and compare it with existing deployment
 func (f *azure) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
-	desiredDeploy := f.generateDeployment(lb)
+	desiredDeploy, err := f.generateDeployment(lb, f.image)
+	if err != nil {
+		log.Errorf("Generate deployment for loadbalancer %v error: %v", lb.Name, err)
+		return err
+	}
 
 	// update
 	updated := false
@@ -255,7 +267,7 @@ func (f *azure) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
 		updated = true
 		if !lbutil.IsStatic(lb) {
 			// do not change deployment if the loadbalancer is static
-			merged, changed := lbutil.MergeDeployment(dp, desiredDeploy)
+			merged, changed := lbutil.MergeDeployment(dp, desiredDeploy, false)
 			if changed {
 				log.Infof("Sync azure deployment %v for lb %v", dp.Name, lb.Name)
 				_, err := f.client.Native().AppsV1().Deployments(lb.Namespace).Update(merged)
@@ -276,6 +288,11 @@ func (f *azure) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
 		}
 	}
 
+	err = lbutil.EnsurePodDisruptionBudget(f.policyClient, lb, lb.Name+providerNameSuffix, f.selector(lb), *desiredDeploy.Spec.Replicas, nil)
+	if err != nil {
+		return err
+	}
+
 	return f.syncStatus(lb)
 }
 
@@ -296,6 +313,11 @@ func (f *azure) cleanup(lb *lbapi.LoadBalancer, deleteStatus bool) error {
 		})
 	}
 
+	err = lbutil.DeletePodDisruptionBudget(f.policyClient, lb.Namespace, lb.Name+providerNameSuffix)
+	if err != nil {
+		return err
+	}
+
 	if deleteStatus {
 		return f.deleteStatus(lb)
 	}
@@ -303,7 +325,13 @@ func (f *azure) cleanup(lb *lbapi.LoadBalancer, deleteStatus bool) error {
 	return nil
 }
 
-func (f *azure) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
+// generateDeployment generates the deployment of lb, defaultImage is used
+// if lb does not override the image
+func (f *azure) generateDeployment(lb *lbapi.LoadBalancer, defaultImage string) (*appsv1.Deployment, error) {
+	image, err := lbutil.ResolveLoadBalancerImage(f.client, lb, "azure", defaultImage, lb.Spec.Providers.Azure.Image, lb.Spec.Providers.Azure.Version, f.allowedImages)
+	if err != nil {
+		return nil, err
+	}
 	terminationGracePeriodSeconds := int64(300)
 	replicas := int32(1)
 	t := true
@@ -344,7 +372,7 @@ func (f *azure) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 					Containers: []v1.Container{
 						{
 							Name:            providerName,
-							Image:           f.image,
+							Image:           image,
 							ImagePullPolicy: v1.PullAlways,
 							Resources: v1.ResourceRequirements{
 								Requests: v1.ResourceList{
@@ -389,5 +417,11 @@ func (f *azure) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 		},
 	}
 
-	return deploy
+	// apply the pod template overlay
+	err = lbutil.ApplyPodTemplate(deploy, lb.Spec.Providers.Azure.PodTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return deploy, nil
 }
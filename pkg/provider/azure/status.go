@@ -17,6 +17,8 @@ limitations under the License.
 package azure
 
 import (
+	"reflect"
+
 	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
 	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
 
@@ -87,5 +89,26 @@ func (f *azure) syncStatus(lb *lbapi.LoadBalancer) error {
 			return err
 		}
 	}
+
+	images := lbutil.RunningImages(podList, providerName)
+	if lb.Status.ProvidersStatuses.Azure == nil || !reflect.DeepEqual(lb.Status.ProvidersStatuses.Azure.RunningImages, images) {
+		_, err := lbutil.UpdateLBWithRetries(
+			f.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+			f.lbLister,
+			lb.Namespace,
+			lb.Name,
+			func(lb *lbapi.LoadBalancer) error {
+				if lb.Status.ProvidersStatuses.Azure == nil {
+					lb.Status.ProvidersStatuses.Azure = &lbapi.AzureProviderStatus{}
+				}
+				lb.Status.ProvidersStatuses.Azure.RunningImages = images
+				return nil
+			},
+		)
+		if err != nil {
+			log.Errorf("Update loadbalancer status error: %v", err)
+			return err
+		}
+	}
 	return nil
 }
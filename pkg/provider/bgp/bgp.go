@@ -0,0 +1,511 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bgp
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	controllerutil "github.com/caicloud/clientset/util/controller"
+	"github.com/caicloud/clientset/util/syncqueue"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	"github.com/caicloud/loadbalancer-controller/pkg/toleration"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	log "k8s.io/klog"
+)
+
+const (
+	providerNameSuffix    = "-provider-bgp"
+	providerName          = "bgp"
+	providerPriorityClass = "system-node-critical"
+	speakerConfigDir      = "/etc/bgp"
+	speakerConfigVolume   = "bgp-config"
+)
+
+type bgp struct {
+	initialized   bool
+	image         string
+	allowedImages []string
+
+	client kubernetes.Interface
+	queue  *syncqueue.SyncQueue
+
+	lbLister   lblisters.LoadBalancerLister
+	dsLister   appslisters.DaemonSetLister
+	podLister  corelisters.PodLister
+	nodeLister corelisters.NodeLister
+}
+
+// New creates a new bgp provider plugin
+func New() plugin.Interface {
+	return &bgp{}
+}
+
+func (f *bgp) Init(cfg config.Configuration, sif informers.SharedInformerFactory) {
+	if f.initialized {
+		return
+	}
+	f.initialized = true
+
+	log.Info("Initialize the bgp provider")
+
+	// set config
+	f.image = cfg.Providers.BGP.Image
+	f.allowedImages = cfg.AllowedImages
+	f.client = cfg.Client
+
+	// initialize controller
+	lbInformer := sif.Custom().Loadbalance().V1alpha2().LoadBalancers()
+	dsInformer := sif.Native().Apps().V1().DaemonSets()
+	podInformer := sif.Native().Core().V1().Pods()
+	nodeInformer := sif.Native().Core().V1().Nodes()
+
+	f.lbLister = lbInformer.Lister()
+	f.dsLister = dsInformer.Lister()
+	f.podLister = podInformer.Lister()
+	f.nodeLister = nodeInformer.Lister()
+	f.queue = syncqueue.NewPassthroughSyncQueue(&lbapi.LoadBalancer{}, f.syncLoadBalancer)
+
+	dsInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDaemonSet(f.lbLister, f.queue, f.daemonSetFiltered))
+	// both speaker and proxy pods, vips are announced from nodes with ready proxy pods
+	// and speakers report the state of sessions by pod conditions
+	podInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForSyncStatusWithPod(f.lbLister, f.podLister, f.queue, f.podFiltered))
+}
+
+func (f *bgp) Run(stopCh <-chan struct{}) {
+
+	workers := 1
+
+	if !f.initialized {
+		panic("Please initialize provider before you run it")
+	}
+
+	defer utilruntime.HandleCrash()
+
+	log.Infof("Starting bgp provider, workers %v, image %v", workers, f.image)
+
+	// lb controller has waited all the informer synced
+	// there is no need to wait again here
+
+	defer func() {
+		log.Info("Shutting down bgp provider")
+		f.queue.ShutDown()
+	}()
+
+	f.queue.Run(workers)
+
+	<-stopCh
+}
+
+func (f *bgp) selector(lb *lbapi.LoadBalancer) labels.Set {
+	return labels.Set{
+		lbapi.LabelKeyCreatedBy: fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name),
+		lbapi.LabelKeyProvider:  providerName,
+	}
+}
+
+// proxySelector selects the proxy pods of lb
+func (f *bgp) proxySelector(lb *lbapi.LoadBalancer) labels.Selector {
+	exists, _ := labels.NewRequirement(lbapi.LabelKeyProxy, selection.Exists, nil)
+	createdBy := labels.Set{lbapi.LabelKeyCreatedBy: fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name)}
+	return labels.SelectorFromSet(createdBy).Add(*exists)
+}
+
+func (f *bgp) daemonSetFiltered(obj *appsv1.DaemonSet) bool {
+	selector := labels.Set{lbapi.LabelKeyProvider: providerName}.AsSelector()
+	return !selector.Matches(labels.Set(obj.Labels))
+}
+
+func (f *bgp) podFiltered(obj *v1.Pod) bool {
+	if obj.Labels[lbapi.LabelKeyProvider] == providerName {
+		return false
+	}
+	if _, ok := obj.Labels[lbapi.LabelKeyProxy]; !ok {
+		return true
+	}
+	// proxy pods of LoadBalancers using bgp provider
+	namespace, name, err := lbutil.SplitNamespaceAndNameByDot(obj.Labels[lbapi.LabelKeyCreatedBy])
+	if err != nil {
+		return true
+	}
+	lb, err := f.lbLister.LoadBalancers(namespace).Get(name)
+	return err != nil || lb.Spec.Providers.BGP == nil
+}
+
+func (f *bgp) OnSync(lb *lbapi.LoadBalancer) {
+	log.Infof("Syncing providers, triggered by loadbalancer %v/%v", lb.Namespace, lb.Name)
+	f.queue.Enqueue(lb)
+}
+
+func (f *bgp) syncLoadBalancer(obj interface{}) error {
+	lb, ok := obj.(*lbapi.LoadBalancer)
+	if !ok {
+		return fmt.Errorf("expect loadbalancer, got %v", obj)
+	}
+
+	key, _ := cache.DeletionHandlingMetaNamespaceKeyFunc(lb)
+
+	startTime := time.Now()
+	defer func() {
+		log.V(5).Infof("Finished syncing bgp provider for %v, usedTime %v", key, time.Since(startTime))
+	}()
+
+	nlb, err := f.lbLister.LoadBalancers(lb.Namespace).Get(lb.Name)
+	if errors.IsNotFound(err) {
+		log.Warningf("LoadBalancer %v has been deleted, clean up provider", key)
+		// events of a deleted lb are never changed again
+		lbutil.ForgetEvents(lb)
+
+		return f.cleanup(lb, false)
+	}
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("Unable to retrieve LoadBalancer %v from store: %v", key, err))
+		return err
+	}
+
+	// fresh lb
+	if lb.UID != nlb.UID {
+		return nil
+	}
+	lb = nlb.DeepCopy()
+
+	if lb.Spec.Providers.BGP == nil {
+		// It is not my responsible, clean up legacies
+		return f.cleanup(lb, true)
+	}
+
+	dss, err := f.getDaemonSetsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+
+	if lb.DeletionTimestamp != nil {
+		// TODO sync status only
+		return nil
+	}
+
+	return f.sync(lb, dss)
+}
+
+func (f *bgp) getDaemonSetsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv1.DaemonSet, error) {
+
+	// construct selector
+	selector := f.selector(lb).AsSelector()
+
+	// list all
+	dsList, err := f.dsLister.DaemonSets(lb.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	canAdoptFunc := controllerutil.RecheckDeletionTimestamp(func() (metav1.Object, error) {
+		// fresh lb
+		fresh, err := f.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace).Get(lb.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if fresh.UID != lb.UID {
+			return nil, fmt.Errorf("original LoadBalancer %v/%v is gone: got uid %v, wanted %v", lb.Namespace, lb.Name, fresh.UID, lb.UID)
+		}
+		return fresh, nil
+	})
+
+	cm := controllerutil.NewDaemonSetControllerRefManager(f.client.Native(), lb, selector, api.ControllerKind, canAdoptFunc)
+	return cm.Claim(dsList)
+}
+
+// sync renders the speaker config from lb and the ready proxy pods, and runs
+// speakers with a daemonset on the nodes of lb
+func (f *bgp) sync(lb *lbapi.LoadBalancer, dss []*appsv1.DaemonSet) error {
+	provider := lb.Spec.Providers.BGP
+	if f.image == "" && provider.Image == "" {
+		// there is no default speaker, it is provided by users following docs/images.md
+		err := fmt.Errorf("no bgp speaker image, set it by --provider-bgp or spec.providers.bgp.image")
+		lbutil.RecordEventOnChange(f.client, lb, "bgp/speaker", v1.EventTypeWarning, "NoSpeakerImage", "%v", err)
+		return err
+	}
+	lbutil.ForgetEvent(lb, "bgp/speaker")
+	image, err := lbutil.ResolveLoadBalancerImage(f.client, lb, "bgp", f.image, provider.Image, provider.Version, f.allowedImages)
+	if err != nil {
+		return err
+	}
+
+	nodeSelector := labels.Set{fmt.Sprintf(lbapi.UniqueLabelKeyFormat, lb.Namespace, lb.Name): "true"}.AsSelector()
+	nodes, err := f.nodeLister.List(nodeSelector)
+	if err != nil {
+		return err
+	}
+	proxyPods, err := f.podLister.Pods(lb.Namespace).List(f.proxySelector(lb))
+	if err != nil {
+		return err
+	}
+
+	config := newSpeakerConfig(lb, nodes, proxyPods)
+	cm, err := f.ensureConfigMap(lb, config)
+	if err != nil {
+		return err
+	}
+
+	desired, err := f.generateDaemonSet(lb, image)
+	if err != nil {
+		log.Errorf("Generate daemonset for loadbalancer %v error: %v", lb.Name, err)
+		return err
+	}
+	ds, err := lbutil.EnsureDaemonSet(f.client, lb, desired, dss)
+	if err != nil {
+		return err
+	}
+
+	return f.syncStatus(lb, ds, cm, config)
+}
+
+// ensureConfigMap writes the speaker config to the ConfigMap mounted into speaker pods
+func (f *bgp) ensureConfigMap(lb *lbapi.LoadBalancer, config speakerConfig) (*v1.ConfigMap, error) {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	name := lb.Name + providerNameSuffix
+	cm, err := f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Get(name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		t := true
+		cm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: f.selector(lb),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         api.ControllerKind.GroupVersion().String(),
+						Kind:               api.ControllerKind.Kind,
+						Name:               lb.Name,
+						UID:                lb.UID,
+						Controller:         &t,
+						BlockOwnerDeletion: &t,
+					},
+				},
+			},
+			Data: map[string]string{speakerConfigKey: string(data)},
+		}
+		log.Infof("Create bgp ConfigMap %v for lb %v", name, lb.Name)
+		return f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Create(cm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cm.Data[speakerConfigKey] == string(data) {
+		return cm, nil
+	}
+	cm = cm.DeepCopy()
+	cm.Data = map[string]string{speakerConfigKey: string(data)}
+	log.Infof("Update bgp ConfigMap %v for lb %v", name, lb.Name)
+	return f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Update(cm)
+}
+
+// cleanup daemonset and other resource controlled by bgp provider
+func (f *bgp) cleanup(lb *lbapi.LoadBalancer, deleteStatus bool) error {
+	dss, err := f.getDaemonSetsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+	if len(dss) > 0 {
+		// the ConfigMap is only created with the daemonset
+		err = lbutil.DeleteDaemonSets(f.client, dss...)
+		if err != nil {
+			return err
+		}
+		err = f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Delete(lb.Name+providerNameSuffix, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	if deleteStatus {
+		return f.deleteStatus(lb)
+	}
+	return nil
+}
+
+// generateDaemonSet generates the speaker daemonset of lb
+func (f *bgp) generateDaemonSet(lb *lbapi.LoadBalancer, image string) (*appsv1.DaemonSet, error) {
+	terminationGracePeriodSeconds := int64(30)
+	t := true
+	labels := f.selector(lb)
+
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   lb.Name + providerNameSuffix,
+			Labels: labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         api.ControllerKind.GroupVersion().String(),
+					Kind:               api.ControllerKind.Kind,
+					Name:               lb.Name,
+					UID:                lb.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				},
+			},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: v1.PodSpec{
+					// speakers peer with routers from node ips
+					HostNetwork:                   true,
+					DNSPolicy:                     v1.DNSClusterFirstWithHostNet,
+					PriorityClassName:             providerPriorityClass,
+					TerminationGracePeriodSeconds: &terminationGracePeriodSeconds,
+					Affinity: &v1.Affinity{
+						// run on the nodes of lb
+						NodeAffinity: &v1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+								NodeSelectorTerms: []v1.NodeSelectorTerm{
+									{
+										MatchExpressions: []v1.NodeSelectorRequirement{
+											{
+												Key:      fmt.Sprintf(lbapi.UniqueLabelKeyFormat, lb.Namespace, lb.Name),
+												Operator: v1.NodeSelectorOpIn,
+												Values:   []string{"true"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					// tolerate taints
+					Tolerations: toleration.GenerateTolerations(),
+					Containers: []v1.Container{
+						{
+							Name:            providerName,
+							Image:           image,
+							ImagePullPolicy: v1.PullAlways,
+							Resources: v1.ResourceRequirements{
+								Limits: v1.ResourceList{
+									v1.ResourceCPU:    resource.MustParse("200m"),
+									v1.ResourceMemory: resource.MustParse("100Mi"),
+								},
+								Requests: v1.ResourceList{
+									v1.ResourceCPU:    resource.MustParse("50m"),
+									v1.ResourceMemory: resource.MustParse("50Mi"),
+								},
+							},
+							SecurityContext: &v1.SecurityContext{
+								// vips are added to the local interface
+								Capabilities: &v1.Capabilities{
+									Add: []v1.Capability{"NET_ADMIN"},
+								},
+							},
+							Env: []v1.EnvVar{
+								{
+									Name: "NODE_NAME",
+									ValueFrom: &v1.EnvVarSource{
+										FieldRef: &v1.ObjectFieldSelector{
+											FieldPath: "spec.nodeName",
+										},
+									},
+								},
+								{
+									// speakers report the state of sessions by the conditions of their pods
+									Name: "POD_NAME",
+									ValueFrom: &v1.EnvVarSource{
+										FieldRef: &v1.ObjectFieldSelector{
+											FieldPath: "metadata.name",
+										},
+									},
+								},
+								{
+									Name: "POD_NAMESPACE",
+									ValueFrom: &v1.EnvVarSource{
+										FieldRef: &v1.ObjectFieldSelector{
+											FieldPath: "metadata.namespace",
+										},
+									},
+								},
+								{
+									Name:  "LOADBALANCER_NAMESPACE",
+									Value: lb.Namespace,
+								},
+								{
+									Name:  "LOADBALANCER_NAME",
+									Value: lb.Name,
+								},
+								{
+									Name:  "BGP_CONFIG",
+									Value: speakerConfigDir + "/" + speakerConfigKey,
+								},
+							},
+							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      speakerConfigVolume,
+									MountPath: speakerConfigDir,
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+					Volumes: []v1.Volume{
+						{
+							Name: speakerConfigVolume,
+							VolumeSource: v1.VolumeSource{
+								ConfigMap: &v1.ConfigMapVolumeSource{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: lb.Name + providerNameSuffix,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// apply the pod template overlay
+	err := lbutil.ApplyPodTemplate(deploy, lb.Spec.Providers.BGP.PodTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return lbutil.GenerateDaemonSet(deploy, deploy.Name), nil
+}
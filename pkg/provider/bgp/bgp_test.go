@@ -0,0 +1,124 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bgp
+
+import (
+	"reflect"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newNode(name, rack string) *v1.Node {
+	return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"rack": rack}}}
+}
+
+func newPod(name, node string, ready bool) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1.PodSpec{
+			NodeName:   node,
+			Containers: []v1.Container{{Name: "c"}},
+		},
+		Status: v1.PodStatus{
+			Phase: v1.PodRunning,
+			PodIP: "127.0.0.1",
+			ContainerStatuses: []v1.ContainerStatus{{
+				Name:  "c",
+				Ready: ready,
+				State: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			}},
+		},
+	}
+}
+
+func newLoadBalancer() *lbapi.LoadBalancer {
+	lb := &lbapi.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "lb"}}
+	lb.Spec.Providers.BGP = &lbapi.BGPProvider{
+		VIPs: []string{"10.1.0.100"},
+		ASN:  64512,
+		Peers: []lbapi.BGPPeer{
+			{Address: "10.0.0.1", ASN: 64513, NodeSelector: map[string]string{"rack": "a"}},
+			{Address: "10.0.0.2", ASN: 64514, Port: 1179},
+		},
+		Communities: []string{"64512:100"},
+	}
+	return lb
+}
+
+func TestSpeakerConfig(t *testing.T) {
+	nodes := []*v1.Node{newNode("n3", "a"), newNode("n1", "a"), newNode("n2", "b")}
+	proxyPods := []*v1.Pod{newPod("p1", "n1", true), newPod("p2", "n2", false), newPod("p4", "n4", true)}
+
+	config := newSpeakerConfig(newLoadBalancer(), nodes, proxyPods)
+	if !reflect.DeepEqual(config.AnnouncingNodes, []string{"n1"}) {
+		t.Errorf("got announcing nodes %v, want [n1]", config.AnnouncingNodes)
+	}
+	want := []speakerPeer{
+		{Address: "10.0.0.1", ASN: 64513, Port: defaultBGPPort, Nodes: []string{"n1", "n3"}, Condition: "loadbalance.caicloud.io/bgp-peer-10.0.0.1"},
+		{Address: "10.0.0.2", ASN: 64514, Port: 1179, Nodes: []string{"n1", "n2", "n3"}, Condition: "loadbalance.caicloud.io/bgp-peer-10.0.0.2"},
+	}
+	if !reflect.DeepEqual(config.Peers, want) {
+		t.Errorf("got peers %+v, want %+v", config.Peers, want)
+	}
+}
+
+func TestPeerStatuses(t *testing.T) {
+	config := speakerConfig{
+		Peers: []speakerPeer{
+			{Address: "10.0.0.1", ASN: 64513, Nodes: []string{"n1", "n2"}},
+			{Address: "10.0.0.3", ASN: 64515, Nodes: []string{"n1"}},
+			{Address: "fd00::2", ASN: 64514, Nodes: []string{"n1"}},
+		},
+	}
+	speaker := newPod("s1", "n1", true)
+	speaker.Status.Conditions = []v1.PodCondition{
+		{Type: peerConditionType("10.0.0.1"), Status: v1.ConditionTrue, Reason: "Established"},
+		{Type: peerConditionType("fd00::2"), Status: v1.ConditionFalse, Reason: "OpenSent", Message: "waiting for open"},
+	}
+	statuses := peerStatuses(config, []*v1.Pod{speaker})
+
+	want := []lbapi.BGPSessionState{
+		lbapi.BGPSessionEstablished,
+		lbapi.BGPSessionUnknown,
+		lbapi.BGPSessionUnknown,
+		lbapi.BGPSessionOpenSent,
+	}
+	if len(statuses) != len(want) {
+		t.Fatalf("got %d statuses, want %d", len(statuses), len(want))
+	}
+	for i, s := range statuses {
+		if s.State != want[i] {
+			t.Errorf("status %d %+v, want state %v", i, s, want[i])
+		}
+	}
+	if statuses[1].Node != "n2" || statuses[1].Message != "speaker is not running" {
+		t.Errorf("unexpected status of node without speaker %+v", statuses[1])
+	}
+	if statuses[2].Message != "session is not reported by speaker" {
+		t.Errorf("unexpected status of unreported peer %+v", statuses[2])
+	}
+	if statuses[3].Message != "waiting for open" {
+		t.Errorf("unexpected status of ipv6 peer %+v", statuses[3])
+	}
+	if got := string(peerConditionType("fd00::2")); got != "loadbalance.caicloud.io/bgp-peer-fd00--2" {
+		t.Errorf("got condition type %v of ipv6 peer", got)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bgp
+
+import (
+	"sort"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	speakerConfigKey = "bgp.json"
+	defaultBGPPort   = 179
+)
+
+// speakerPeer is an upstream router and the nodes peering with it
+type speakerPeer struct {
+	Address string   `json:"address"`
+	ASN     uint32   `json:"asn"`
+	Port    int32    `json:"port"`
+	Nodes   []string `json:"nodes"`
+	// Condition is the type of pod condition reporting the session with peer
+	Condition v1.PodConditionType `json:"condition"`
+}
+
+// speakerConfig is the configuration of speakers of a LoadBalancer, a speaker
+// only peers with the peers containing its node, and withdraws vips if its node
+// is not announcing
+type speakerConfig struct {
+	ASN         uint32        `json:"asn"`
+	VIPs        []string      `json:"vips"`
+	Communities []string      `json:"communities,omitempty"`
+	Peers       []speakerPeer `json:"peers"`
+	// AnnouncingNodes are the nodes with ready proxy pods
+	AnnouncingNodes []string `json:"announcingNodes"`
+}
+
+// newSpeakerConfig generates the speaker config of lb, nodes are the nodes of
+// lb and proxyPods are the proxy pods of lb
+func newSpeakerConfig(lb *lbapi.LoadBalancer, nodes []*v1.Node, proxyPods []*v1.Pod) speakerConfig {
+	provider := lb.Spec.Providers.BGP
+	config := speakerConfig{
+		ASN:             provider.ASN,
+		VIPs:            provider.VIPs,
+		Communities:     provider.Communities,
+		Peers:           make([]speakerPeer, 0, len(provider.Peers)),
+		AnnouncingNodes: announcingNodes(nodes, proxyPods),
+	}
+
+	for _, peer := range provider.Peers {
+		sp := speakerPeer{
+			Address:   peer.Address,
+			ASN:       peer.ASN,
+			Port:      peer.Port,
+			Nodes:     make([]string, 0),
+			Condition: peerConditionType(peer.Address),
+		}
+		if sp.Port == 0 {
+			sp.Port = defaultBGPPort
+		}
+		selector := labels.SelectorFromSet(peer.NodeSelector)
+		for _, node := range nodes {
+			if selector.Matches(labels.Set(node.Labels)) {
+				sp.Nodes = append(sp.Nodes, node.Name)
+			}
+		}
+		sort.Strings(sp.Nodes)
+		config.Peers = append(config.Peers, sp)
+	}
+	return config
+}
+
+// announcingNodes returns the sorted nodes which have ready proxy pods, traffic
+// to vips is only attracted to them
+func announcingNodes(nodes []*v1.Node, proxyPods []*v1.Pod) []string {
+	ready := make(map[string]bool)
+	for _, pod := range proxyPods {
+		if pod.DeletionTimestamp == nil && lbutil.ComputePodStatus(pod).Ready {
+			ready[pod.Spec.NodeName] = true
+		}
+	}
+	names := make([]string, 0)
+	for _, node := range nodes {
+		if ready[node.Name] {
+			names = append(names, node.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bgp
+
+import (
+	"fmt"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// sessionStates are the states of sessions reported by speakers
+var sessionStates = []lbapi.BGPSessionState{
+	lbapi.BGPSessionIdle,
+	lbapi.BGPSessionConnect,
+	lbapi.BGPSessionActive,
+	lbapi.BGPSessionOpenSent,
+	lbapi.BGPSessionOpenConfirm,
+	lbapi.BGPSessionEstablished,
+}
+
+// peerConditionType returns the type of the pod condition which a speaker reports
+// the session with peer at address by, colons of ipv6 addresses are not allowed
+// in condition types
+func peerConditionType(address string) v1.PodConditionType {
+	return v1.PodConditionType(fmt.Sprintf("%s/bgp-peer-%s", lbapi.GroupName, strings.Replace(address, ":", "-", -1)))
+}
+
+// sessionState converts the reason of a peer condition to the session state
+func sessionState(reason string) lbapi.BGPSessionState {
+	for _, s := range sessionStates {
+		if strings.EqualFold(string(s), reason) {
+			return s
+		}
+	}
+	return lbapi.BGPSessionUnknown
+}
+
+// peerStatuses collects the state of sessions between speakers and peers in config
+// from the conditions of speaker pods, speakers update them when sessions change so
+// the status is resynced by pod events
+func peerStatuses(config speakerConfig, speakers []*v1.Pod) []lbapi.BGPPeerStatus {
+	pods := make(map[string]*v1.Pod)
+	for _, pod := range speakers {
+		if pod.Status.Phase == v1.PodRunning && pod.DeletionTimestamp == nil {
+			pods[pod.Spec.NodeName] = pod
+		}
+	}
+
+	statuses := make([]lbapi.BGPPeerStatus, 0)
+	for _, peer := range config.Peers {
+		for _, node := range peer.Nodes {
+			status := lbapi.BGPPeerStatus{
+				Node:    node,
+				Address: peer.Address,
+				ASN:     peer.ASN,
+				State:   lbapi.BGPSessionUnknown,
+			}
+			pod, ok := pods[node]
+			if !ok {
+				status.Message = "speaker is not running"
+				statuses = append(statuses, status)
+				continue
+			}
+			status.Message = "session is not reported by speaker"
+			for _, c := range pod.Status.Conditions {
+				if c.Type == peerConditionType(peer.Address) {
+					status.State = sessionState(c.Reason)
+					status.Message = c.Message
+					break
+				}
+			}
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bgp
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	bgpOpen         = 1
+	bgpNotification = 3
+	bgpKeepalive    = 4
+)
+
+// writeBGPMessage writes a BGP message with the marker and header
+func writeBGPMessage(w io.Writer, typ byte, body []byte) error {
+	msg := make([]byte, 19, 19+len(body))
+	for i := 0; i < 16; i++ {
+		msg[i] = 0xff
+	}
+	binary.BigEndian.PutUint16(msg[16:], uint16(19+len(body)))
+	msg[18] = typ
+	_, err := w.Write(append(msg, body...))
+	return err
+}
+
+// readBGPMessage reads a BGP message and returns its type and body
+func readBGPMessage(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, 19)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+	body := make([]byte, int(binary.BigEndian.Uint16(header[16:]))-19)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return header[18], body, nil
+}
+
+// openBody is the body of an OPEN message without optional parameters
+func openBody(asn uint32) []byte {
+	body := []byte{4, 0, 0, 0, 90, 10, 0, 0, 1, 0}
+	binary.BigEndian.PutUint16(body[1:], uint16(asn))
+	return body
+}
+
+// standInPeer is a BGP router accepting sessions from the local asn, it answers
+// an OPEN by its OPEN and a KEEPALIVE, and sends a NOTIFICATION for other asns
+type standInPeer struct {
+	listener net.Listener
+	asn      uint32
+	local    uint32
+}
+
+func newStandInPeer(t *testing.T, address string, asn, local uint32) *standInPeer {
+	listener, err := net.Listen("tcp", net.JoinHostPort(address, "0"))
+	if err != nil {
+		// loopback addresses other than 127.0.0.1 are not configured on some systems
+		t.Skipf("listen on %v error: %v", address, err)
+	}
+	p := &standInPeer{listener: listener, asn: asn, local: local}
+	go p.serve()
+	return p
+}
+
+func (p *standInPeer) port() int32 {
+	return int32(p.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (p *standInPeer) serve() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		go func(conn net.Conn) {
+			defer conn.Close()
+			typ, body, err := readBGPMessage(conn)
+			if err != nil || typ != bgpOpen || len(body) < 3 {
+				return
+			}
+			if uint32(binary.BigEndian.Uint16(body[1:])) != p.local {
+				// bad peer AS
+				_ = writeBGPMessage(conn, bgpNotification, []byte{2, 2})
+				return
+			}
+			_ = writeBGPMessage(conn, bgpOpen, openBody(p.asn))
+			_ = writeBGPMessage(conn, bgpKeepalive, nil)
+			_, _, _ = readBGPMessage(conn)
+		}(conn)
+	}
+}
+
+// runStandInSpeaker follows the speaker contract in docs/images.md, it reads the
+// speaker config, peers with the peers of node and reports the sessions by the
+// conditions of its pod
+func runStandInSpeaker(t *testing.T, data, node string, pod *v1.Pod) {
+	config := struct {
+		ASN   uint32 `json:"asn"`
+		Peers []struct {
+			Address   string   `json:"address"`
+			ASN       uint32   `json:"asn"`
+			Port      int32    `json:"port"`
+			Nodes     []string `json:"nodes"`
+			Condition string   `json:"condition"`
+		} `json:"peers"`
+	}{}
+	if err := json.Unmarshal([]byte(data), &config); err != nil {
+		t.Fatal(err)
+	}
+
+	pod.Status.Conditions = nil
+	for _, peer := range config.Peers {
+		found := false
+		for _, n := range peer.Nodes {
+			found = found || n == node
+		}
+		if !found {
+			continue
+		}
+		state, message := lbapi.BGPSessionActive, ""
+		conn, err := net.DialTimeout("tcp", net.JoinHostPort(peer.Address, strconv.Itoa(int(peer.Port))), time.Second)
+		if err != nil {
+			message = err.Error()
+		} else {
+			_ = conn.SetDeadline(time.Now().Add(time.Second))
+			_ = writeBGPMessage(conn, bgpOpen, openBody(config.ASN))
+			state = lbapi.BGPSessionOpenSent
+			for state != lbapi.BGPSessionEstablished && state != lbapi.BGPSessionIdle {
+				typ, body, err := readBGPMessage(conn)
+				switch {
+				case err != nil:
+					state, message = lbapi.BGPSessionIdle, err.Error()
+				case typ == bgpOpen && uint32(binary.BigEndian.Uint16(body[1:])) != peer.ASN:
+					state, message = lbapi.BGPSessionIdle, "unexpected peer asn"
+				case typ == bgpOpen:
+					state = lbapi.BGPSessionOpenConfirm
+					_ = writeBGPMessage(conn, bgpKeepalive, nil)
+				case typ == bgpKeepalive && state == lbapi.BGPSessionOpenConfirm:
+					state = lbapi.BGPSessionEstablished
+				case typ == bgpNotification:
+					state, message = lbapi.BGPSessionIdle, fmt.Sprintf("notification %v", body)
+				}
+			}
+			conn.Close()
+		}
+		status := v1.ConditionFalse
+		if state == lbapi.BGPSessionEstablished {
+			status = v1.ConditionTrue
+		}
+		pod.Status.Conditions = append(pod.Status.Conditions, v1.PodCondition{
+			Type:    v1.PodConditionType(peer.Condition),
+			Status:  status,
+			Reason:  string(state),
+			Message: message,
+		})
+	}
+}
+
+func TestStandInBGPPeer(t *testing.T) {
+	lb := newLoadBalancer()
+	established := newStandInPeer(t, "127.0.0.1", 64513, lb.Spec.Providers.BGP.ASN)
+	defer established.listener.Close()
+	// the peer expects another asn of lb
+	rejecting := newStandInPeer(t, "127.0.0.2", 64514, 64600)
+	defer rejecting.listener.Close()
+	// nothing listens on the port
+	closed := newStandInPeer(t, "127.0.0.3", 64515, lb.Spec.Providers.BGP.ASN)
+	closed.listener.Close()
+
+	lb.Spec.Providers.BGP.Peers = []lbapi.BGPPeer{
+		{Address: "127.0.0.1", ASN: 64513, Port: established.port()},
+		{Address: "127.0.0.2", ASN: 64514, Port: rejecting.port()},
+		{Address: "127.0.0.3", ASN: 64515, Port: closed.port()},
+		{Address: "127.0.0.4", ASN: 64516, NodeSelector: map[string]string{"rack": "b"}},
+	}
+	nodes := []*v1.Node{newNode("n1", "a")}
+	config := newSpeakerConfig(lb, nodes, []*v1.Pod{newPod("proxy", "n1", true)})
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	speaker := newPod("speaker", "n1", true)
+	runStandInSpeaker(t, string(data), "n1", speaker)
+	statuses := peerStatuses(config, []*v1.Pod{speaker})
+	want := []lbapi.BGPSessionState{
+		lbapi.BGPSessionEstablished,
+		lbapi.BGPSessionIdle,
+		lbapi.BGPSessionActive,
+	}
+	if len(statuses) != len(want) {
+		t.Fatalf("got statuses %+v, want %d", statuses, len(want))
+	}
+	for i, s := range statuses {
+		if s.Node != "n1" || s.State != want[i] {
+			t.Errorf("status %d %+v, want state %v on n1", i, s, want[i])
+		}
+	}
+
+	// the session goes down when the peer is gone
+	established.listener.Close()
+	runStandInSpeaker(t, string(data), "n1", speaker)
+	if s := peerStatuses(config, []*v1.Pod{speaker})[0]; s.State != lbapi.BGPSessionActive {
+		t.Errorf("got status %+v after peer is gone, want %v", s, lbapi.BGPSessionActive)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bgp
+
+import (
+	"reflect"
+	"sort"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	log "k8s.io/klog"
+)
+
+func (f *bgp) syncStatus(lb *lbapi.LoadBalancer, ds *appsv1.DaemonSet, cm *v1.ConfigMap, config speakerConfig) error {
+	podList, err := f.podLister.Pods(lb.Namespace).List(f.selector(lb).AsSelector())
+	if err != nil {
+		log.Errorf("get pod list error: %v", err)
+		return err
+	}
+
+	providerStatus := lbapi.BGPProviderStatus{
+		PodStatuses: lbapi.PodStatuses{
+			Replicas: lbutil.DaemonSetReplicas(lb, ds),
+			Statuses: make([]lbapi.PodStatus, 0),
+		},
+		DaemonSet:       ds.Name,
+		ConfigMap:       cm.Name,
+		VIPs:            config.VIPs,
+		AnnouncingNodes: config.AnnouncingNodes,
+		Peers:           peerStatuses(config, podList),
+	}
+	for _, pod := range podList {
+		status := lbutil.ComputePodStatus(pod)
+		providerStatus.TotalReplicas++
+		if status.Ready {
+			providerStatus.ReadyReplicas++
+		}
+		providerStatus.Statuses = append(providerStatus.Statuses, status)
+	}
+	sort.Sort(lbutil.SortPodStatusByName(providerStatus.Statuses))
+
+	previous := lb.Status.ProvidersStatuses.BGP
+	f.recordEvents(lb, previous, &providerStatus)
+
+	if previous != nil && lbutil.BGPProviderStatusEqual(*previous, providerStatus) {
+		return nil
+	}
+	_, err = lbutil.UpdateLBWithRetries(
+		f.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+		f.lbLister,
+		lb.Namespace,
+		lb.Name,
+		func(lb *lbapi.LoadBalancer) error {
+			lb.Status.ProvidersStatuses.BGP = &providerStatus
+			return nil
+		},
+	)
+	if err != nil {
+		log.Errorf("Update loadbalancer status error, %v", err)
+		return err
+	}
+	return nil
+}
+
+// recordEvents records events when the announcing nodes or the state of sessions change
+func (f *bgp) recordEvents(lb *lbapi.LoadBalancer, previous, current *lbapi.BGPProviderStatus) {
+	if previous == nil || !reflect.DeepEqual(previous.AnnouncingNodes, current.AnnouncingNodes) {
+		if len(current.AnnouncingNodes) == 0 {
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "NoAnnouncingNodes", "No node has a ready proxy pod, vips are withdrawn")
+		} else {
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeNormal, "AnnouncingNodesChanged", "Vips are announced from nodes %v", current.AnnouncingNodes)
+		}
+	}
+
+	if previous == nil {
+		return
+	}
+	established := make(map[string]bool)
+	for _, peer := range previous.Peers {
+		if peer.State == lbapi.BGPSessionEstablished {
+			established[peer.Node+"/"+peer.Address] = true
+		}
+	}
+	for _, peer := range current.Peers {
+		if established[peer.Node+"/"+peer.Address] && peer.State != lbapi.BGPSessionEstablished {
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "BGPSessionDown",
+				"Session between node %v and peer %v is %v %v", peer.Node, peer.Address, peer.State, peer.Message)
+		}
+	}
+}
+
+func (f *bgp) deleteStatus(lb *lbapi.LoadBalancer) error {
+	if lb.Status.ProvidersStatuses.BGP == nil {
+		return nil
+	}
+
+	log.Infof("delete bgp status for %v/%v", lb.Namespace, lb.Name)
+	_, err := lbutil.UpdateLBWithRetries(
+		f.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+		f.lbLister,
+		lb.Namespace,
+		lb.Name,
+		func(lb *lbapi.LoadBalancer) error {
+			lb.Status.ProvidersStatuses.BGP = nil
+			return nil
+		},
+	)
+
+	if err != nil {
+		log.Errorf("Update loadbalancer status error: %v", err)
+		return err
+	}
+	return nil
+}
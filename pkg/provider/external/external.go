@@ -134,6 +134,11 @@ func (f *external) syncLoadBalancer(obj interface{}) error {
 		return nil
 	}
 	provider := lb.Spec.Providers.External
+	if provider.VIP == "" && len(provider.VIPs) == 0 {
+		// the vip is not allocated from pool yet
+		log.Infof("Loadbalancer %v is waiting for vip from pool", key)
+		return nil
+	}
 
 	// vip and vips conversion for compatibility
 	vip := provider.VIP
@@ -147,8 +152,9 @@ func (f *external) syncLoadBalancer(obj interface{}) error {
 	}
 	// sync status
 	providerStatus := lbapi.ExpternalProviderStatus{
-		VIP:  vip,
-		VIPs: vips,
+		VIP:      vip,
+		VIPs:     vips,
+		Families: lbapi.VIPFamilies(vips),
 	}
 	externalstatus := lb.Status.ProvidersStatuses.External
 	// check whether the statuses are equal
@@ -0,0 +1,144 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f5
+
+import "net/http"
+
+type pool struct {
+	Name              string `json:"name"`
+	Partition         string `json:"partition"`
+	FullPath          string `json:"fullPath,omitempty"`
+	Description       string `json:"description,omitempty"`
+	LoadBalancingMode string `json:"loadBalancingMode,omitempty"`
+	Monitor           string `json:"monitor,omitempty"`
+}
+
+type poolMember struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+	Address   string `json:"address"`
+}
+
+type profile struct {
+	Name string `json:"name"`
+}
+
+type sourceAddressTranslation struct {
+	Type string `json:"type"`
+}
+
+type virtualServer struct {
+	Name                     string                   `json:"name"`
+	Partition                string                   `json:"partition"`
+	FullPath                 string                   `json:"fullPath,omitempty"`
+	Description              string                   `json:"description,omitempty"`
+	Destination              string                   `json:"destination"`
+	IPProtocol               string                   `json:"ipProtocol"`
+	Pool                     string                   `json:"pool"`
+	SourceAddressTranslation sourceAddressTranslation `json:"sourceAddressTranslation"`
+	Profiles                 []profile                `json:"profiles,omitempty"`
+}
+
+// objectPath returns the path of object in partition used in urls, such as ~Common~name
+func objectPath(partition, name string) string {
+	return "~" + partition + "~" + name
+}
+
+// fullPath returns the full path of object in partition, such as /Common/name
+func fullPath(partition, name string) string {
+	return "/" + partition + "/" + name
+}
+
+func partitionFilter(partition string) string {
+	return "?$filter=partition+eq+" + partition
+}
+
+// pools returns the pools in partition
+func (c *client) pools(partition string) ([]pool, error) {
+	resp := struct {
+		Items []pool `json:"items"`
+	}{}
+	err := c.request(http.MethodGet, "/mgmt/tm/ltm/pool"+partitionFilter(partition), nil, &resp)
+	return resp.Items, err
+}
+
+// createPool creates a pool with members
+func (c *client) createPool(p pool, members []poolMember) error {
+	in := struct {
+		pool
+		Members []poolMember `json:"members"`
+	}{p, members}
+	return c.request(http.MethodPost, "/mgmt/tm/ltm/pool", in, nil)
+}
+
+// deletePool deletes a pool, nodes of its members are left
+func (c *client) deletePool(partition, name string) error {
+	err := c.request(http.MethodDelete, "/mgmt/tm/ltm/pool/"+objectPath(partition, name), nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// poolMembers returns the members of pool
+func (c *client) poolMembers(partition, name string) ([]poolMember, error) {
+	resp := struct {
+		Items []poolMember `json:"items"`
+	}{}
+	err := c.request(http.MethodGet, "/mgmt/tm/ltm/pool/"+objectPath(partition, name)+"/members", nil, &resp)
+	return resp.Items, err
+}
+
+// setPoolMembers replaces the members of pool
+func (c *client) setPoolMembers(partition, name string, members []poolMember) error {
+	in := map[string][]poolMember{"members": members}
+	return c.request(http.MethodPatch, "/mgmt/tm/ltm/pool/"+objectPath(partition, name), in, nil)
+}
+
+// virtualServers returns the virtual servers in partition
+func (c *client) virtualServers(partition string) ([]virtualServer, error) {
+	resp := struct {
+		Items []virtualServer `json:"items"`
+	}{}
+	err := c.request(http.MethodGet, "/mgmt/tm/ltm/virtual"+partitionFilter(partition), nil, &resp)
+	return resp.Items, err
+}
+
+// createVirtualServer creates a virtual server
+func (c *client) createVirtualServer(vs virtualServer) error {
+	return c.request(http.MethodPost, "/mgmt/tm/ltm/virtual", vs, nil)
+}
+
+// updateVirtualServer updates the destination, pool and snat of virtual server
+func (c *client) updateVirtualServer(vs virtualServer) error {
+	in := map[string]interface{}{
+		"description":              vs.Description,
+		"destination":              vs.Destination,
+		"pool":                     vs.Pool,
+		"sourceAddressTranslation": vs.SourceAddressTranslation,
+	}
+	return c.request(http.MethodPatch, "/mgmt/tm/ltm/virtual/"+objectPath(vs.Partition, vs.Name), in, nil)
+}
+
+// deleteVirtualServer deletes a virtual server
+func (c *client) deleteVirtualServer(partition, name string) error {
+	err := c.request(http.MethodDelete, "/mgmt/tm/ltm/virtual/"+objectPath(partition, name), nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
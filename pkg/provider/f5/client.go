@@ -0,0 +1,148 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f5
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+)
+
+// apiError is the error responded by iControl REST
+type apiError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%v %v responded %v: %v", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// isNotFound returns true if err means the object does not exist
+func isNotFound(err error) bool {
+	e, ok := err.(*apiError)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+// credentials are the user of BIG-IP and the CA of its certificate
+type credentials struct {
+	Username string
+	Password string
+	CA       []byte
+}
+
+// credentialsFromSecret reads credentials from the data of Secret
+func credentialsFromSecret(data map[string][]byte) (credentials, error) {
+	creds := credentials{
+		Username: string(data[lbapi.F5UsernameKey]),
+		Password: string(data[lbapi.F5PasswordKey]),
+		CA:       data[lbapi.F5CAKey],
+	}
+	if creds.Username == "" || creds.Password == "" {
+		return creds, fmt.Errorf("%v and %v are required", lbapi.F5UsernameKey, lbapi.F5PasswordKey)
+	}
+	return creds, nil
+}
+
+// client calls iControl REST of a BIG-IP with basic auth
+type client struct {
+	client *http.Client
+	// endpoint is the scheme and host of BIG-IP
+	endpoint string
+	username string
+	password string
+}
+
+// newClient creates a client of BIG-IP at host, https is used if host has no scheme
+func newClient(host string, creds credentials, insecureSkipVerify bool) (*client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: insecureSkipVerify}
+	if len(creds.CA) > 0 {
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(creds.CA) {
+			return nil, fmt.Errorf("no valid certificate in %v", lbapi.F5CAKey)
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	endpoint := strings.TrimSuffix(host, "/")
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	return &client{
+		client:   &http.Client{Timeout: requestTimeout, Transport: transport},
+		endpoint: endpoint,
+		username: creds.Username,
+		password: creds.Password,
+	}, nil
+}
+
+// request sends in as json to path of iControl REST and decodes the response into out
+func (c *client) request(method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequest(method, c.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := ioutil.ReadAll(resp.Body)
+		e := &apiError{StatusCode: resp.StatusCode, Method: method, Path: path, Message: strings.TrimSpace(string(data))}
+		// iControl REST responds errors like {"code":404,"message":"..."}
+		detail := struct {
+			Message string `json:"message"`
+		}{}
+		if json.Unmarshal(data, &detail) == nil && detail.Message != "" {
+			e.Message = detail.Message
+		}
+		return e
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f5
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog"
+)
+
+const (
+	providerName = "f5"
+)
+
+type f5 struct {
+	// NodeProvider syncs the virtual servers with the nodes of lb, and resyncs them
+	// to correct the drift made on BIG-IP by hand
+	*lbutil.NodeProvider
+	initialized bool
+
+	client   kubernetes.Interface
+	lbLister lblisters.LoadBalancerLister
+}
+
+// New creates a new f5 provider plugin
+func New() plugin.Interface {
+	return &f5{}
+}
+
+func (f *f5) Init(cfg config.Configuration, sif informers.SharedInformerFactory) {
+	if f.initialized {
+		return
+	}
+	f.initialized = true
+
+	log.Info("Initialize the f5 provider")
+
+	// set config
+	f.client = cfg.Client
+	f.lbLister = sif.Custom().Loadbalance().V1alpha2().LoadBalancers().Lister()
+	f.NodeProvider = lbutil.NewNodeProvider(providerName, sif, cfg.Providers.F5.ResyncPeriod, f)
+}
+
+// Enabled checks whether lb uses f5 provider
+func (f *f5) Enabled(lb *lbapi.LoadBalancer) bool {
+	return lb.Spec.Providers.F5 != nil
+}
+
+// Pending is always true, virtual servers may be changed on BIG-IP by hand and
+// nothing in cluster changes then
+func (f *f5) Pending(lb *lbapi.LoadBalancer) bool {
+	return true
+}
+
+// Release deletes the status of lb, virtual servers are left behind
+func (f *f5) Release(lb *lbapi.LoadBalancer) error {
+	if status := lb.Status.ProvidersStatuses.F5; status != nil && len(status.VirtualServers) > 0 {
+		// the host and credentials are gone with the spec
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "VirtualServersOrphaned", "Virtual servers %v are not deleted, f5 provider is removed from spec", strings.Join(status.VirtualServers, ", "))
+	}
+	return f.syncStatus(lb, nil)
+}
+
+// Sync syncs the virtual servers and pools of lb with the nodes of lb
+func (f *f5) Sync(lb *lbapi.LoadBalancer, nodes []*v1.Node) error {
+	state, syncErr := f.syncBIGIP(lb, nodes)
+	if err := f.syncStatus(lb, computeStatus(lb.Status.ProvidersStatuses.F5, state, syncErr)); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+func (f *f5) syncBIGIP(lb *lbapi.LoadBalancer, nodes []*v1.Node) (*f5State, error) {
+	c, err := f.newClient(lb)
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "InvalidCredentials", "%v", err)
+		return nil, err
+	}
+	state, err := syncVirtualServers(c, lb, lbutil.NodeInternalIPs(nodes))
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "VirtualServersSyncFailed", "Sync virtual servers error: %v", err)
+		return nil, err
+	}
+	return state, nil
+}
+
+// syncStatus updates the f5 status of lb, status is nil to delete it
+func (f *f5) syncStatus(lb *lbapi.LoadBalancer, status *lbapi.F5ProviderStatus) error {
+	return lbutil.SyncProviderStatus(f.client, f.lbLister, lb, providerName, lb.Status.ProvidersStatuses.F5, status, func(lb *lbapi.LoadBalancer) {
+		lb.Status.ProvidersStatuses.F5 = status
+	})
+}
+
+// newClient creates a client of BIG-IP with the credentials Secret of lb
+func (f *f5) newClient(lb *lbapi.LoadBalancer) (*client, error) {
+	spec := lb.Spec.Providers.F5
+	secret, err := f.client.Native().CoreV1().Secrets(lb.Namespace).Get(spec.CredentialsSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get credentials secret %v error: %v", spec.CredentialsSecret, err)
+	}
+	creds, err := credentialsFromSecret(secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("credentials secret %v is invalid: %v", spec.CredentialsSecret, err)
+	}
+	if spec.InsecureSkipVerify {
+		log.Warningf("TLS verification of BIG-IP %v is disabled by insecureSkipVerify of lb %v/%v", spec.Host, lb.Namespace, lb.Name)
+	}
+	return newClient(spec.Host, creds, spec.InsecureSkipVerify)
+}
+
+// Cleanup deletes the virtual servers and pools of a deleted lb
+func (f *f5) Cleanup(lb *lbapi.LoadBalancer) error {
+	c, err := f.newClient(lb)
+	if err != nil {
+		// retried by the queue, virtual servers are left behind if credentials never come back
+		if status := lb.Status.ProvidersStatuses.F5; status != nil && len(status.VirtualServers) > 0 {
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "VirtualServersOrphaned", "Virtual servers %v are not deleted: %v", strings.Join(status.VirtualServers, ", "), err)
+		}
+		log.Warningf("Delete virtual servers of lb %v/%v error: %v", lb.Namespace, lb.Name, err)
+		return err
+	}
+	return deleteVirtualServers(c, lb)
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f5
+
+import (
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/util/lb/lbtest"
+)
+
+const (
+	testUsername = "admin"
+	testPassword = "password"
+)
+
+// mockBIGIP is a stand-in of iControl REST keeping pools and virtual servers in memory
+type mockBIGIP struct {
+	lbtest.Mock
+	pools    map[string]*pool
+	members  map[string][]poolMember
+	virtuals map[string]*virtualServer
+}
+
+func newMockBIGIP() *mockBIGIP {
+	return &mockBIGIP{
+		pools:    make(map[string]*pool),
+		members:  make(map[string][]poolMember),
+		virtuals: make(map[string]*virtualServer),
+	}
+}
+
+func (m *mockBIGIP) fail(w http.ResponseWriter, code int, format string, args ...interface{}) {
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"code": code, "message": fmt.Sprintf(format, args...)})
+}
+
+func (m *mockBIGIP) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.Lock()
+	defer m.Unlock()
+
+	if username, password, ok := r.BasicAuth(); !ok || username != testUsername || password != testPassword {
+		m.fail(w, http.StatusUnauthorized, "Authorization failed: no user authentication header or token detected")
+		return
+	}
+	if r.Method != http.MethodGet {
+		m.Record(r.Method + " " + r.URL.Path)
+	}
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, "/mgmt/tm/ltm/"), "/")
+	partition := strings.TrimPrefix(r.URL.Query().Get("$filter"), "partition eq ")
+	name := ""
+	if len(path) > 1 {
+		name = strings.Replace(path[1], "~", "/", -1)
+	}
+
+	switch {
+	case path[0] == "pool" && r.Method == http.MethodGet && len(path) == 1:
+		items := []pool{}
+		for _, p := range m.pools {
+			if p.Partition == partition {
+				items = append(items, *p)
+			}
+		}
+		respond(w, items)
+	case path[0] == "pool" && r.Method == http.MethodGet:
+		if m.pools[name] == nil {
+			m.fail(w, http.StatusNotFound, "Object not found - %v", name)
+			return
+		}
+		respond(w, m.members[name])
+	case path[0] == "pool" && r.Method == http.MethodPost:
+		in := struct {
+			pool
+			Members []poolMember `json:"members"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		in.FullPath = fullPath(in.Partition, in.Name)
+		if m.pools[in.FullPath] != nil {
+			m.fail(w, http.StatusConflict, "The requested Pool (%v) already exists", in.FullPath)
+			return
+		}
+		m.pools[in.FullPath] = &in.pool
+		m.members[in.FullPath] = in.Members
+	case path[0] == "pool" && r.Method == http.MethodPatch:
+		in := struct {
+			Members []poolMember `json:"members"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		if m.pools[name] == nil {
+			m.fail(w, http.StatusNotFound, "Object not found - %v", name)
+			return
+		}
+		m.members[name] = in.Members
+	case path[0] == "pool":
+		if m.pools[name] == nil {
+			m.fail(w, http.StatusNotFound, "Object not found - %v", name)
+			return
+		}
+		for _, vs := range m.virtuals {
+			if vs.Pool == name {
+				m.fail(w, http.StatusBadRequest, "The Pool (%v) cannot be deleted because it is in use by a Virtual Server (%v)", name, vs.FullPath)
+				return
+			}
+		}
+		delete(m.pools, name)
+		delete(m.members, name)
+	case r.Method == http.MethodGet:
+		items := []virtualServer{}
+		for _, vs := range m.virtuals {
+			if vs.Partition == partition {
+				items = append(items, *vs)
+			}
+		}
+		respond(w, items)
+	case r.Method == http.MethodPost:
+		vs := &virtualServer{}
+		_ = json.NewDecoder(r.Body).Decode(vs)
+		vs.FullPath = fullPath(vs.Partition, vs.Name)
+		if m.virtuals[vs.FullPath] != nil {
+			m.fail(w, http.StatusConflict, "The requested Virtual Server (%v) already exists", vs.FullPath)
+			return
+		}
+		if m.pools[vs.Pool] == nil {
+			m.fail(w, http.StatusBadRequest, "The requested Pool (%v) was not found", vs.Pool)
+			return
+		}
+		vs.Profiles = nil
+		m.virtuals[vs.FullPath] = vs
+	case r.Method == http.MethodPatch:
+		vs := m.virtuals[name]
+		if vs == nil {
+			m.fail(w, http.StatusNotFound, "Object not found - %v", name)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(vs)
+	default:
+		if m.virtuals[name] == nil {
+			m.fail(w, http.StatusNotFound, "Object not found - %v", name)
+			return
+		}
+		delete(m.virtuals, name)
+	}
+}
+
+// respond writes items in a collection of iControl REST
+func respond(w http.ResponseWriter, items interface{}) {
+	lbtest.RespondJSON(w, map[string]interface{}{"items": items})
+}
+
+// newTestClient returns a client of BIG-IP served by server, which trusts the certificate of server
+func newTestClient(t *testing.T, server *httptest.Server) *client {
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	creds, err := credentialsFromSecret(map[string][]byte{
+		lbapi.F5UsernameKey: []byte(testUsername),
+		lbapi.F5PasswordKey: []byte(testPassword),
+		lbapi.F5CAKey:       ca,
+	})
+	if err != nil {
+		t.Fatalf("credentials error: %v", err)
+	}
+	c, err := newClient(strings.TrimPrefix(server.URL, "https://"), creds, false)
+	if err != nil {
+		t.Fatalf("new client error: %v", err)
+	}
+	return c
+}
+
+func newLoadBalancer() *lbapi.LoadBalancer {
+	lb := lbtest.NewLoadBalancer()
+	lb.Spec.Providers.F5 = &lbapi.F5Provider{
+		Host:              "bigip",
+		Partition:         "k8s",
+		CredentialsSecret: "f5",
+		VIPAddress:        "10.10.0.100",
+	}
+	return lb
+}
+
+func TestJoinAddressPort(t *testing.T) {
+	if got := joinAddressPort("10.0.0.1", 80); got != "10.0.0.1:80" {
+		t.Errorf("got %v, want 10.0.0.1:80", got)
+	}
+	if got := joinAddressPort("2001:db8::1", 80); got != "2001:db8::1.80" {
+		t.Errorf("got %v, want 2001:db8::1.80", got)
+	}
+}
+
+func TestSyncVirtualServers(t *testing.T) {
+	mock := newMockBIGIP()
+	server := httptest.NewTLSServer(mock)
+	defer server.Close()
+	c := newTestClient(t, server)
+
+	// objects not created by controller are never touched
+	foreign := pool{Name: "default-lb-tcp-8080", Partition: "k8s", FullPath: "/k8s/default-lb-tcp-8080"}
+	mock.pools[foreign.FullPath] = &foreign
+
+	lb := newLoadBalancer()
+	state, err := syncVirtualServers(c, lb, []string{"10.0.0.1", "10.0.0.2"})
+	if err != nil {
+		t.Fatalf("sync virtual servers error: %v", err)
+	}
+	status := computeStatus(nil, state, nil)
+	want := []string{"/k8s/default-lb-tcp-20053", "/k8s/default-lb-tcp-20080", "/k8s/default-lb-tcp-443", "/k8s/default-lb-tcp-80", "/k8s/default-lb-udp-20053"}
+	if status.Phase != lbapi.F5RunningPhase || !reflect.DeepEqual(status.VirtualServers, want) {
+		t.Errorf("got status %v %v, want Running with virtual servers %v", status.Phase, status.VirtualServers, want)
+	}
+	for _, vs := range mock.virtuals {
+		port := vs.Name[strings.LastIndex(vs.Name, "-")+1:]
+		if vs.Destination != "/k8s/10.10.0.100:"+port || vs.Pool != vs.FullPath || vs.SourceAddressTranslation.Type != "automap" {
+			t.Errorf("got virtual server %+v", vs)
+		}
+		members := []string{}
+		for _, m := range mock.members[vs.Pool] {
+			members = append(members, m.Name)
+		}
+		sort.Strings(members)
+		if want := []string{"10.0.0.1:" + port, "10.0.0.2:" + port}; !reflect.DeepEqual(members, want) {
+			t.Errorf("got members %v of %v, want %v", members, vs.Pool, want)
+		}
+	}
+	if vs := mock.virtuals["/k8s/default-lb-udp-20053"]; vs.IPProtocol != "udp" {
+		t.Errorf("got protocol %v of udp virtual server", vs.IPProtocol)
+	}
+
+	// nothing changes if nothing is changed
+	mock.TakeActions()
+	if _, err := syncVirtualServers(c, lb, []string{"10.0.0.2", "10.0.0.1"}); err != nil {
+		t.Fatalf("sync virtual servers again error: %v", err)
+	}
+	if actions := mock.TakeActions(); len(actions) != 0 {
+		t.Errorf("unexpected actions %v", actions)
+	}
+
+	// changes made on BIG-IP are reverted
+	mock.virtuals["/k8s/default-lb-tcp-80"].Destination = "/k8s/10.10.0.200:80"
+	mock.members["/k8s/default-lb-tcp-443"] = nil
+	delete(mock.virtuals, "/k8s/default-lb-tcp-20080")
+	delete(mock.pools, "/k8s/default-lb-tcp-20080")
+	if _, err := syncVirtualServers(c, lb, []string{"10.0.0.1", "10.0.0.2"}); err != nil {
+		t.Fatalf("sync virtual servers error: %v", err)
+	}
+	wantActions := []string{
+		"PATCH /mgmt/tm/ltm/pool/~k8s~default-lb-tcp-443",
+		"PATCH /mgmt/tm/ltm/virtual/~k8s~default-lb-tcp-80",
+		"POST /mgmt/tm/ltm/pool",
+		"POST /mgmt/tm/ltm/virtual",
+	}
+	actions := mock.TakeActions()
+	sort.Strings(actions)
+	if !reflect.DeepEqual(actions, wantActions) {
+		t.Errorf("got actions %v, want %v", actions, wantActions)
+	}
+	if vs := mock.virtuals["/k8s/default-lb-tcp-80"]; vs.Destination != "/k8s/10.10.0.100:80" {
+		t.Errorf("got destination %v, want /k8s/10.10.0.100:80", vs.Destination)
+	}
+
+	// stream and node are removed
+	lb.Spec.Proxy.Streams = lb.Spec.Proxy.Streams[:2]
+	if _, err := syncVirtualServers(c, lb, []string{"10.0.0.2"}); err != nil {
+		t.Fatalf("sync virtual servers error: %v", err)
+	}
+	if len(mock.virtuals) != 4 || len(mock.pools) != 5 {
+		t.Errorf("got %v virtual servers and %v pools, want 4 and 5", len(mock.virtuals), len(mock.pools))
+	}
+	for _, vs := range mock.virtuals {
+		if members := mock.members[vs.Pool]; len(members) != 1 || members[0].Address != "10.0.0.2" {
+			t.Errorf("got members %v of %v, want only 10.0.0.2", members, vs.Pool)
+		}
+	}
+
+	if err := deleteVirtualServers(c, lb); err != nil {
+		t.Fatalf("delete virtual servers error: %v", err)
+	}
+	if len(mock.virtuals) != 0 || len(mock.pools) != 1 || mock.pools[foreign.FullPath] == nil {
+		t.Errorf("got %v virtual servers and pools %v left, want only the foreign pool", len(mock.virtuals), mock.pools)
+	}
+}
+
+func TestClientError(t *testing.T) {
+	server := httptest.NewTLSServer(newMockBIGIP())
+	defer server.Close()
+
+	c := newTestClient(t, server)
+	c.password = "wrong"
+	_, err := c.pools("Common")
+	if e, ok := err.(*apiError); !ok || e.StatusCode != http.StatusUnauthorized || !strings.HasPrefix(e.Message, "Authorization failed") {
+		t.Errorf("got error %v, want unauthorized", err)
+	}
+
+	// certificate of BIG-IP is not trusted without CA
+	c, _ = newClient(server.URL, credentials{Username: testUsername, Password: testPassword}, false)
+	if _, err := c.pools("Common"); err == nil {
+		t.Errorf("got no error with untrusted certificate")
+	}
+	c, _ = newClient(server.URL, credentials{Username: testUsername, Password: testPassword}, true)
+	if _, err := c.pools("Common"); err != nil {
+		t.Errorf("got error %v with insecure skip verify", err)
+	}
+
+	status := computeStatus(&lbapi.F5ProviderStatus{VirtualServers: []string{"/Common/vs"}}, nil, fmt.Errorf("timeout"))
+	if status.Phase != lbapi.F5ErrorPhase || status.Message != "timeout" || len(status.VirtualServers) != 1 {
+		t.Errorf("got status %+v, want Error keeping virtual servers", status)
+	}
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package f5
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	log "k8s.io/klog"
+)
+
+const (
+	defaultPartition = "Common"
+)
+
+// listenerSpec is a virtual server of lb, the port of members is the same as the listener port
+type listenerSpec struct {
+	Protocol string
+	Port     int
+}
+
+func (l listenerSpec) String() string {
+	return fmt.Sprintf("%s/%d", l.Protocol, l.Port)
+}
+
+// f5State is the state of virtual servers after sync
+type f5State struct {
+	virtualServers []string
+	members        []string
+}
+
+func partition(lb *lbapi.LoadBalancer) string {
+	if lb.Spec.Providers.F5.Partition != "" {
+		return lb.Spec.Providers.F5.Partition
+	}
+	return defaultPartition
+}
+
+// objectName returns the name of virtual server and pool of listener
+func objectName(lb *lbapi.LoadBalancer, l listenerSpec) string {
+	prefix := lb.Spec.Providers.F5.Name
+	if prefix == "" {
+		prefix = lb.Namespace + "-" + lb.Name
+	}
+	return fmt.Sprintf("%s-%s-%d", prefix, l.Protocol, l.Port)
+}
+
+// ownerDescription is the description of virtual servers and pools of lb, objects
+// without it in partition are never touched
+func ownerDescription(lb *lbapi.LoadBalancer) string {
+	return fmt.Sprintf("managed by loadbalancer-controller for %v/%v", lb.Namespace, lb.Name)
+}
+
+// joinAddressPort joins address and port like BIG-IP, which separates ipv6 address
+// and port by a dot
+func joinAddressPort(address string, port int) string {
+	if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+		return address + "." + strconv.Itoa(port)
+	}
+	return address + ":" + strconv.Itoa(port)
+}
+
+// desiredListeners returns the listeners of http, https port and streams of lb's proxy
+func desiredListeners(lb *lbapi.LoadBalancer) []listenerSpec {
+	listeners := make([]listenerSpec, 0)
+	for _, l := range lbutil.ProxyListeners(lb) {
+		// BIG-IP names protocols in lower case
+		listeners = append(listeners, listenerSpec{Protocol: strings.ToLower(string(l.Protocol)), Port: l.Port})
+	}
+	return listeners
+}
+
+// syncVirtualServers makes the virtual servers and pools of lb in partition the same as
+// desired, changes made to them out of controller are reverted
+func syncVirtualServers(c *client, lb *lbapi.LoadBalancer, addresses []string) (*f5State, error) {
+	part := partition(lb)
+	description := ownerDescription(lb)
+	vip := lb.Spec.Providers.F5.VIPAddress
+	desired := desiredListeners(lb)
+
+	pools, err := c.pools(part)
+	if err != nil {
+		return nil, err
+	}
+	existingPools := sets.NewString()
+	for _, p := range pools {
+		if p.Description == description {
+			existingPools.Insert(p.Name)
+		}
+	}
+	vss, err := c.virtualServers(part)
+	if err != nil {
+		return nil, err
+	}
+	existingVSs := make(map[string]virtualServer)
+	for _, vs := range vss {
+		if vs.Description == description {
+			existingVSs[vs.Name] = vs
+		}
+	}
+
+	state := &f5State{members: addresses}
+	wanted := sets.NewString()
+	for _, l := range desired {
+		name := objectName(lb, l)
+		wanted.Insert(name)
+
+		members := make([]poolMember, 0, len(addresses))
+		for _, address := range addresses {
+			members = append(members, poolMember{Name: joinAddressPort(address, l.Port), Partition: part, Address: address})
+		}
+		if existingPools.Has(name) {
+			if err := syncPoolMembers(c, part, name, members); err != nil {
+				return nil, err
+			}
+		} else {
+			p := pool{Name: name, Partition: part, Description: description, LoadBalancingMode: "round-robin"}
+			if l.Protocol == "tcp" {
+				p.Monitor = "/Common/tcp"
+			}
+			log.Infof("Create pool %v in partition %v", name, part)
+			if err := c.createPool(p, members); err != nil {
+				return nil, err
+			}
+		}
+
+		vs := virtualServer{
+			Name:                     name,
+			Partition:                part,
+			Description:              description,
+			Destination:              fullPath(part, joinAddressPort(vip, l.Port)),
+			IPProtocol:               l.Protocol,
+			Pool:                     fullPath(part, name),
+			SourceAddressTranslation: sourceAddressTranslation{Type: "automap"},
+		}
+		if err := syncVirtualServer(c, vs, existingVSs); err != nil {
+			return nil, err
+		}
+		state.virtualServers = append(state.virtualServers, fullPath(part, name))
+	}
+
+	// virtual servers refer to pools, so they are deleted first
+	for name := range existingVSs {
+		if !wanted.Has(name) {
+			log.Infof("Delete virtual server %v in partition %v", name, part)
+			if err := c.deleteVirtualServer(part, name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, name := range existingPools.Difference(wanted).List() {
+		log.Infof("Delete pool %v in partition %v", name, part)
+		if err := c.deletePool(part, name); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(state.virtualServers)
+	return state, nil
+}
+
+// syncPoolMembers replaces the members of pool if they are not the desired ones
+func syncPoolMembers(c *client, partition, name string, members []poolMember) error {
+	existing, err := c.poolMembers(partition, name)
+	if err != nil {
+		return err
+	}
+	have := sets.NewString()
+	for _, m := range existing {
+		have.Insert(m.Name)
+	}
+	want := sets.NewString()
+	for _, m := range members {
+		want.Insert(m.Name)
+	}
+	if have.Equal(want) {
+		return nil
+	}
+	log.Infof("Update members of pool %v in partition %v from %v to %v", name, partition, have.List(), want.List())
+	return c.setPoolMembers(partition, name, members)
+}
+
+// syncVirtualServer creates vs or reverts the changes of it, the virtual server is
+// recreated if its protocol is changed since profiles depend on it
+func syncVirtualServer(c *client, vs virtualServer, existing map[string]virtualServer) error {
+	old, ok := existing[vs.Name]
+	if ok && old.IPProtocol != vs.IPProtocol {
+		log.Infof("Recreate virtual server %v in partition %v with protocol %v", vs.Name, vs.Partition, vs.IPProtocol)
+		if err := c.deleteVirtualServer(vs.Partition, vs.Name); err != nil {
+			return err
+		}
+		ok = false
+	}
+	if !ok {
+		vs.Profiles = []profile{{Name: "/Common/" + vs.IPProtocol}}
+		log.Infof("Create virtual server %v in partition %v", vs.Name, vs.Partition)
+		return c.createVirtualServer(vs)
+	}
+	if old.Destination == vs.Destination && old.Pool == vs.Pool && old.SourceAddressTranslation == vs.SourceAddressTranslation {
+		return nil
+	}
+	log.Infof("Update virtual server %v in partition %v", vs.Name, vs.Partition)
+	return c.updateVirtualServer(vs)
+}
+
+// deleteVirtualServers deletes the virtual servers and pools of lb
+func deleteVirtualServers(c *client, lb *lbapi.LoadBalancer) error {
+	part := partition(lb)
+	description := ownerDescription(lb)
+	vss, err := c.virtualServers(part)
+	if err != nil {
+		return err
+	}
+	for _, vs := range vss {
+		if vs.Description != description {
+			continue
+		}
+		log.Infof("Delete virtual server %v in partition %v", vs.Name, part)
+		if err := c.deleteVirtualServer(part, vs.Name); err != nil {
+			return err
+		}
+	}
+	pools, err := c.pools(part)
+	if err != nil {
+		return err
+	}
+	for _, p := range pools {
+		if p.Description != description {
+			continue
+		}
+		log.Infof("Delete pool %v in partition %v", p.Name, part)
+		if err := c.deletePool(part, p.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// computeStatus computes the status of virtual servers, the virtual servers in old
+// status are kept if state is nil, which means they can't be got
+func computeStatus(old *lbapi.F5ProviderStatus, state *f5State, syncErr error) *lbapi.F5ProviderStatus {
+	status := &lbapi.F5ProviderStatus{}
+	if state != nil {
+		status.VirtualServers = state.virtualServers
+		status.Members = state.members
+	} else if old != nil {
+		status = old.DeepCopy()
+		status.Message = ""
+	}
+	if len(status.VirtualServers) == 0 {
+		status.VirtualServers = nil
+	}
+	if len(status.Members) == 0 {
+		status.Members = nil
+	}
+
+	if syncErr != nil {
+		status.Phase = lbapi.F5ErrorPhase
+		status.Message = syncErr.Error()
+	} else {
+		status.Phase = lbapi.F5RunningPhase
+	}
+	return status
+}
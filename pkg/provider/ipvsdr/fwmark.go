@@ -0,0 +1,181 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipvsdr
+
+import (
+	"fmt"
+	"sync"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	minFwmark = 1
+	// the firewall mark of a vip is fwmark<<16|(index+1), it must fit in 32 bits
+	maxFwmark = 0xffff
+)
+
+// fwmarkOwner is a keepalived group of a LoadBalancer using a fwmark
+type fwmarkOwner struct {
+	// key identifies the group the same as vridOwner
+	key string
+	// lb is the key of LoadBalancer
+	lb     string
+	fwmark int
+	// the older LoadBalancer keeps the fwmark
+	created int64
+}
+
+// wins checks whether a keeps the fwmark when b uses it too
+func (a fwmarkOwner) wins(b fwmarkOwner) bool {
+	if a.created != b.created {
+		return a.created < b.created
+	}
+	return a.key < b.key
+}
+
+// fwmarkAllocator allocates the fwmarks of keepalived groups of ipvsdr LoadBalancers.
+// Unlike vrids, fwmarks are unique in cluster, because groups in different network
+// segments may run on the same node and share its iptables and IPVS tables.
+type fwmarkAllocator struct {
+	mu sync.Mutex
+	// assigned are the fwmarks allocated recently, they may not be observed
+	// in the status of LoadBalancers in lister yet
+	assigned map[string]fwmarkOwner
+}
+
+func newFwmarkAllocator() *fwmarkAllocator {
+	return &fwmarkAllocator{
+		assigned: make(map[string]fwmarkOwner),
+	}
+}
+
+// groups returns the fwmark owners of the master and slave keepalived groups of lb,
+// the recently assigned fwmarks take precedence over status, fwmark is 0 if a group
+// does not have a valid one
+func (a *fwmarkAllocator) groups(lb *lbapi.LoadBalancer) []fwmarkOwner {
+	provider := lb.Spec.Providers.Ipvsdr
+	if provider == nil {
+		return nil
+	}
+	key, _ := cache.MetaNamespaceKeyFunc(lb)
+	status := lb.Status.ProvidersStatuses.Ipvsdr
+
+	owners := make([]fwmarkOwner, len(provider.Slaves)+1)
+	for i := range owners {
+		o := fwmarkOwner{
+			key:     key,
+			lb:      key,
+			created: lb.CreationTimestamp.UnixNano(),
+		}
+		if i > 0 {
+			o.key = fmt.Sprintf("%s/slaves/%d", key, i-1)
+		}
+		switch {
+		case status == nil:
+		case i == 0 && status.Fwmark != nil:
+			o.fwmark = *status.Fwmark
+		case i > 0 && i-1 < len(status.Slaves) && status.Slaves[i-1].Fwmark != nil:
+			o.fwmark = *status.Slaves[i-1].Fwmark
+		}
+		if assigned, ok := a.assigned[o.key]; ok {
+			o.fwmark = assigned.fwmark
+		}
+		if o.fwmark < minFwmark || o.fwmark > maxFwmark {
+			o.fwmark = 0
+		}
+		owners[i] = o
+	}
+	return owners
+}
+
+// allocate returns the fwmarks of the keepalived groups of lb, the master group is
+// the first one followed by slaves. The current fwmark is kept unless another group
+// winning it uses it too, otherwise the vrid of the group is preferred if it is free so
+// that fwmarks are stable in a single network segment, and then the lowest free one. The keys of
+// other LoadBalancers which have to give up their fwmarks are returned.
+func (a *fwmarkAllocator) allocate(lb *lbapi.LoadBalancer, lbs []*lbapi.LoadBalancer, vrids []int) ([]int, []string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	groups := a.groups(lb)
+	if len(groups) != len(vrids) {
+		return nil, nil, fmt.Errorf("got %d vrids for %d keepalived groups", len(vrids), len(groups))
+	}
+
+	key, _ := cache.MetaNamespaceKeyFunc(lb)
+	// owners are the groups using fwmarks, including the former groups of lb
+	owners := make(map[int]fwmarkOwner)
+	for _, other := range lbs {
+		for _, o := range a.groups(other) {
+			if o.lb == key || o.fwmark == 0 {
+				continue
+			}
+			if owner, ok := owners[o.fwmark]; !ok || o.wins(owner) {
+				owners[o.fwmark] = o
+			}
+		}
+	}
+
+	fwmarks := make([]int, len(groups))
+	losers := make(map[string]bool)
+	for i, self := range groups {
+		fwmark := 0
+		owner, ok := owners[self.fwmark]
+		if self.fwmark != 0 && (!ok || (owner.lb != key && self.wins(owner))) {
+			fwmark = self.fwmark
+		} else if _, ok := owners[vrids[i]]; !ok && vrids[i] >= minFwmark {
+			fwmark = vrids[i]
+		} else {
+			for m := minFwmark; m <= maxFwmark; m++ {
+				if _, ok := owners[m]; !ok {
+					fwmark = m
+					break
+				}
+			}
+		}
+		if fwmark == 0 {
+			return nil, nil, fmt.Errorf("no free fwmark for group %v", self.key)
+		}
+		if owner, ok := owners[fwmark]; ok {
+			losers[owner.lb] = true
+		}
+		self.fwmark = fwmark
+		owners[fwmark] = self
+		a.assigned[self.key] = self
+		fwmarks[i] = fwmark
+	}
+
+	keys := make([]string, 0, len(losers))
+	for k := range losers {
+		keys = append(keys, k)
+	}
+	return fwmarks, keys, nil
+}
+
+// release forgets the fwmarks assigned to the groups of LoadBalancer
+func (a *fwmarkAllocator) release(key string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k, o := range a.assigned {
+		if o.lb == key {
+			delete(a.assigned, k)
+		}
+	}
+}
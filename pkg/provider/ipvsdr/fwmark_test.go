@@ -0,0 +1,94 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipvsdr
+
+import (
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+func TestAllocateFwmark(t *testing.T) {
+	a := newFwmarkAllocator()
+	// a and b are in different segments, both of them use vrid 1
+	lba := newVRIDLoadBalancer("a", "10.0.0.10", 1, intPtr(1), nil)
+	lbb := newVRIDLoadBalancer("b", "10.1.0.10", 2, intPtr(1), nil)
+	lbs := []*lbapi.LoadBalancer{lba, lbb}
+
+	fa, _, err := a.allocate(lba, lbs, []int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fb, _, err := a.allocate(lbb, lbs, []int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fa[0] != 1 {
+		t.Errorf("got fwmark %d of a, want its vrid 1", fa[0])
+	}
+	if fb[0] == fa[0] {
+		t.Errorf("a and b share fwmark %d", fa[0])
+	}
+
+	// allocation is stable
+	if again, _, _ := a.allocate(lbb, lbs, []int{1}); again[0] != fb[0] {
+		t.Errorf("got fwmark %d of b, want %d", again[0], fb[0])
+	}
+
+	// the older LoadBalancer keeps the fwmark in status
+	a = newFwmarkAllocator()
+	lba.Status.ProvidersStatuses.Ipvsdr.Fwmark = intPtr(5)
+	lbb.Status.ProvidersStatuses.Ipvsdr.Fwmark = intPtr(5)
+	fb, losers, err := a.allocate(lbb, lbs, []int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fb[0] == 5 || len(losers) != 0 {
+		t.Errorf("got fwmark %d of b and losers %v, want b to give up 5", fb[0], losers)
+	}
+	lbb.Status.ProvidersStatuses.Ipvsdr.Fwmark = intPtr(5)
+	a = newFwmarkAllocator()
+	fa, losers, err = a.allocate(lba, lbs, []int{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fa[0] != 5 || len(losers) != 1 || losers[0] != "default/b" {
+		t.Errorf("got fwmark %d of a and losers %v, want a to keep 5 and b to lose", fa[0], losers)
+	}
+
+	a.release("default/a")
+	if len(a.assigned) != 0 {
+		t.Errorf("got assigned fwmarks %v after release", a.assigned)
+	}
+}
+
+func TestAllocateSlaveFwmarks(t *testing.T) {
+	a := newFwmarkAllocator()
+	lb := newVRIDLoadBalancer("a", "10.0.0.10", 1, nil, nil)
+	lb.Spec.Providers.Ipvsdr.Slaves = []lbapi.KeepalivedProvider{{VIP: "10.1.0.10"}}
+	// groups in different segments may have the same vrid
+	fwmarks, _, err := a.allocate(lb, []*lbapi.LoadBalancer{lb}, []int{1, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fwmarks[0] == fwmarks[1] {
+		t.Errorf("master and slave share fwmark %d", fwmarks[0])
+	}
+	if _, _, err := a.allocate(lb, []*lbapi.LoadBalancer{lb}, []int{1}); err == nil {
+		t.Errorf("expected error for mismatched vrids")
+	}
+}
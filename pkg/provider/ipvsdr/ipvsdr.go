@@ -18,7 +18,6 @@ package ipvsdr
 
 import (
 	"fmt"
-	"math/rand"
 	"strings"
 	"time"
 
@@ -29,8 +28,11 @@ import (
 	controllerutil "github.com/caicloud/clientset/util/controller"
 	"github.com/caicloud/clientset/util/syncqueue"
 	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	policyv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/policy/v1"
 	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	"github.com/caicloud/loadbalancer-controller/pkg/keepalived"
 	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	"github.com/caicloud/loadbalancer-controller/pkg/rollout"
 	"github.com/caicloud/loadbalancer-controller/pkg/toleration"
 	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
 
@@ -52,20 +54,34 @@ const (
 	providerNameSuffix    = "-provider-ipvsdr"
 	providerName          = "ipvsdr"
 	providerPriorityClass = "system-node-critical"
+	// minImageVersion is the first provider image running the keepalived config
+	// in KEEPALIVED_CONFIG_DIR, PORT_RANGES is read since v0.4.0
+	minImageVersion = "v0.5.0"
 )
 
 type ipvsdr struct {
-	initialized      bool
-	image            string
-	nodeIPLabel      string
-	nodeIPAnnotation string
+	initialized   bool
+	image         string
+	allowedImages []string
+	// rollout means the default image is granted by staged rollout
+	stagedRollout bool
+	// nodeIPSource decides where the ipv4 and ipv6 addresses of nodes come from
+	nodeIPSource keepalived.NodeIPSource
 
 	client kubernetes.Interface
 	queue  *syncqueue.SyncQueue
-
-	lbLister  lblisters.LoadBalancerLister
-	dLister   appslisters.DeploymentLister
-	podLister corelisters.PodLister
+	// policyClient manages policy/v1 PodDisruptionBudgets
+	policyClient *policyv1.Client
+	// vrids allocates VRRP router ids
+	vrids *vridAllocator
+	// fwmarks allocates firewall marks of vips
+	fwmarks *fwmarkAllocator
+
+	lbLister   lblisters.LoadBalancerLister
+	dLister    appslisters.DeploymentLister
+	dsLister   appslisters.DaemonSetLister
+	podLister  corelisters.PodLister
+	nodeLister corelisters.NodeLister
 }
 
 // New creates a new ipvsdr provider plugin
@@ -83,22 +99,40 @@ func (f *ipvsdr) Init(cfg config.Configuration, sif informers.SharedInformerFact
 
 	// set config
 	f.image = cfg.Providers.Ipvsdr.Image
-	f.nodeIPLabel = cfg.Providers.Ipvsdr.NodeIPLabel
-	f.nodeIPAnnotation = cfg.Providers.Ipvsdr.NodeIPAnnotation
+	f.allowedImages = cfg.AllowedImages
+	f.stagedRollout = cfg.Rollout.Enabled
+	f.nodeIPSource = keepalived.NodeIPSource{
+		Label:          cfg.Providers.Ipvsdr.NodeIPLabel,
+		Annotation:     cfg.Providers.Ipvsdr.NodeIPAnnotation,
+		IPv6Label:      cfg.Providers.Ipvsdr.NodeIPv6Label,
+		IPv6Annotation: cfg.Providers.Ipvsdr.NodeIPv6Annotation,
+	}
 	f.client = cfg.Client
+	f.policyClient = policyv1.New(cfg.Client.Native().PolicyV1beta1().RESTClient())
+	f.vrids = newVRIDAllocator(cfg.Providers.Ipvsdr.VRIDSegmentPrefix)
+	f.fwmarks = newFwmarkAllocator()
 
 	// initialize controller
 	lbInformer := sif.Custom().Loadbalance().V1alpha2().LoadBalancers()
 	dInformer := sif.Native().Apps().V1().Deployments()
+	dsInformer := sif.Native().Apps().V1().DaemonSets()
 	podInfomer := sif.Native().Core().V1().Pods()
+	nodeInformer := sif.Native().Core().V1().Nodes()
 
 	f.lbLister = lbInformer.Lister()
 	f.dLister = dInformer.Lister()
+	f.dsLister = dsInformer.Lister()
 	f.podLister = podInfomer.Lister()
+	f.nodeLister = nodeInformer.Lister()
 	f.queue = syncqueue.NewPassthroughSyncQueue(&lbapi.LoadBalancer{}, f.syncLoadBalancer)
 
-	dInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDeployment(f.lbLister, f.dLister, f.queue, f.deploymentFiltered))
+	dInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDeployment(f.lbLister, f.queue, f.deploymentFiltered))
+	dsInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDaemonSet(f.lbLister, f.queue, f.daemonSetFiltered))
 	podInfomer.Informer().AddEventHandler(lbutil.NewEventHandlerForSyncStatusWithPod(f.lbLister, f.podLister, f.queue, f.podFiltered))
+	// real servers of keepalived are the ips of nodes
+	nodeInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForNode(f.lbLister, f.queue, func(lb *lbapi.LoadBalancer) bool {
+		return lb.Spec.Providers.Ipvsdr == nil
+	}))
 }
 
 func (f *ipvsdr) Run(stopCh <-chan struct{}) {
@@ -124,6 +158,8 @@ func (f *ipvsdr) Run(stopCh <-chan struct{}) {
 
 	f.queue.Run(workers)
 
+	f.detectDuplicateVRIDs()
+
 	<-stopCh
 }
 
@@ -139,6 +175,10 @@ func (f *ipvsdr) deploymentFiltered(obj *appsv1.Deployment) bool {
 	return f.filteredByLabel(obj)
 }
 
+func (f *ipvsdr) daemonSetFiltered(obj *appsv1.DaemonSet) bool {
+	return f.filteredByLabel(obj)
+}
+
 func (f *ipvsdr) podFiltered(obj *v1.Pod) bool {
 	return f.filteredByLabel(obj)
 }
@@ -172,6 +212,8 @@ func (f *ipvsdr) syncLoadBalancer(obj interface{}) error {
 	nlb, err := f.lbLister.LoadBalancers(lb.Namespace).Get(lb.Name)
 	if errors.IsNotFound(err) {
 		log.Warningf("LoadBalancer %v has been deleted, clean up provider", key)
+		// events of a deleted lb are never changed again
+		lbutil.ForgetEvents(lb)
 
 		return f.cleanup(lb, false)
 	}
@@ -191,17 +233,28 @@ func (f *ipvsdr) syncLoadBalancer(obj interface{}) error {
 		return f.cleanup(lb, true)
 	}
 
+	if provider := lb.Spec.Providers.Ipvsdr; provider.VIP == "" && len(provider.VIPs) == 0 {
+		// the vip is not allocated from pool yet
+		log.Infof("Loadbalancer %v is waiting for vip from pool", key)
+		return nil
+	}
+
 	ds, err := f.getDeploymentsForLoadBalancer(lb)
 	if err != nil {
 		return err
 	}
 
+	dss, err := f.getDaemonSetsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+
 	if lb.DeletionTimestamp != nil {
 		// TODO sync status only
 		return nil
 	}
 
-	return f.sync(lb, ds)
+	return f.sync(lb, ds, dss)
 }
 
 func (f *ipvsdr) getDeploymentsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv1.Deployment, error) {
@@ -234,10 +287,94 @@ func (f *ipvsdr) getDeploymentsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv
 	return cm.Claim(dList)
 }
 
-// sync generate desired deployment from lb and compare it with existing deployment
-func (f *ipvsdr) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
-	desiredDeploy := f.generateDeployment(lb)
+func (f *ipvsdr) getDaemonSetsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv1.DaemonSet, error) {
 
+	// construct selector
+	selector := f.selector(lb).AsSelector()
+
+	// list all
+	dsList, err := f.dsLister.DaemonSets(lb.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	canAdoptFunc := controllerutil.RecheckDeletionTimestamp(func() (metav1.Object, error) {
+		// fresh lb
+		fresh, err := f.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace).Get(lb.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if fresh.UID != lb.UID {
+			return nil, fmt.Errorf("original LoadBalancer %v/%v is gone: got uid %v, wanted %v", lb.Namespace, lb.Name, fresh.UID, lb.UID)
+		}
+		return fresh, nil
+	})
+
+	cm := controllerutil.NewDaemonSetControllerRefManager(f.client.Native(), lb, selector, api.ControllerKind, canAdoptFunc)
+	return cm.Claim(dsList)
+}
+
+// sync generate desired workload from lb and compare it with existing workload
+func (f *ipvsdr) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment, dss []*appsv1.DaemonSet) error {
+	// the following loadbalancer need to get valid vrids
+	// 1. a new lb need to get a valid vrid
+	// 2. a old lb didn't have a valid vrid
+	// 3. a lb shares its vrid with another lb in the same network segment
+	vrids, err := f.allocateVRIDs(lb)
+	if err != nil {
+		return err
+	}
+	fwmarks, err := f.allocateFwmarks(lb, vrids)
+	if err != nil {
+		return err
+	}
+	cm, err := f.ensureKeepalivedConfig(lb, vrids, fwmarks)
+	if err != nil {
+		return err
+	}
+
+	defaultImage := f.image
+	if f.stagedRollout {
+		current := rollout.CurrentDaemonSetImage(dss, providerName)
+		if current == "" {
+			current = rollout.CurrentImage(dps, providerName)
+		}
+		defaultImage = rollout.Image(lb, rollout.ComponentIpvsdr, f.image, current)
+	}
+	desiredDeploy, err := f.generateDeployment(lb, defaultImage)
+	if err != nil {
+		log.Errorf("Generate deployment for loadbalancer %v error: %v", lb.Name, err)
+		return err
+	}
+
+	var ds *appsv1.DaemonSet
+	if lb.Spec.Providers.Ipvsdr.Workload == lbapi.WorkloadDaemonSet {
+		desired := lbutil.GenerateDaemonSet(desiredDeploy, lb.Name+providerNameSuffix)
+		ds, err = lbutil.EnsureDaemonSet(f.client, lb, desired, dss)
+		if err == nil {
+			// switched from deployment, the daemonset is created first so the
+			// provider is not gone until the deployment is deleted
+			err = lbutil.DeleteDeployments(f.client, dps...)
+		}
+	} else {
+		err = f.syncDeployment(lb, desiredDeploy, dps, dss)
+	}
+	if err != nil {
+		return err
+	}
+
+	replicas, _ := lbutil.CalculateReplicas(lb)
+	err = lbutil.EnsurePodDisruptionBudget(f.policyClient, lb, lb.Name+providerNameSuffix, f.selector(lb), replicas, nil)
+	if err != nil {
+		return err
+	}
+
+	return f.syncStatus(lb, ds, cm, vrids, fwmarks)
+}
+
+// syncDeployment runs provider with a deployment, daemonsets are deleted
+func (f *ipvsdr) syncDeployment(lb *lbapi.LoadBalancer, desiredDeploy *appsv1.Deployment, dps []*appsv1.Deployment, dss []*appsv1.DaemonSet) error {
 	// update
 	updated := false
 	for _, dp := range dps {
@@ -260,7 +397,7 @@ func (f *ipvsdr) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
 		updated = true
 		// do not change deployment if the loadbalancer is static
 		if !lbutil.IsStatic(lb) {
-			merged, changed := lbutil.MergeDeployment(dp, desiredDeploy)
+			merged, changed := lbutil.MergeDeployment(dp, desiredDeploy, false)
 			if changed {
 				log.Infof("Sync ipvsdr deployment %v for lb %v", dp.Name, lb.Name)
 				_, err := f.client.Native().AppsV1().Deployments(lb.Namespace).Update(merged)
@@ -281,12 +418,17 @@ func (f *ipvsdr) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
 		}
 	}
 
-	return f.syncStatus(lb)
+	// switched from daemonset, it is deleted after the deployment is created
+	return lbutil.DeleteDaemonSets(f.client, dss...)
 }
 
 // cleanup deployment and other resource controlled by ipvsdr provider
 func (f *ipvsdr) cleanup(lb *lbapi.LoadBalancer, deleteStatus bool) error {
 
+	key, _ := cache.MetaNamespaceKeyFunc(lb)
+	f.vrids.release(key)
+	f.fwmarks.release(key)
+
 	ds, err := f.getDeploymentsForLoadBalancer(lb)
 	if err != nil {
 		return err
@@ -301,6 +443,25 @@ func (f *ipvsdr) cleanup(lb *lbapi.LoadBalancer, deleteStatus bool) error {
 		})
 	}
 
+	dss, err := f.getDaemonSetsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+	err = lbutil.DeleteDaemonSets(f.client, dss...)
+	if err != nil {
+		return err
+	}
+
+	err = f.deleteKeepalivedConfig(lb)
+	if err != nil {
+		return err
+	}
+
+	err = lbutil.DeletePodDisruptionBudget(f.policyClient, lb.Namespace, lb.Name+providerNameSuffix)
+	if err != nil {
+		return err
+	}
+
 	if deleteStatus {
 		return f.deleteStatus(lb)
 	}
@@ -308,7 +469,23 @@ func (f *ipvsdr) cleanup(lb *lbapi.LoadBalancer, deleteStatus bool) error {
 	return nil
 }
 
-func (f *ipvsdr) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
+// generateDeployment generates the deployment of lb, defaultImage is used
+// if lb does not override the image
+func (f *ipvsdr) generateDeployment(lb *lbapi.LoadBalancer, defaultImage string) (*appsv1.Deployment, error) {
+	image, err := lbutil.ResolveLoadBalancerImage(f.client, lb, "ipvsdr", defaultImage, lb.Spec.Providers.Ipvsdr.Image, lb.Spec.Providers.Ipvsdr.Version, f.allowedImages)
+	if err != nil {
+		return nil, err
+	}
+	// images held by staged rollout are already running, they are replaced when rollout reaches lb
+	held := image == defaultImage && defaultImage != f.image
+	if !held && lbutil.ImageOlderThan(image, minImageVersion) {
+		// older providers ignore the envs, they render their own keepalived config
+		// and forward all ports of vip
+		err := fmt.Errorf("image %s is older than %s which is required by ipvsdr provider", image, minImageVersion)
+		lbutil.RecordEventOnChange(f.client, lb, "ipvsdr/version", v1.EventTypeWarning, "ImageTooOld", "%v", err)
+		return nil, err
+	}
+	lbutil.ForgetEvent(lb, "ipvsdr/version")
 	terminationGracePeriodSeconds := int64(30)
 	hostNetwork := true
 	dnsPolicy := v1.DNSClusterFirstWithHostNet
@@ -398,7 +575,7 @@ func (f *ipvsdr) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 					Containers: []v1.Container{
 						{
 							Name:            providerName,
-							Image:           f.image,
+							Image:           image,
 							ImagePullPolicy: v1.PullAlways,
 							Resources: v1.ResourceRequirements{
 								Limits: v1.ResourceList{
@@ -440,14 +617,37 @@ func (f *ipvsdr) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 								},
 								{
 									Name:  "NODEIP_LABEL",
-									Value: f.nodeIPLabel,
+									Value: f.nodeIPSource.Label,
 								},
 								{
 									Name:  "NODEIP_ANNOTATION",
-									Value: f.nodeIPAnnotation,
+									Value: f.nodeIPSource.Annotation,
+								},
+								{
+									Name:  "NODEIPV6_LABEL",
+									Value: f.nodeIPSource.IPv6Label,
+								},
+								{
+									Name:  "NODEIPV6_ANNOTATION",
+									Value: f.nodeIPSource.IPv6Annotation,
+								},
+								{
+									// only these ports of vip will be forwarded to proxy
+									Name:  "PORT_RANGES",
+									Value: lbutil.FormatPortRanges(lbutil.ProxyPortRanges(lb)),
+								},
+								{
+									// keepalived.conf and fwmark rules rendered by controller
+									Name:  "KEEPALIVED_CONFIG_DIR",
+									Value: keepalivedConfigDir,
 								},
 							},
 							VolumeMounts: []v1.VolumeMount{
+								{
+									Name:      keepalivedConfigVolume,
+									MountPath: keepalivedConfigDir,
+									ReadOnly:  true,
+								},
 								{
 									Name:      "modules",
 									MountPath: "/lib/modules",
@@ -462,6 +662,16 @@ func (f *ipvsdr) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 						},
 					},
 					Volumes: []v1.Volume{
+						{
+							Name: keepalivedConfigVolume,
+							VolumeSource: v1.VolumeSource{
+								ConfigMap: &v1.ConfigMapVolumeSource{
+									LocalObjectReference: v1.LocalObjectReference{
+										Name: keepalivedConfigMapName(lb),
+									},
+								},
+							},
+						},
 						{
 							Name: "modules",
 							VolumeSource: v1.VolumeSource{
@@ -485,9 +695,31 @@ func (f *ipvsdr) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 		},
 	}
 
-	return deploy
+	// apply the pod template overlay
+	err = lbutil.ApplyPodTemplate(deploy, lb.Spec.Providers.Ipvsdr.PodTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return deploy, nil
 }
 
-func (f *ipvsdr) getValidVRID() int {
-	return rand.Intn(254) + 1
+// detectDuplicateVRIDs finds the LoadBalancers sharing vrids in a network segment,
+// which are allocated randomly by old versions, and enqueues them to reallocate
+func (f *ipvsdr) detectDuplicateVRIDs() {
+	lbs, err := f.lbLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("List loadbalancers error: %v", err)
+		return
+	}
+	losers := f.vrids.duplicates(lbs)
+	for _, lb := range lbs {
+		key, _ := cache.MetaNamespaceKeyFunc(lb)
+		winner, ok := losers[key]
+		if !ok {
+			continue
+		}
+		log.Warningf("Loadbalancer %v shares vrid %v with %v in segment %v", key, winner.vrid, winner.key, winner.segment)
+		f.queue.Enqueue(lb)
+	}
 }
@@ -0,0 +1,125 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipvsdr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	"github.com/caicloud/loadbalancer-controller/pkg/keepalived"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	log "k8s.io/klog"
+)
+
+const (
+	keepalivedConfigDir    = "/etc/keepalived/loadbalancer"
+	keepalivedConfigVolume = "keepalived-config"
+	// the max length of config diff in events
+	maxEventDiffLength = 1024
+)
+
+// keepalivedConfigMapName returns the name of ConfigMap holding keepalived config
+func keepalivedConfigMapName(lb *lbapi.LoadBalancer) string {
+	return lb.Name + providerNameSuffix
+}
+
+// renderKeepalivedConfig renders the keepalived config of lb with the nodes
+// which provider runs on, vrids and fwmarks are allocated for master and slave groups
+func (f *ipvsdr) renderKeepalivedConfig(lb *lbapi.LoadBalancer, vrids, fwmarks []int) (map[string]string, error) {
+	selector := labels.Set{fmt.Sprintf(lbapi.UniqueLabelKeyFormat, lb.Namespace, lb.Name): "true"}.AsSelector()
+	nodes, err := f.nodeLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	config, err := keepalived.NewConfig(lb, vrids, fwmarks, nodes, f.nodeIPSource)
+	if err != nil {
+		return nil, err
+	}
+	return keepalived.Render(config)
+}
+
+// ensureKeepalivedConfig renders the keepalived config of lb and writes it to
+// the ConfigMap mounted into provider pods
+func (f *ipvsdr) ensureKeepalivedConfig(lb *lbapi.LoadBalancer, vrids, fwmarks []int) (*v1.ConfigMap, error) {
+	data, err := f.renderKeepalivedConfig(lb, vrids, fwmarks)
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "InvalidKeepalivedConfig", "Render keepalived config error: %v", err)
+		return nil, err
+	}
+
+	name := keepalivedConfigMapName(lb)
+	cm, err := f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Get(name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		t := true
+		cm = &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: f.selector(lb),
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         api.ControllerKind.GroupVersion().String(),
+						Kind:               api.ControllerKind.Kind,
+						Name:               lb.Name,
+						UID:                lb.UID,
+						Controller:         &t,
+						BlockOwnerDeletion: &t,
+					},
+				},
+			},
+			Data: data,
+		}
+		log.Infof("Create keepalived ConfigMap %v for lb %v", name, lb.Name)
+		return f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Create(cm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if reflect.DeepEqual(cm.Data, data) {
+		return cm, nil
+	}
+	diff := strings.Join(keepalived.DiffData(cm.Data, data), "\n")
+	cm = cm.DeepCopy()
+	cm.Data = data
+	log.Infof("Update keepalived ConfigMap %v for lb %v:\n%v", name, lb.Name, diff)
+	cm, err = f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Update(cm)
+	if err != nil {
+		return nil, err
+	}
+	if len(diff) > maxEventDiffLength {
+		diff = diff[:maxEventDiffLength] + "\n..."
+	}
+	lbutil.RecordEvent(f.client, lb, v1.EventTypeNormal, "KeepalivedConfigUpdated", "Keepalived config %v is updated:\n%v", name, diff)
+	return cm, nil
+}
+
+// deleteKeepalivedConfig deletes the keepalived ConfigMap of lb
+func (f *ipvsdr) deleteKeepalivedConfig(lb *lbapi.LoadBalancer) error {
+	err := f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Delete(keepalivedConfigMapName(lb), &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
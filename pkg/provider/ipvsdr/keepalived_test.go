@@ -0,0 +1,134 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipvsdr
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/keepalived"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// newNodeProvider returns an ipvsdr provider whose node lister has a node running lbs
+func newNodeProvider(t *testing.T, lbs ...*lbapi.LoadBalancer) *ipvsdr {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1", Labels: map[string]string{}},
+		Status: v1.NodeStatus{
+			Addresses: []v1.NodeAddress{{Type: v1.NodeInternalIP, Address: "192.168.0.1"}},
+		},
+	}
+	for _, lb := range lbs {
+		node.Labels[fmt.Sprintf(lbapi.UniqueLabelKeyFormat, lb.Namespace, lb.Name)] = "true"
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(node); err != nil {
+		t.Fatal(err)
+	}
+	return &ipvsdr{nodeLister: corelisters.NewNodeLister(indexer)}
+}
+
+var setMarkRegexp = regexp.MustCompile(`--set-mark (\d+)`)
+
+func TestRenderKeepalivedConfig(t *testing.T) {
+	lb := newVRIDLoadBalancer("lb", "10.0.0.10", 1, nil, nil)
+	provider := lb.Spec.Providers.Ipvsdr
+	provider.VIPs = []string{"10.0.0.10", "10.0.0.11"}
+	provider.Scheduler = lbapi.IpvsSchedulerRR
+	provider.Slaves = []lbapi.KeepalivedProvider{
+		{
+			VIPs:      []string{"10.0.1.10"},
+			Scheduler: lbapi.IpvsSchedulerWRR,
+			HAMode:    lbapi.ActiveActiveHA,
+			Bind:      &lbapi.KeepalivedBind{Iface: "eth1"},
+		},
+	}
+	f := newNodeProvider(t, lb)
+
+	if _, err := f.renderKeepalivedConfig(lb, []int{1}, []int{1}); err == nil {
+		t.Errorf("expected error for missing slave vrid")
+	}
+
+	data, err := f.renderKeepalivedConfig(lb, []int{1, 2}, []int{1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	conf := data[keepalived.ConfigKey]
+	for _, want := range []string{
+		"vrrp_instance default_lb_master {",
+		"virtual_router_id 1",
+		"lb_algo rr",
+		"vrrp_instance default_lb_slave-0 {",
+		"interface eth1",
+		"virtual_router_id 2",
+		"lb_algo wrr",
+		"real_server 192.168.0.1 0 {",
+	} {
+		if !strings.Contains(conf, want) {
+			t.Errorf("keepalived.conf doesn't contain %q:\n%v", want, conf)
+		}
+	}
+	if strings.Count(conf, "nopreempt") != 1 {
+		t.Errorf("want only the active-passive master group to be nopreempt:\n%v", conf)
+	}
+}
+
+func TestRenderKeepalivedConfigSegmentsOnNode(t *testing.T) {
+	// a and b are in different network segments and get the same vrid,
+	// their providers run on the same node
+	lba := newVRIDLoadBalancer("a", "10.0.0.10", 1, nil, nil)
+	lbb := newVRIDLoadBalancer("b", "10.1.0.10", 2, nil, nil)
+	lbs := []*lbapi.LoadBalancer{lba, lbb}
+	f := newNodeProvider(t, lbs...)
+	vrids := newVRIDAllocator(24)
+	fwmarks := newFwmarkAllocator()
+
+	marks := make(map[string]string)
+	for _, lb := range lbs {
+		vrid, _, err := allocateMaster(vrids, lb, lbs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vrid != 1 {
+			t.Errorf("got vrid %d of %v, want 1", vrid, lb.Name)
+		}
+		fwmark, _, err := fwmarks.allocate(lb, lbs, []int{vrid})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := f.renderKeepalivedConfig(lb, []int{vrid}, fwmark)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range setMarkRegexp.FindAllStringSubmatch(data[keepalived.RulesKey], -1) {
+			if other, ok := marks[m[1]]; ok && other != lb.Name {
+				t.Errorf("fwmark %v is used by both %v and %v on node1", m[1], other, lb.Name)
+			}
+			marks[m[1]] = lb.Name
+		}
+	}
+	if len(marks) != 2 {
+		t.Errorf("got fwmarks %v, want one for each vip", marks)
+	}
+}
@@ -20,12 +20,19 @@ import (
 	"sort"
 
 	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/keepalived"
 	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
 
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
 	log "k8s.io/klog"
 )
 
-func (f *ipvsdr) syncStatus(lb *lbapi.LoadBalancer) error {
+// syncStatus syncs status of provider, vrids and fwmarks are allocated for
+// master and slave groups
+func (f *ipvsdr) syncStatus(lb *lbapi.LoadBalancer, ds *appsv1.DaemonSet, cm *v1.ConfigMap, vrids, fwmarks []int) error {
 	if lb.Spec.Providers.Ipvsdr == nil {
 		return f.deleteStatus(lb)
 	}
@@ -45,6 +52,10 @@ func (f *ipvsdr) syncStatus(lb *lbapi.LoadBalancer) error {
 	}
 
 	replicas, _ := lbutil.CalculateReplicas(lb)
+	if ds != nil {
+		// desired replicas is the number of nodes which daemonset runs on
+		replicas = lbutil.DaemonSetReplicas(lb, ds)
+	}
 	// caculate proxy status
 	providerStatus := lbapi.IpvsdrProviderStatus{
 		PodStatuses: lbapi.PodStatuses{
@@ -53,20 +64,33 @@ func (f *ipvsdr) syncStatus(lb *lbapi.LoadBalancer) error {
 			TotalReplicas: 0,
 			Statuses:      make([]lbapi.PodStatus, 0),
 		},
-		VIP:  vip,
-		VIPs: vips,
+		VIP:      vip,
+		VIPs:     vips,
+		Families: lbapi.VIPFamilies(vips),
+	}
+	if ds != nil {
+		providerStatus.DaemonSet = ds.Name
+	}
+	if cm != nil {
+		providerStatus.ConfigMap = cm.Name
 	}
 
-	// the following loadbalancer need to get a valid vrid
-	// 1. a new lb need to get a valid vrid
-	// 2. a old lb didn't have a valid vrid
 	ipvsdrstatus := lb.Status.ProvidersStatuses.Ipvsdr
-	if ipvsdrstatus == nil || ipvsdrstatus.Vrid == nil || *ipvsdrstatus.Vrid == -1 {
-		// keepalived use unicast now. so vrid will not be conflict
-		vrid := f.getValidVRID()
-		providerStatus.Vrid = &vrid
-	} else {
-		providerStatus.Vrid = ipvsdrstatus.Vrid
+	vrid, fwmark := vrids[0], fwmarks[0]
+	providerStatus.Vrid = &vrid
+	providerStatus.Fwmark = &fwmark
+	for i, slave := range provider.Slaves {
+		vrid, fwmark := vrids[i+1], fwmarks[i+1]
+		slaveStatus := lbapi.KeepalivedStatus{
+			VIPs:   lbapi.KeepalivedVIPs(slave),
+			Vrid:   &vrid,
+			Fwmark: &fwmark,
+		}
+		slaveStatus.Families = lbapi.VIPFamilies(slaveStatus.VIPs)
+		if len(slaveStatus.VIPs) > 0 {
+			slaveStatus.VIP = slaveStatus.VIPs[0]
+		}
+		providerStatus.Slaves = append(providerStatus.Slaves, slaveStatus)
 	}
 
 	podList, err := f.podLister.List(f.selector(lb).AsSelector())
@@ -87,6 +111,7 @@ func (f *ipvsdr) syncStatus(lb *lbapi.LoadBalancer) error {
 	}
 
 	sort.Sort(lbutil.SortPodStatusByName(providerStatus.Statuses))
+	providerStatus.RunningImages = lbutil.RunningImages(podList, providerName)
 
 	// check whether the statuses are equal
 	if ipvsdrstatus == nil || !lbutil.IpvsdrProviderStatusEqual(*ipvsdrstatus, providerStatus) {
@@ -112,6 +137,89 @@ func (f *ipvsdr) syncStatus(lb *lbapi.LoadBalancer) error {
 	return nil
 }
 
+// allocateVRIDs allocates the vrids of master and slave groups of lb, events
+// are recorded when a vrid conflicts with another group
+func (f *ipvsdr) allocateVRIDs(lb *lbapi.LoadBalancer) ([]int, error) {
+	lbs, err := f.lbLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	previous := make([]int, len(lb.Spec.Providers.Ipvsdr.Slaves)+1)
+	for i := range previous {
+		previous[i] = -1
+	}
+	if status := lb.Status.ProvidersStatuses.Ipvsdr; status != nil {
+		if status.Vrid != nil {
+			previous[0] = *status.Vrid
+		}
+		for i := 0; i < len(status.Slaves) && i+1 < len(previous); i++ {
+			if status.Slaves[i].Vrid != nil {
+				previous[i+1] = *status.Slaves[i].Vrid
+			}
+		}
+	}
+
+	vrids, conflicts, err := f.vrids.allocate(lb, lbs)
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "VRIDExhausted", "Allocate vrid error: %v", err)
+		return nil, err
+	}
+
+	// the LoadBalancers using the vrids of lb have to reallocate, such as lb sets
+	// an explicit vrid which is allocated to another one
+	key, _ := cache.MetaNamespaceKeyFunc(lb)
+	losers := f.vrids.duplicates(lbs)
+	for _, other := range lbs {
+		otherKey, _ := cache.MetaNamespaceKeyFunc(other)
+		if winner, ok := losers[otherKey]; ok && otherKey != key && winner.lb == key {
+			f.queue.Enqueue(other)
+		}
+	}
+
+	for i, vrid := range vrids {
+		conflict := conflicts[i]
+		// events are only recorded when vrid changes
+		if conflict == nil || vrid == previous[i] {
+			continue
+		}
+		group := keepalived.GroupName(i)
+		if i == 0 && lb.Spec.Providers.Ipvsdr.Vrid != nil {
+			// the explicit vrid is kept
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "DuplicateVRID",
+				"Vrid %d is also used by %v in segment %v", vrid, conflict.key, conflict.segment)
+			continue
+		}
+		log.Warningf("Reallocate vrid of group %v of loadbalancer %v from %v to %v, it is used by %v", group, key, previous[i], vrid, conflict.key)
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "DuplicateVRID",
+			"Vrid %d of group %v is used by %v in segment %v, reallocated vrid %d", conflict.vrid, group, conflict.key, conflict.segment, vrid)
+	}
+	return vrids, nil
+}
+
+// allocateFwmarks allocates the fwmarks of master and slave groups of lb, the
+// LoadBalancers giving up their fwmarks are resynced
+func (f *ipvsdr) allocateFwmarks(lb *lbapi.LoadBalancer, vrids []int) ([]int, error) {
+	lbs, err := f.lbLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	fwmarks, losers, err := f.fwmarks.allocate(lb, lbs, vrids)
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "FwmarkExhausted", "Allocate fwmark error: %v", err)
+		return nil, err
+	}
+	for _, key := range losers {
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			continue
+		}
+		if other, err := f.lbLister.LoadBalancers(namespace).Get(name); err == nil {
+			f.queue.Enqueue(other)
+		}
+	}
+	return fwmarks, nil
+}
+
 func (f *ipvsdr) deleteStatus(lb *lbapi.LoadBalancer) error {
 	if lb.Status.ProvidersStatuses.Ipvsdr == nil {
 		return nil
@@ -0,0 +1,296 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipvsdr
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	minVRID = 1
+	maxVRID = 255
+	// ipv6 vips are always grouped by /64
+	ipv6SegmentPrefix = 64
+)
+
+// vridOwner is a keepalived group of a LoadBalancer using a vrid in a network segment
+type vridOwner struct {
+	// key identifies the group, it is the key of LoadBalancer for the master group
+	// and suffixed by /slaves/<index> for slave groups
+	key string
+	// lb is the key of LoadBalancer
+	lb string
+	// segments are the network segments of the first vip of each ip family,
+	// segment is them joined by comma
+	segments []string
+	segment  string
+	vrid     int
+	// explicit means the vrid is set in spec
+	explicit bool
+	// the older LoadBalancer keeps the vrid
+	created int64
+}
+
+// overlaps checks whether a and b have a common network segment
+func (a vridOwner) overlaps(b vridOwner) bool {
+	for _, s := range a.segments {
+		for _, t := range b.segments {
+			if s == t {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wins checks whether a keeps the vrid when b uses it too
+func (a vridOwner) wins(b vridOwner) bool {
+	if a.explicit != b.explicit {
+		return a.explicit
+	}
+	if a.created != b.created {
+		return a.created < b.created
+	}
+	return a.key < b.key
+}
+
+// vridAllocator allocates VRRP router ids of ipvsdr LoadBalancers. An id is unique
+// in a network segment, which is the first vip of each ip family masked by the
+// prefix length.
+type vridAllocator struct {
+	prefix int
+
+	mu sync.Mutex
+	// assigned are the vrids allocated recently, they may not be observed
+	// in the status of LoadBalancers in lister yet
+	assigned map[string]vridOwner
+}
+
+func newVRIDAllocator(prefix int) *vridAllocator {
+	return &vridAllocator{
+		prefix:   prefix,
+		assigned: make(map[string]vridOwner),
+	}
+}
+
+// segment returns the network segment of vip
+func (a *vridAllocator) segment(vip string) string {
+	ip := net.ParseIP(vip)
+	if ip == nil {
+		return vip
+	}
+	if ip.To4() != nil {
+		return (&net.IPNet{IP: ip.Mask(net.CIDRMask(a.prefix, 32)), Mask: net.CIDRMask(a.prefix, 32)}).String()
+	}
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(ipv6SegmentPrefix, 128)), Mask: net.CIDRMask(ipv6SegmentPrefix, 128)}).String()
+}
+
+// groups returns the vrid owners of the master and slave keepalived groups of lb,
+// vrid is 0 if a group does not have a valid one
+func (a *vridAllocator) groups(lb *lbapi.LoadBalancer) []vridOwner {
+	provider := lb.Spec.Providers.Ipvsdr
+	if provider == nil {
+		return nil
+	}
+	key, _ := cache.MetaNamespaceKeyFunc(lb)
+	status := lb.Status.ProvidersStatuses.Ipvsdr
+
+	newOwner := func(id string, spec lbapi.KeepalivedProvider) vridOwner {
+		o := vridOwner{
+			key:     id,
+			lb:      key,
+			created: lb.CreationTimestamp.UnixNano(),
+		}
+		// ipv4 and ipv6 vips are announced by VRRP instances of the same vrid
+		for _, family := range lbapi.VIPFamilies(lbapi.KeepalivedVIPs(spec)) {
+			o.segments = append(o.segments, a.segment(family.VIPs[0]))
+		}
+		o.segment = strings.Join(o.segments, ",")
+		return o
+	}
+
+	master := newOwner(key, provider.KeepalivedProvider)
+	switch {
+	case provider.Vrid != nil:
+		master.vrid = *provider.Vrid
+		master.explicit = true
+	case status != nil && status.Vrid != nil:
+		master.vrid = *status.Vrid
+	}
+	owners := []vridOwner{master}
+
+	for i, slave := range provider.Slaves {
+		o := newOwner(fmt.Sprintf("%s/slaves/%d", key, i), slave)
+		if status != nil && i < len(status.Slaves) && status.Slaves[i].Vrid != nil {
+			o.vrid = *status.Slaves[i].Vrid
+		}
+		owners = append(owners, o)
+	}
+
+	for i := range owners {
+		if owners[i].vrid < minVRID || owners[i].vrid > maxVRID {
+			owners[i].vrid = 0
+		}
+	}
+	return owners
+}
+
+// owners returns the vrid owners of lbs, the recently assigned vrids take precedence
+func (a *vridAllocator) owners(lbs []*lbapi.LoadBalancer) []vridOwner {
+	owners := make([]vridOwner, 0, len(lbs))
+	for _, lb := range lbs {
+		for _, o := range a.groups(lb) {
+			if assigned, ok := a.assigned[o.key]; ok && !o.explicit && assigned.segment == o.segment {
+				o.vrid = assigned.vrid
+			}
+			owners = append(owners, o)
+		}
+	}
+	return owners
+}
+
+// allocate returns the vrids of the keepalived groups of lb, the master group
+// is the first one followed by slaves. The explicit vrid in spec is always used,
+// the current vrid in status is kept unless another group winning it uses it too,
+// otherwise the lowest free vrid in the segment is allocated. The groups which
+// lb conflicts with are returned, nil if there is no conflict.
+func (a *vridAllocator) allocate(lb *lbapi.LoadBalancer, lbs []*lbapi.LoadBalancer) ([]int, []*vridOwner, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	groups := a.groups(lb)
+	if len(groups) == 0 {
+		return nil, nil, fmt.Errorf("loadbalancer %v has no ipvsdr provider", lb.Name)
+	}
+
+	// groups of other LoadBalancers
+	key, _ := cache.MetaNamespaceKeyFunc(lb)
+	others := make([]vridOwner, 0, len(lbs))
+	for _, o := range a.owners(lbs) {
+		if o.lb != key && o.vrid != 0 {
+			others = append(others, o)
+		}
+	}
+
+	vrids := make([]int, len(groups))
+	conflicts := make([]*vridOwner, len(groups))
+	// allocated are the groups of lb handled before
+	allocated := make([]vridOwner, 0, len(groups))
+	for i, self := range groups {
+		if assigned, ok := a.assigned[self.key]; ok && !self.explicit && assigned.segment == self.segment {
+			self.vrid = assigned.vrid
+		}
+
+		used := make(map[int]bool)
+		var conflict *vridOwner
+		for _, o := range others {
+			if !o.overlaps(self) {
+				continue
+			}
+			used[o.vrid] = true
+			if o.vrid == self.vrid && (o.wins(self) || self.explicit) && conflict == nil {
+				o := o
+				conflict = &o
+			}
+		}
+		// the former groups of lb always win
+		for _, o := range allocated {
+			if !o.overlaps(self) {
+				continue
+			}
+			used[o.vrid] = true
+			if o.vrid == self.vrid && conflict == nil {
+				o := o
+				conflict = &o
+			}
+		}
+		conflicts[i] = conflict
+
+		if self.explicit || (self.vrid != 0 && conflict == nil) {
+			a.assigned[self.key] = self
+			vrids[i] = self.vrid
+			allocated = append(allocated, self)
+			continue
+		}
+
+		self.vrid = 0
+		for vrid := minVRID; vrid <= maxVRID; vrid++ {
+			if !used[vrid] {
+				self.vrid = vrid
+				break
+			}
+		}
+		if self.vrid == 0 {
+			return nil, conflicts, fmt.Errorf("no free vrid in segment %v", self.segment)
+		}
+		a.assigned[self.key] = self
+		vrids[i] = self.vrid
+		allocated = append(allocated, self)
+	}
+	return vrids, conflicts, nil
+}
+
+// release forgets the vrids assigned to the groups of LoadBalancer
+func (a *vridAllocator) release(key string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k, o := range a.assigned {
+		if o.lb == key {
+			delete(a.assigned, k)
+		}
+	}
+}
+
+// duplicates returns the keys of LoadBalancers which have groups giving up
+// their vrids, mapping to the groups keeping the vrids
+func (a *vridAllocator) duplicates(lbs []*lbapi.LoadBalancer) map[string]vridOwner {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	groups := make(map[string][]vridOwner)
+	for _, o := range a.owners(lbs) {
+		if o.vrid == 0 {
+			continue
+		}
+		for _, segment := range o.segments {
+			id := fmt.Sprintf("%s/%d", segment, o.vrid)
+			groups[id] = append(groups[id], o)
+		}
+	}
+
+	losers := make(map[string]vridOwner)
+	for _, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool { return group[i].wins(group[j]) })
+		for _, o := range group[1:] {
+			if _, ok := losers[o.lb]; !ok {
+				losers[o.lb] = group[0]
+			}
+		}
+	}
+	return losers
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipvsdr
+
+import (
+	"testing"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newVRIDLoadBalancer(name, vip string, created int, status, explicit *int) *lbapi.LoadBalancer {
+	lb := &lbapi.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(time.Unix(int64(created), 0)),
+		},
+	}
+	lb.Spec.Providers.Ipvsdr = &lbapi.IpvsdrProvider{Vrid: explicit}
+	lb.Spec.Providers.Ipvsdr.VIP = vip
+	if status != nil {
+		lb.Status.ProvidersStatuses.Ipvsdr = &lbapi.IpvsdrProviderStatus{Vrid: status}
+	}
+	return lb
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+// allocateMaster allocates vrids of lb and returns the master one
+func allocateMaster(a *vridAllocator, lb *lbapi.LoadBalancer, lbs []*lbapi.LoadBalancer) (int, *vridOwner, error) {
+	vrids, conflicts, err := a.allocate(lb, lbs)
+	if err != nil {
+		return 0, nil, err
+	}
+	return vrids[0], conflicts[0], nil
+}
+
+func TestAllocateVRID(t *testing.T) {
+	a := newVRIDAllocator(24)
+	lbs := []*lbapi.LoadBalancer{
+		newVRIDLoadBalancer("a", "10.0.0.10", 1, intPtr(1), nil),
+		newVRIDLoadBalancer("b", "10.0.0.11", 2, intPtr(3), nil),
+		newVRIDLoadBalancer("other-segment", "10.0.1.10", 3, intPtr(2), nil),
+	}
+
+	// the lowest free vrid in segment
+	c := newVRIDLoadBalancer("c", "10.0.0.12", 4, nil, nil)
+	vrid, conflict, err := allocateMaster(a, c, append(lbs, c))
+	if err != nil || vrid != 2 || conflict != nil {
+		t.Errorf("allocate c = %v, %v, %v, want 2", vrid, conflict, err)
+	}
+	// the assigned vrid is not observed in status yet
+	d := newVRIDLoadBalancer("d", "10.0.0.13", 5, nil, nil)
+	vrid, _, _ = allocateMaster(a, d, append(lbs, c, d))
+	if vrid != 4 {
+		t.Errorf("allocate d = %v, want 4", vrid)
+	}
+	// the current vrid is kept
+	vrid, conflict, _ = allocateMaster(a, lbs[1], lbs)
+	if vrid != 3 || conflict != nil {
+		t.Errorf("allocate b = %v, %v, want 3", vrid, conflict)
+	}
+
+	// the newer one gives up the duplicate vrid
+	dup := newVRIDLoadBalancer("dup", "10.0.0.14", 6, intPtr(1), nil)
+	vrid, conflict, _ = allocateMaster(a, dup, append(lbs, c, d, dup))
+	if vrid != 5 || conflict == nil || conflict.key != "default/a" {
+		t.Errorf("allocate dup = %v, %v, want 5 conflicting with a", vrid, conflict)
+	}
+
+	// the explicit vrid is always used, and wins the allocated one
+	explicit := newVRIDLoadBalancer("explicit", "10.0.0.15", 7, nil, intPtr(3))
+	vrid, conflict, _ = allocateMaster(a, explicit, append(lbs, explicit))
+	if vrid != 3 || conflict == nil || conflict.key != "default/b" {
+		t.Errorf("allocate explicit = %v, %v, want 3 conflicting with b", vrid, conflict)
+	}
+	vrid, conflict, _ = allocateMaster(a, lbs[1], append(lbs, explicit))
+	if vrid == 3 || conflict == nil || conflict.key != "default/explicit" {
+		t.Errorf("allocate b = %v, %v, want a new vrid", vrid, conflict)
+	}
+}
+
+func TestDuplicateVRIDs(t *testing.T) {
+	a := newVRIDAllocator(24)
+	lbs := []*lbapi.LoadBalancer{
+		newVRIDLoadBalancer("old", "10.0.0.10", 1, intPtr(7), nil),
+		newVRIDLoadBalancer("new", "10.0.0.11", 2, intPtr(7), nil),
+		newVRIDLoadBalancer("explicit", "10.0.0.12", 3, nil, intPtr(8)),
+		newVRIDLoadBalancer("allocated", "10.0.0.13", 0, intPtr(8), nil),
+		newVRIDLoadBalancer("other-segment", "10.0.1.10", 0, intPtr(7), nil),
+		newVRIDLoadBalancer("ipv6", "fd00::1", 0, intPtr(7), nil),
+	}
+	losers := a.duplicates(lbs)
+	if len(losers) != 2 {
+		t.Fatalf("got losers %v, want 2", losers)
+	}
+	if losers["default/new"].key != "default/old" {
+		t.Errorf("new should give up vrid to old, got %v", losers["default/new"])
+	}
+	if losers["default/allocated"].key != "default/explicit" {
+		t.Errorf("allocated should give up vrid to explicit, got %v", losers["default/allocated"])
+	}
+}
+
+func TestAllocateSlaveVRIDs(t *testing.T) {
+	a := newVRIDAllocator(24)
+	other := newVRIDLoadBalancer("other", "10.0.1.10", 1, intPtr(1), nil)
+	lb := newVRIDLoadBalancer("lb", "10.0.0.10", 2, intPtr(1), nil)
+	lb.Spec.Providers.Ipvsdr.Slaves = []lbapi.KeepalivedProvider{
+		// the same segment as master
+		{VIPs: []string{"10.0.0.11"}},
+		// the same segment as other
+		{VIPs: []string{"10.0.1.11"}},
+	}
+	lb.Status.ProvidersStatuses.Ipvsdr.Slaves = []lbapi.KeepalivedStatus{{Vrid: intPtr(1)}, {Vrid: intPtr(1)}}
+
+	vrids, conflicts, err := a.allocate(lb, []*lbapi.LoadBalancer{other, lb})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(vrids) != 3 || vrids[0] != 1 || vrids[1] != 2 || vrids[2] != 2 {
+		t.Errorf("got vrids %v, want [1 2 2]", vrids)
+	}
+	if conflicts[0] != nil || conflicts[1] == nil || conflicts[1].key != "default/lb" ||
+		conflicts[2] == nil || conflicts[2].key != "default/other" {
+		t.Errorf("unexpected conflicts %v", conflicts)
+	}
+
+	a.release("default/lb")
+	if len(a.assigned) != 0 {
+		t.Errorf("groups are not released: %v", a.assigned)
+	}
+}
+
+func TestAllocateDualStackVRID(t *testing.T) {
+	a := newVRIDAllocator(24)
+	// the ipv6 segment is shared with a dual-stack LoadBalancer
+	ipv6 := newVRIDLoadBalancer("ipv6", "fd00::10", 1, intPtr(1), nil)
+	dual := newVRIDLoadBalancer("dual", "10.0.0.10", 2, intPtr(1), nil)
+	dual.Spec.Providers.Ipvsdr.VIPs = []string{"fd00::11"}
+
+	vrids, conflicts, err := a.allocate(dual, []*lbapi.LoadBalancer{ipv6, dual})
+	if err != nil || vrids[0] != 2 || conflicts[0] == nil || conflicts[0].key != "default/ipv6" {
+		t.Errorf("allocate dual = %v, %v, %v, want 2 conflicting with ipv6", vrids, conflicts, err)
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+const (
+	requestTimeout    = 10 * time.Second
+	defaultDomainName = "Default"
+
+	// service types of octavia and neutron in keystone catalog
+	loadBalancerService = "load-balancer"
+	networkService      = "network"
+)
+
+// apiError is the error responded by openstack api
+type apiError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%v %v responded %v: %v", e.Method, e.URL, e.StatusCode, strings.TrimSpace(e.Body))
+}
+
+// isNotFound returns true if err means the resource does not exist
+func isNotFound(err error) bool {
+	e, ok := err.(*apiError)
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+// credentials are the keystone v3 password credentials scoped to a project
+type credentials struct {
+	AuthURL    string
+	Username   string
+	Password   string
+	ProjectID  string
+	DomainName string
+}
+
+// credentialsFromSecret reads credentials from the data of Secret
+func credentialsFromSecret(data map[string][]byte) (credentials, error) {
+	creds := credentials{
+		AuthURL:    string(data[lbapi.OpenStackAuthURLKey]),
+		Username:   string(data[lbapi.OpenStackUsernameKey]),
+		Password:   string(data[lbapi.OpenStackPasswordKey]),
+		ProjectID:  string(data[lbapi.OpenStackProjectIDKey]),
+		DomainName: string(data[lbapi.OpenStackDomainNameKey]),
+	}
+	if creds.DomainName == "" {
+		creds.DomainName = defaultDomainName
+	}
+	if creds.AuthURL == "" || creds.Username == "" || creds.Password == "" || creds.ProjectID == "" {
+		return creds, fmt.Errorf("%v, %v, %v and %v are required", lbapi.OpenStackAuthURLKey,
+			lbapi.OpenStackUsernameKey, lbapi.OpenStackPasswordKey, lbapi.OpenStackProjectIDKey)
+	}
+	return creds, nil
+}
+
+// client calls octavia and neutron with a keystone token
+type client struct {
+	client *http.Client
+	token  string
+	// octavia and neutron are the endpoints of services
+	octavia string
+	neutron string
+
+	// pollInterval and activeTimeout control waiting for load balancer to be active
+	pollInterval  time.Duration
+	activeTimeout time.Duration
+}
+
+// newClient authenticates with keystone and finds the endpoints of octavia and
+// neutron in region from catalog, empty region means any region
+func newClient(creds credentials, region string, activeTimeout time.Duration) (*client, error) {
+	c := &client{
+		client:        &http.Client{Timeout: requestTimeout},
+		pollInterval:  2 * time.Second,
+		activeTimeout: activeTimeout,
+	}
+
+	auth := map[string]interface{}{
+		"auth": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"methods": []string{"password"},
+				"password": map[string]interface{}{
+					"user": map[string]interface{}{
+						"name":     creds.Username,
+						"password": creds.Password,
+						"domain":   map[string]string{"name": creds.DomainName},
+					},
+				},
+			},
+			"scope": map[string]interface{}{
+				"project": map[string]string{"id": creds.ProjectID},
+			},
+		},
+	}
+	resp := struct {
+		Token struct {
+			Catalog []struct {
+				Type      string `json:"type"`
+				Endpoints []struct {
+					Interface string `json:"interface"`
+					Region    string `json:"region"`
+					URL       string `json:"url"`
+				} `json:"endpoints"`
+			} `json:"catalog"`
+		} `json:"token"`
+	}{}
+	header, err := c.request(http.MethodPost, strings.TrimSuffix(creds.AuthURL, "/")+"/auth/tokens", auth, &resp)
+	if err != nil {
+		return nil, err
+	}
+	c.token = header.Get("X-Subject-Token")
+	if c.token == "" {
+		return nil, fmt.Errorf("keystone responded no token")
+	}
+
+	for _, service := range resp.Token.Catalog {
+		for _, ep := range service.Endpoints {
+			if ep.Interface != "public" || (region != "" && ep.Region != region) {
+				continue
+			}
+			url := strings.TrimSuffix(ep.URL, "/")
+			switch {
+			case service.Type == loadBalancerService && c.octavia == "":
+				c.octavia = url
+			case service.Type == networkService && c.neutron == "":
+				c.neutron = url
+			}
+		}
+	}
+	if c.octavia == "" || c.neutron == "" {
+		return nil, fmt.Errorf("no public endpoint of %v and %v in region %q", loadBalancerService, networkService, region)
+	}
+	return c, nil
+}
+
+// request sends in as json and decodes the response into out, it returns the response header
+func (c *client) request(method, url string, in, out interface{}) (http.Header, error) {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("X-Auth-Token", c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := ioutil.ReadAll(resp.Body)
+		return nil, &apiError{StatusCode: resp.StatusCode, Method: method, URL: url, Body: string(data)}
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return resp.Header, nil
+	}
+	return resp.Header, json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,326 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	log "k8s.io/klog"
+)
+
+// listenerSpec is a listener of load balancer, the port of members is the same as the listener port
+type listenerSpec struct {
+	Protocol string
+	Port     int
+}
+
+func (l listenerSpec) String() string {
+	return fmt.Sprintf("%s/%d", l.Protocol, l.Port)
+}
+
+// octaviaState is the state of load balancer after sync
+type octaviaState struct {
+	loadBalancer *octaviaLoadBalancer
+	floatingIP   *floatingIP
+	// floatingIPID is the id of floating ip allocated by provider
+	floatingIPID string
+	members      []string
+}
+
+// loadBalancerName returns the name of octavia load balancer of lb
+func loadBalancerName(lb *lbapi.LoadBalancer) string {
+	if lb.Spec.Providers.OpenStack.Name != "" {
+		return lb.Spec.Providers.OpenStack.Name
+	}
+	return lb.Namespace + "-" + lb.Name
+}
+
+// desiredListeners returns the listeners of http, https port and streams of lb's proxy
+func desiredListeners(lb *lbapi.LoadBalancer) []listenerSpec {
+	listeners := make([]listenerSpec, 0)
+	for _, l := range lbutil.ProxyListeners(lb) {
+		listeners = append(listeners, listenerSpec{Protocol: string(l.Protocol), Port: l.Port})
+	}
+	return listeners
+}
+
+// ensureLoadBalancer creates the octavia load balancer of lb if necessary, and syncs
+// its listeners, pools, members and floating ip. It returns the state of load balancer
+// and whether it is created.
+func ensureLoadBalancer(c *client, lb *lbapi.LoadBalancer, addresses []string) (*octaviaState, bool, error) {
+	spec := lb.Spec.Providers.OpenStack
+	status := lb.Status.ProvidersStatuses.OpenStack
+	if status == nil {
+		status = &lbapi.OpenStackProviderStatus{}
+	}
+	name := loadBalancerName(lb)
+
+	var olb *octaviaLoadBalancer
+	var err error
+	if status.LoadBalancerID != "" {
+		if olb, err = c.getLoadBalancer(status.LoadBalancerID); err != nil {
+			return nil, false, err
+		}
+	}
+	if olb == nil {
+		// load balancer may be created before status is saved
+		if olb, err = c.findLoadBalancer(name); err != nil {
+			return nil, false, err
+		}
+	}
+	created := false
+	if olb == nil {
+		olb, err = c.createLoadBalancer(name, spec.SubnetID, spec.VIPAddress, spec.FlavorID)
+		if err != nil {
+			return nil, false, err
+		}
+		log.Infof("Create octavia load balancer %v for lb %v/%v", olb.ID, lb.Namespace, lb.Name)
+		created = true
+	}
+	state := &octaviaState{loadBalancer: olb}
+
+	// changes are only accepted when load balancer is active
+	wait := func() error {
+		fresh, err := c.waitActive(olb.ID)
+		if fresh != nil {
+			state.loadBalancer = fresh
+		}
+		return err
+	}
+	if err := wait(); err != nil {
+		return state, created, err
+	}
+
+	listeners, err := c.listeners(olb.ID)
+	if err != nil {
+		return state, created, err
+	}
+	desired := desiredListeners(lb)
+	wanted := sets.NewString()
+	for _, l := range desired {
+		wanted.Insert(l.String())
+	}
+	existing := make(map[string]octaviaListener)
+	for _, l := range listeners {
+		key := listenerSpec{Protocol: l.Protocol, Port: l.ProtocolPort}.String()
+		if wanted.Has(key) {
+			existing[key] = l
+			continue
+		}
+		log.Infof("Delete listener %v of octavia load balancer %v", key, name)
+		if l.DefaultPoolID != "" {
+			if err := c.deletePool(l.DefaultPoolID); err != nil {
+				return state, created, err
+			}
+			if err := wait(); err != nil {
+				return state, created, err
+			}
+		}
+		if err := c.deleteListener(l.ID); err != nil {
+			return state, created, err
+		}
+		if err := wait(); err != nil {
+			return state, created, err
+		}
+	}
+
+	for _, ls := range desired {
+		resource := fmt.Sprintf("%s-%s-%d", name, strings.ToLower(ls.Protocol), ls.Port)
+		l, ok := existing[ls.String()]
+		if !ok {
+			log.Infof("Create listener %v of octavia load balancer %v", ls, name)
+			nl, err := c.createListener(olb.ID, resource, ls.Protocol, ls.Port)
+			if err != nil {
+				return state, created, err
+			}
+			l = *nl
+			if err := wait(); err != nil {
+				return state, created, err
+			}
+		}
+		poolID := l.DefaultPoolID
+		if poolID == "" {
+			if poolID, err = c.createPool(l.ID, resource, ls.Protocol); err != nil {
+				return state, created, err
+			}
+			if err := wait(); err != nil {
+				return state, created, err
+			}
+		}
+		if err := syncMembers(c, poolID, ls.Port, addresses, memberSubnetID(lb), wait); err != nil {
+			return state, created, err
+		}
+	}
+	state.members = addresses
+
+	if err := syncFloatingIP(c, lb, state); err != nil {
+		return state, created, err
+	}
+	return state, created, nil
+}
+
+func memberSubnetID(lb *lbapi.LoadBalancer) string {
+	if lb.Spec.Providers.OpenStack.MemberSubnetID != "" {
+		return lb.Spec.Providers.OpenStack.MemberSubnetID
+	}
+	return lb.Spec.Providers.OpenStack.SubnetID
+}
+
+// syncMembers makes addresses the members of pool, wait is called after every change
+func syncMembers(c *client, poolID string, port int, addresses []string, subnetID string, wait func() error) error {
+	members, err := c.members(poolID)
+	if err != nil {
+		return err
+	}
+	want := sets.NewString(addresses...)
+	have := sets.NewString()
+	for _, m := range members {
+		if want.Has(m.Address) && m.ProtocolPort == port {
+			have.Insert(m.Address)
+			continue
+		}
+		log.Infof("Delete member %v:%v of pool %v", m.Address, m.ProtocolPort, poolID)
+		if err := c.deleteMember(poolID, m.ID); err != nil {
+			return err
+		}
+		if err := wait(); err != nil {
+			return err
+		}
+	}
+	for _, address := range want.Difference(have).List() {
+		log.Infof("Create member %v:%v of pool %v", address, port, poolID)
+		if err := c.createMember(poolID, address, port, subnetID); err != nil {
+			return err
+		}
+		if err := wait(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncFloatingIP associates a floating ip with vip if floating network is set,
+// or releases the floating ip allocated by provider
+func syncFloatingIP(c *client, lb *lbapi.LoadBalancer, state *octaviaState) error {
+	spec := lb.Spec.Providers.OpenStack
+	allocated := ""
+	if status := lb.Status.ProvidersStatuses.OpenStack; status != nil {
+		allocated = status.FloatingIPID
+	}
+
+	if spec.FloatingNetworkID == "" {
+		if allocated != "" {
+			log.Infof("Release floating ip %v of lb %v/%v", allocated, lb.Namespace, lb.Name)
+			return c.deleteFloatingIP(allocated)
+		}
+		return nil
+	}
+
+	fips, err := c.floatingIPs(state.loadBalancer.VIPPortID)
+	if err != nil {
+		return err
+	}
+	if len(fips) > 0 {
+		state.floatingIP = &fips[0]
+		if fips[0].ID == allocated {
+			state.floatingIPID = allocated
+		}
+		return nil
+	}
+	description := fmt.Sprintf("vip of loadbalancer %v/%v", lb.Namespace, lb.Name)
+	fip, err := c.createFloatingIP(spec.FloatingNetworkID, state.loadBalancer.VIPPortID, description)
+	if err != nil {
+		return err
+	}
+	log.Infof("Associate floating ip %v with lb %v/%v", fip.FloatingIPAddress, lb.Namespace, lb.Name)
+	state.floatingIP = fip
+	state.floatingIPID = fip.ID
+	return nil
+}
+
+// deleteLoadBalancer deletes the octavia load balancer of lb and the floating ip
+// allocated by provider
+func deleteLoadBalancer(c *client, lb *lbapi.LoadBalancer) error {
+	var olb *octaviaLoadBalancer
+	var err error
+	status := lb.Status.ProvidersStatuses.OpenStack
+	if status != nil && status.LoadBalancerID != "" {
+		olb, err = c.getLoadBalancer(status.LoadBalancerID)
+	} else {
+		olb, err = c.findLoadBalancer(loadBalancerName(lb))
+	}
+	if err != nil {
+		return err
+	}
+
+	if status != nil && status.FloatingIPID != "" {
+		if err := c.deleteFloatingIP(status.FloatingIPID); err != nil {
+			return err
+		}
+	}
+	if olb == nil {
+		return nil
+	}
+	log.Infof("Delete octavia load balancer %v of lb %v/%v", olb.ID, lb.Namespace, lb.Name)
+	return c.deleteLoadBalancer(olb.ID)
+}
+
+// computeStatus computes the status of load balancer, the load balancer in old
+// status is kept if state is nil, which means it can't be got
+func computeStatus(old *lbapi.OpenStackProviderStatus, state *octaviaState, syncErr error) *lbapi.OpenStackProviderStatus {
+	status := &lbapi.OpenStackProviderStatus{}
+	if state != nil {
+		olb := state.loadBalancer
+		status.LoadBalancerID = olb.ID
+		status.VIPAddress = olb.VIPAddress
+		status.ProvisioningStatus = olb.ProvisioningStatus
+		status.OperatingStatus = olb.OperatingStatus
+		if state.floatingIP != nil {
+			status.FloatingIP = state.floatingIP.FloatingIPAddress
+		}
+		status.FloatingIPID = state.floatingIPID
+		status.Members = state.members
+	} else if old != nil {
+		status = old.DeepCopy()
+		status.Message = ""
+	}
+	if len(status.Members) == 0 {
+		status.Members = nil
+	}
+
+	switch {
+	case syncErr != nil:
+		status.Phase = lbapi.OpenStackErrorPhase
+		status.Message = syncErr.Error()
+	case state == nil:
+		status.Phase = lbapi.OpenStackProgressingPhase
+	case status.ProvisioningStatus == provisioningActive:
+		status.Phase = lbapi.OpenStackRunningPhase
+	case status.ProvisioningStatus == provisioningError:
+		status.Phase = lbapi.OpenStackErrorPhase
+		status.Message = fmt.Sprintf("load balancer is in %v status", provisioningError)
+	default:
+		status.Phase = lbapi.OpenStackProgressingPhase
+		status.Message = fmt.Sprintf("load balancer is %v", status.ProvisioningStatus)
+	}
+	return status
+}
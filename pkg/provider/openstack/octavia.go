@@ -0,0 +1,295 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	provisioningActive = "ACTIVE"
+	provisioningError  = "ERROR"
+)
+
+type octaviaLoadBalancer struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	VIPAddress         string `json:"vip_address"`
+	VIPPortID          string `json:"vip_port_id"`
+	ProvisioningStatus string `json:"provisioning_status"`
+	OperatingStatus    string `json:"operating_status"`
+}
+
+type octaviaListener struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Protocol      string `json:"protocol"`
+	ProtocolPort  int    `json:"protocol_port"`
+	DefaultPoolID string `json:"default_pool_id"`
+}
+
+type octaviaMember struct {
+	ID           string `json:"id"`
+	Address      string `json:"address"`
+	ProtocolPort int    `json:"protocol_port"`
+}
+
+type floatingIP struct {
+	ID                string `json:"id"`
+	FloatingIPAddress string `json:"floating_ip_address"`
+	PortID            string `json:"port_id"`
+}
+
+func (c *client) lbaas(format string, args ...interface{}) string {
+	return c.octavia + "/v2/lbaas/" + fmt.Sprintf(format, args...)
+}
+
+// getLoadBalancer returns the load balancer with id, or nil if not found
+func (c *client) getLoadBalancer(id string) (*octaviaLoadBalancer, error) {
+	resp := struct {
+		LoadBalancer octaviaLoadBalancer `json:"loadbalancer"`
+	}{}
+	_, err := c.request(http.MethodGet, c.lbaas("loadbalancers/%s", id), nil, &resp)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &resp.LoadBalancer, nil
+}
+
+// findLoadBalancer returns the load balancer with name, or nil if not found
+func (c *client) findLoadBalancer(name string) (*octaviaLoadBalancer, error) {
+	resp := struct {
+		LoadBalancers []octaviaLoadBalancer `json:"loadbalancers"`
+	}{}
+	_, err := c.request(http.MethodGet, c.lbaas("loadbalancers?name=%s", url.QueryEscape(name)), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	for i := range resp.LoadBalancers {
+		if resp.LoadBalancers[i].Name == name {
+			return &resp.LoadBalancers[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// createLoadBalancer creates a load balancer with vip in subnet
+func (c *client) createLoadBalancer(name, subnetID, vipAddress, flavorID string) (*octaviaLoadBalancer, error) {
+	lb := map[string]string{
+		"name":          name,
+		"vip_subnet_id": subnetID,
+	}
+	if vipAddress != "" {
+		lb["vip_address"] = vipAddress
+	}
+	if flavorID != "" {
+		lb["flavor_id"] = flavorID
+	}
+	resp := struct {
+		LoadBalancer octaviaLoadBalancer `json:"loadbalancer"`
+	}{}
+	_, err := c.request(http.MethodPost, c.lbaas("loadbalancers"), map[string]interface{}{"loadbalancer": lb}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.LoadBalancer, nil
+}
+
+// deleteLoadBalancer deletes the load balancer with its listeners, pools and members
+func (c *client) deleteLoadBalancer(id string) error {
+	_, err := c.request(http.MethodDelete, c.lbaas("loadbalancers/%s?cascade=true", id), nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// waitActive waits for the load balancer to leave the PENDING_* statuses, octavia
+// rejects changes of listeners, pools and members of it until then
+func (c *client) waitActive(id string) (*octaviaLoadBalancer, error) {
+	var lb *octaviaLoadBalancer
+	err := wait.PollImmediate(c.pollInterval, c.activeTimeout, func() (bool, error) {
+		var err error
+		lb, err = c.getLoadBalancer(id)
+		if err != nil {
+			return false, err
+		}
+		if lb == nil {
+			return false, fmt.Errorf("load balancer %v is gone", id)
+		}
+		switch lb.ProvisioningStatus {
+		case provisioningActive:
+			return true, nil
+		case provisioningError:
+			return false, fmt.Errorf("load balancer %v is in %v status", id, provisioningError)
+		}
+		return false, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return lb, fmt.Errorf("timed out waiting for load balancer %v to be %v", id, provisioningActive)
+	}
+	return lb, err
+}
+
+// listeners returns the listeners of load balancer
+func (c *client) listeners(lbID string) ([]octaviaListener, error) {
+	resp := struct {
+		Listeners []octaviaListener `json:"listeners"`
+	}{}
+	_, err := c.request(http.MethodGet, c.lbaas("listeners?loadbalancer_id=%s", lbID), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Listeners, nil
+}
+
+// createListener creates a listener of load balancer
+func (c *client) createListener(lbID, name, protocol string, port int) (*octaviaListener, error) {
+	resp := struct {
+		Listener octaviaListener `json:"listener"`
+	}{}
+	_, err := c.request(http.MethodPost, c.lbaas("listeners"), map[string]interface{}{
+		"listener": map[string]interface{}{
+			"loadbalancer_id": lbID,
+			"name":            name,
+			"protocol":        protocol,
+			"protocol_port":   port,
+		},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Listener, nil
+}
+
+// deleteListener deletes a listener
+func (c *client) deleteListener(id string) error {
+	_, err := c.request(http.MethodDelete, c.lbaas("listeners/%s", id), nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// createPool creates the default pool of listener, it returns the id of pool
+func (c *client) createPool(listenerID, name, protocol string) (string, error) {
+	resp := struct {
+		Pool struct {
+			ID string `json:"id"`
+		} `json:"pool"`
+	}{}
+	_, err := c.request(http.MethodPost, c.lbaas("pools"), map[string]interface{}{
+		"pool": map[string]interface{}{
+			"listener_id":  listenerID,
+			"name":         name,
+			"protocol":     protocol,
+			"lb_algorithm": "ROUND_ROBIN",
+		},
+	}, &resp)
+	if err != nil {
+		return "", err
+	}
+	return resp.Pool.ID, nil
+}
+
+// deletePool deletes a pool with its members
+func (c *client) deletePool(id string) error {
+	_, err := c.request(http.MethodDelete, c.lbaas("pools/%s", id), nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// members returns the members of pool
+func (c *client) members(poolID string) ([]octaviaMember, error) {
+	resp := struct {
+		Members []octaviaMember `json:"members"`
+	}{}
+	_, err := c.request(http.MethodGet, c.lbaas("pools/%s/members", poolID), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Members, nil
+}
+
+// createMember adds address to pool, subnetID is the subnet of address
+func (c *client) createMember(poolID, address string, port int, subnetID string) error {
+	_, err := c.request(http.MethodPost, c.lbaas("pools/%s/members", poolID), map[string]interface{}{
+		"member": map[string]interface{}{
+			"address":       address,
+			"protocol_port": port,
+			"subnet_id":     subnetID,
+		},
+	}, nil)
+	return err
+}
+
+// deleteMember removes a member from pool
+func (c *client) deleteMember(poolID, id string) error {
+	_, err := c.request(http.MethodDelete, c.lbaas("pools/%s/members/%s", poolID, id), nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// floatingIPs returns the floating ips associated with port
+func (c *client) floatingIPs(portID string) ([]floatingIP, error) {
+	resp := struct {
+		FloatingIPs []floatingIP `json:"floatingips"`
+	}{}
+	_, err := c.request(http.MethodGet, c.neutron+"/v2.0/floatingips?port_id="+url.QueryEscape(portID), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.FloatingIPs, nil
+}
+
+// createFloatingIP allocates a floating ip from network and associates it with port
+func (c *client) createFloatingIP(networkID, portID, description string) (*floatingIP, error) {
+	resp := struct {
+		FloatingIP floatingIP `json:"floatingip"`
+	}{}
+	_, err := c.request(http.MethodPost, c.neutron+"/v2.0/floatingips", map[string]interface{}{
+		"floatingip": map[string]string{
+			"floating_network_id": networkID,
+			"port_id":             portID,
+			"description":         description,
+		},
+	}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.FloatingIP, nil
+}
+
+// deleteFloatingIP releases a floating ip
+func (c *client) deleteFloatingIP(id string) error {
+	_, err := c.request(http.MethodDelete, c.neutron+"/v2.0/floatingips/"+id, nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
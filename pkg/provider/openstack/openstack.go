@@ -0,0 +1,151 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog"
+)
+
+const (
+	providerName = "openstack"
+)
+
+type openstack struct {
+	// NodeProvider syncs the octavia load balancer with the nodes of lb, and resyncs
+	// it until it is running because load balancers take minutes to be provisioned
+	*lbutil.NodeProvider
+	initialized bool
+
+	activeTimeout time.Duration
+
+	client   kubernetes.Interface
+	lbLister lblisters.LoadBalancerLister
+}
+
+// New creates a new openstack provider plugin
+func New() plugin.Interface {
+	return &openstack{}
+}
+
+func (f *openstack) Init(cfg config.Configuration, sif informers.SharedInformerFactory) {
+	if f.initialized {
+		return
+	}
+	f.initialized = true
+
+	log.Info("Initialize the openstack provider")
+
+	// set config
+	f.activeTimeout = cfg.Providers.OpenStack.ActiveTimeout
+	f.client = cfg.Client
+	f.lbLister = sif.Custom().Loadbalance().V1alpha2().LoadBalancers().Lister()
+	f.NodeProvider = lbutil.NewNodeProvider(providerName, sif, cfg.Providers.OpenStack.ResyncPeriod, f)
+}
+
+// Enabled checks whether lb uses openstack provider
+func (f *openstack) Enabled(lb *lbapi.LoadBalancer) bool {
+	return lb.Spec.Providers.OpenStack != nil
+}
+
+// Pending checks whether the octavia load balancer of lb is not running
+func (f *openstack) Pending(lb *lbapi.LoadBalancer) bool {
+	status := lb.Status.ProvidersStatuses.OpenStack
+	return status == nil || status.Phase != lbapi.OpenStackRunningPhase
+}
+
+// Release deletes the status of lb, octavia load balancer is left behind
+func (f *openstack) Release(lb *lbapi.LoadBalancer) error {
+	if status := lb.Status.ProvidersStatuses.OpenStack; status != nil && status.LoadBalancerID != "" {
+		// the credentials are gone with the spec
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "OctaviaOrphaned", "Octavia load balancer %v is not deleted, openstack provider is removed from spec", status.LoadBalancerID)
+	}
+	return f.syncStatus(lb, nil)
+}
+
+// Sync syncs the octavia load balancer of lb with the nodes of lb
+func (f *openstack) Sync(lb *lbapi.LoadBalancer, nodes []*v1.Node) error {
+	state, syncErr := f.syncOctavia(lb, nodes)
+	if err := f.syncStatus(lb, computeStatus(lb.Status.ProvidersStatuses.OpenStack, state, syncErr)); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+func (f *openstack) syncOctavia(lb *lbapi.LoadBalancer, nodes []*v1.Node) (*octaviaState, error) {
+	c, err := f.newClient(lb)
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "InvalidCredentials", "%v", err)
+		return nil, err
+	}
+	state, created, err := ensureLoadBalancer(c, lb, lbutil.NodeInternalIPs(nodes))
+	if created {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeNormal, "OctaviaCreated", "Octavia load balancer %v is created", state.loadBalancer.ID)
+	}
+	if err != nil {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "OctaviaSyncFailed", "Sync octavia load balancer error: %v", err)
+		return state, err
+	}
+	return state, nil
+}
+
+// syncStatus updates the openstack status of lb, status is nil to delete it
+func (f *openstack) syncStatus(lb *lbapi.LoadBalancer, status *lbapi.OpenStackProviderStatus) error {
+	return lbutil.SyncProviderStatus(f.client, f.lbLister, lb, providerName, lb.Status.ProvidersStatuses.OpenStack, status, func(lb *lbapi.LoadBalancer) {
+		lb.Status.ProvidersStatuses.OpenStack = status
+	})
+}
+
+// newClient authenticates with the credentials Secret of lb
+func (f *openstack) newClient(lb *lbapi.LoadBalancer) (*client, error) {
+	spec := lb.Spec.Providers.OpenStack
+	secret, err := f.client.Native().CoreV1().Secrets(lb.Namespace).Get(spec.CredentialsSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get credentials secret %v error: %v", spec.CredentialsSecret, err)
+	}
+	creds, err := credentialsFromSecret(secret.Data)
+	if err != nil {
+		return nil, fmt.Errorf("credentials secret %v is invalid: %v", spec.CredentialsSecret, err)
+	}
+	return newClient(creds, spec.Region, f.activeTimeout)
+}
+
+// Cleanup deletes the octavia load balancer of a deleted lb
+func (f *openstack) Cleanup(lb *lbapi.LoadBalancer) error {
+	c, err := f.newClient(lb)
+	if err != nil {
+		// retried by the queue, the load balancer is left behind if credentials never come back
+		if status := lb.Status.ProvidersStatuses.OpenStack; status != nil && status.LoadBalancerID != "" {
+			lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "OctaviaOrphaned", "Octavia load balancer %v is not deleted: %v", status.LoadBalancerID, err)
+		}
+		log.Warningf("Delete octavia load balancer of lb %v/%v error: %v", lb.Namespace, lb.Name, err)
+		return err
+	}
+	return deleteLoadBalancer(c, lb)
+}
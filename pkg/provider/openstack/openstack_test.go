@@ -0,0 +1,425 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/util/lb/lbtest"
+)
+
+const testToken = "token"
+
+type mockLoadBalancer struct {
+	octaviaLoadBalancer
+	// pending is the number of gets before load balancer is active again
+	pending int
+}
+
+type mockListener struct {
+	octaviaListener
+	loadBalancerID string
+}
+
+type mockPool struct {
+	listenerID string
+	members    map[string]octaviaMember
+}
+
+// mockOpenStack is a stand-in of keystone, octavia and neutron keeping resources in memory,
+// load balancer is pending for a while after every change like octavia does
+type mockOpenStack struct {
+	lbtest.Mock
+	url           string
+	loadBalancers map[string]*mockLoadBalancer
+	listeners     map[string]*mockListener
+	pools         map[string]*mockPool
+	floatingIPs   map[string]*floatingIP
+}
+
+func newMockOpenStack() *mockOpenStack {
+	return &mockOpenStack{
+		loadBalancers: make(map[string]*mockLoadBalancer),
+		listeners:     make(map[string]*mockListener),
+		pools:         make(map[string]*mockPool),
+		floatingIPs:   make(map[string]*floatingIP),
+	}
+}
+
+func (m *mockOpenStack) id(kind string) string {
+	return fmt.Sprintf("%s-%d", kind, m.NextID())
+}
+
+// change marks load balancer pending, it fails if load balancer is immutable now
+func (m *mockOpenStack) change(w http.ResponseWriter, lbID string) bool {
+	lb := m.loadBalancers[lbID]
+	if lb == nil {
+		http.Error(w, "load balancer not found", http.StatusNotFound)
+		return false
+	}
+	if lb.ProvisioningStatus != provisioningActive {
+		http.Error(w, "load balancer is immutable", http.StatusConflict)
+		return false
+	}
+	lb.ProvisioningStatus = "PENDING_UPDATE"
+	lb.pending = 1
+	return true
+}
+
+func (m *mockOpenStack) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.Lock()
+	defer m.Unlock()
+
+	if r.URL.Path == "/v3/auth/tokens" {
+		w.Header().Set("X-Subject-Token", testToken)
+		lbtest.RespondJSON(w, map[string]interface{}{"token": map[string]interface{}{"catalog": []interface{}{
+			map[string]interface{}{"type": loadBalancerService, "endpoints": []interface{}{
+				map[string]string{"interface": "public", "region": "RegionOne", "url": m.url},
+			}},
+			map[string]interface{}{"type": networkService, "endpoints": []interface{}{
+				map[string]string{"interface": "internal", "region": "RegionOne", "url": "http://internal"},
+				map[string]string{"interface": "public", "region": "RegionOne", "url": m.url},
+			}},
+		}}})
+		return
+	}
+	if r.Header.Get("X-Auth-Token") != testToken {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	body := map[string]map[string]interface{}{}
+	if r.Method == http.MethodPost {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		m.Record(r.Method + " " + r.URL.Path)
+	} else if r.Method == http.MethodDelete {
+		m.Record(r.Method + " " + r.URL.Path)
+	}
+	query := r.URL.Query()
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if path[0] == "v2.0" {
+		m.serveFloatingIPs(w, r.Method, path[2:], query.Get("port_id"), body["floatingip"])
+		return
+	}
+	path = path[2:]
+
+	switch {
+	case path[0] == "loadbalancers" && len(path) == 1 && r.Method == http.MethodGet:
+		lbs := []octaviaLoadBalancer{}
+		for _, lb := range m.loadBalancers {
+			if lb.Name == query.Get("name") {
+				lbs = append(lbs, lb.octaviaLoadBalancer)
+			}
+		}
+		lbtest.RespondJSON(w, map[string]interface{}{"loadbalancers": lbs})
+	case path[0] == "loadbalancers" && len(path) == 1:
+		lb := &mockLoadBalancer{pending: 2}
+		lb.ID = m.id("lb")
+		lb.Name = body["loadbalancer"]["name"].(string)
+		lb.VIPAddress = "192.168.0.100"
+		lb.VIPPortID = "port-" + lb.ID
+		lb.ProvisioningStatus = "PENDING_CREATE"
+		lb.OperatingStatus = "OFFLINE"
+		m.loadBalancers[lb.ID] = lb
+		lbtest.RespondJSON(w, map[string]interface{}{"loadbalancer": lb.octaviaLoadBalancer})
+	case path[0] == "loadbalancers":
+		lb := m.loadBalancers[path[1]]
+		if lb == nil {
+			http.Error(w, "load balancer not found", http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodDelete {
+			if lb.ProvisioningStatus != provisioningActive || query.Get("cascade") != "true" {
+				http.Error(w, "load balancer is immutable", http.StatusConflict)
+				return
+			}
+			for id, l := range m.listeners {
+				if l.loadBalancerID == lb.ID {
+					delete(m.listeners, id)
+				}
+			}
+			for id, p := range m.pools {
+				if m.listeners[p.listenerID] == nil {
+					delete(m.pools, id)
+				}
+			}
+			delete(m.loadBalancers, lb.ID)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if lb.pending > 0 {
+			if lb.pending--; lb.pending == 0 {
+				lb.ProvisioningStatus = provisioningActive
+				lb.OperatingStatus = "ONLINE"
+			}
+		}
+		lbtest.RespondJSON(w, map[string]interface{}{"loadbalancer": lb.octaviaLoadBalancer})
+	case path[0] == "listeners" && r.Method == http.MethodGet:
+		listeners := []octaviaListener{}
+		for _, l := range m.listeners {
+			if l.loadBalancerID == query.Get("loadbalancer_id") {
+				listeners = append(listeners, l.octaviaListener)
+			}
+		}
+		lbtest.RespondJSON(w, map[string]interface{}{"listeners": listeners})
+	case path[0] == "listeners" && r.Method == http.MethodPost:
+		spec := body["listener"]
+		l := &mockListener{loadBalancerID: spec["loadbalancer_id"].(string)}
+		if !m.change(w, l.loadBalancerID) {
+			return
+		}
+		l.ID = m.id("listener")
+		l.Name = spec["name"].(string)
+		l.Protocol = spec["protocol"].(string)
+		l.ProtocolPort = int(spec["protocol_port"].(float64))
+		m.listeners[l.ID] = l
+		lbtest.RespondJSON(w, map[string]interface{}{"listener": l.octaviaListener})
+	case path[0] == "listeners":
+		l := m.listeners[path[1]]
+		if l == nil {
+			http.Error(w, "listener not found", http.StatusNotFound)
+			return
+		}
+		if l.DefaultPoolID != "" {
+			http.Error(w, "listener has default pool", http.StatusConflict)
+			return
+		}
+		if !m.change(w, l.loadBalancerID) {
+			return
+		}
+		delete(m.listeners, l.ID)
+		w.WriteHeader(http.StatusNoContent)
+	case path[0] == "pools" && len(path) == 1:
+		listenerID := body["pool"]["listener_id"].(string)
+		l := m.listeners[listenerID]
+		if !m.change(w, l.loadBalancerID) {
+			return
+		}
+		id := m.id("pool")
+		l.DefaultPoolID = id
+		m.pools[id] = &mockPool{listenerID: listenerID, members: make(map[string]octaviaMember)}
+		lbtest.RespondJSON(w, map[string]interface{}{"pool": map[string]string{"id": id}})
+	default:
+		pool := m.pools[path[1]]
+		if pool == nil {
+			http.Error(w, "pool not found", http.StatusNotFound)
+			return
+		}
+		l := m.listeners[pool.listenerID]
+		switch {
+		case len(path) == 2:
+			if !m.change(w, l.loadBalancerID) {
+				return
+			}
+			l.DefaultPoolID = ""
+			delete(m.pools, path[1])
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet:
+			members := []octaviaMember{}
+			for _, member := range pool.members {
+				members = append(members, member)
+			}
+			lbtest.RespondJSON(w, map[string]interface{}{"members": members})
+		case r.Method == http.MethodPost:
+			if !m.change(w, l.loadBalancerID) {
+				return
+			}
+			member := octaviaMember{
+				ID:           m.id("member"),
+				Address:      body["member"]["address"].(string),
+				ProtocolPort: int(body["member"]["protocol_port"].(float64)),
+			}
+			pool.members[member.ID] = member
+			lbtest.RespondJSON(w, map[string]interface{}{"member": member})
+		default:
+			if !m.change(w, l.loadBalancerID) {
+				return
+			}
+			delete(pool.members, path[3])
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+func (m *mockOpenStack) serveFloatingIPs(w http.ResponseWriter, method string, path []string, portID string, spec map[string]interface{}) {
+	switch {
+	case method == http.MethodGet:
+		fips := []floatingIP{}
+		for _, fip := range m.floatingIPs {
+			if fip.PortID == portID {
+				fips = append(fips, *fip)
+			}
+		}
+		lbtest.RespondJSON(w, map[string]interface{}{"floatingips": fips})
+	case method == http.MethodPost:
+		n := m.NextID()
+		fip := &floatingIP{
+			ID:                fmt.Sprintf("fip-%d", n),
+			FloatingIPAddress: fmt.Sprintf("172.24.4.%d", n),
+			PortID:            spec["port_id"].(string),
+		}
+		m.floatingIPs[fip.ID] = fip
+		lbtest.RespondJSON(w, map[string]interface{}{"floatingip": fip})
+	default:
+		if m.floatingIPs[path[0]] == nil {
+			http.Error(w, "floating ip not found", http.StatusNotFound)
+			return
+		}
+		delete(m.floatingIPs, path[0])
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func newTestClient(t *testing.T, mock *mockOpenStack, server *httptest.Server) *client {
+	mock.url = server.URL
+	creds, err := credentialsFromSecret(map[string][]byte{
+		lbapi.OpenStackAuthURLKey:   []byte(server.URL + "/v3/"),
+		lbapi.OpenStackUsernameKey:  []byte("admin"),
+		lbapi.OpenStackPasswordKey:  []byte("password"),
+		lbapi.OpenStackProjectIDKey: []byte("project"),
+	})
+	if err != nil {
+		t.Fatalf("credentials error: %v", err)
+	}
+	c, err := newClient(creds, "RegionOne", time.Second)
+	if err != nil {
+		t.Fatalf("new client error: %v", err)
+	}
+	c.pollInterval = time.Millisecond
+	return c
+}
+
+func newLoadBalancer() *lbapi.LoadBalancer {
+	lb := lbtest.NewLoadBalancer()
+	lb.Spec.Providers.OpenStack = &lbapi.OpenStackProvider{
+		CredentialsSecret: "openstack",
+		SubnetID:          "subnet",
+		FloatingNetworkID: "public",
+	}
+	return lb
+}
+
+func TestDesiredListeners(t *testing.T) {
+	got := desiredListeners(newLoadBalancer())
+	want := []listenerSpec{
+		{Protocol: "TCP", Port: 80},
+		{Protocol: "TCP", Port: 443},
+		{Protocol: "TCP", Port: 20053},
+		{Protocol: "UDP", Port: 20053},
+		{Protocol: "TCP", Port: 20080},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %v, want %v", got, want)
+	}
+}
+
+func TestEnsureLoadBalancer(t *testing.T) {
+	mock := newMockOpenStack()
+	server := httptest.NewServer(mock)
+	defer server.Close()
+	c := newTestClient(t, mock, server)
+
+	lb := newLoadBalancer()
+	state, created, err := ensureLoadBalancer(c, lb, []string{"10.0.0.1", "10.0.0.2"})
+	if err != nil || !created {
+		t.Fatalf("ensure load balancer got created %v error %v", created, err)
+	}
+	status := computeStatus(nil, state, nil)
+	if status.Phase != lbapi.OpenStackRunningPhase || status.VIPAddress != "192.168.0.100" || status.FloatingIP == "" {
+		t.Errorf("got status %v %v %v, want Running with vip and floating ip", status.Phase, status.VIPAddress, status.FloatingIP)
+	}
+	if status.FloatingIPID == "" || mock.floatingIPs[status.FloatingIPID] == nil {
+		t.Errorf("got floating ip id %v, want the allocated one", status.FloatingIPID)
+	}
+	listeners := []string{}
+	for _, l := range mock.listeners {
+		listeners = append(listeners, listenerSpec{Protocol: l.Protocol, Port: l.ProtocolPort}.String())
+		members := []string{}
+		for _, member := range mock.pools[l.DefaultPoolID].members {
+			members = append(members, fmt.Sprintf("%v:%v", member.Address, member.ProtocolPort))
+		}
+		sort.Strings(members)
+		want := []string{fmt.Sprintf("10.0.0.1:%d", l.ProtocolPort), fmt.Sprintf("10.0.0.2:%d", l.ProtocolPort)}
+		if !reflect.DeepEqual(members, want) {
+			t.Errorf("got members %v of listener %v, want %v", members, l.Name, want)
+		}
+	}
+	sort.Strings(listeners)
+	if want := []string{"TCP/20053", "TCP/20080", "TCP/443", "TCP/80", "UDP/20053"}; !reflect.DeepEqual(listeners, want) {
+		t.Errorf("got listeners %v, want %v", listeners, want)
+	}
+
+	// nothing changes if nothing is changed
+	lb.Status.ProvidersStatuses.OpenStack = status
+	mock.TakeActions()
+	if _, created, err = ensureLoadBalancer(c, lb, []string{"10.0.0.1", "10.0.0.2"}); err != nil || created {
+		t.Fatalf("ensure load balancer again got created %v error %v", created, err)
+	}
+	if actions := mock.TakeActions(); len(actions) != 0 {
+		t.Errorf("unexpected actions %v", actions)
+	}
+
+	// stream and node are removed
+	lb.Spec.Proxy.Streams = lb.Spec.Proxy.Streams[:2]
+	state, _, err = ensureLoadBalancer(c, lb, []string{"10.0.0.2"})
+	if err != nil {
+		t.Fatalf("ensure load balancer error: %v", err)
+	}
+	if len(mock.listeners) != 4 || len(mock.pools) != 4 {
+		t.Errorf("got %v listeners and %v pools, want 4 and 4", len(mock.listeners), len(mock.pools))
+	}
+	for _, pool := range mock.pools {
+		if len(pool.members) != 1 {
+			t.Errorf("got members %v, want only 10.0.0.2", pool.members)
+		}
+	}
+
+	lb.Status.ProvidersStatuses.OpenStack = computeStatus(status, state, nil)
+	if err := deleteLoadBalancer(c, lb); err != nil {
+		t.Fatalf("delete load balancer error: %v", err)
+	}
+	if len(mock.loadBalancers) != 0 || len(mock.listeners) != 0 || len(mock.pools) != 0 || len(mock.floatingIPs) != 0 {
+		t.Errorf("got %v load balancers, %v listeners, %v pools and %v floating ips left",
+			len(mock.loadBalancers), len(mock.listeners), len(mock.pools), len(mock.floatingIPs))
+	}
+}
+
+func TestComputeStatus(t *testing.T) {
+	old := &lbapi.OpenStackProviderStatus{LoadBalancerID: "lb-1", Phase: lbapi.OpenStackRunningPhase}
+	status := computeStatus(old, nil, fmt.Errorf("unauthorized"))
+	if status.Phase != lbapi.OpenStackErrorPhase || status.LoadBalancerID != "lb-1" || status.Message != "unauthorized" {
+		t.Errorf("got status %+v, want Error keeping load balancer id", status)
+	}
+
+	state := &octaviaState{loadBalancer: &octaviaLoadBalancer{ID: "lb-1", ProvisioningStatus: "PENDING_UPDATE"}}
+	if status = computeStatus(old, state, nil); status.Phase != lbapi.OpenStackProgressingPhase {
+		t.Errorf("got phase %v, want Progressing", status.Phase)
+	}
+	state.loadBalancer.ProvisioningStatus = provisioningError
+	if status = computeStatus(old, state, nil); status.Phase != lbapi.OpenStackErrorPhase {
+		t.Errorf("got phase %v, want Error", status.Phase)
+	}
+}
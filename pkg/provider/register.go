@@ -18,15 +18,25 @@ package provider
 
 import (
 	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	"github.com/caicloud/loadbalancer-controller/pkg/provider/aliyun"
+	"github.com/caicloud/loadbalancer-controller/pkg/provider/aws"
 	"github.com/caicloud/loadbalancer-controller/pkg/provider/azure"
+	"github.com/caicloud/loadbalancer-controller/pkg/provider/bgp"
 	"github.com/caicloud/loadbalancer-controller/pkg/provider/external"
+	"github.com/caicloud/loadbalancer-controller/pkg/provider/f5"
 	"github.com/caicloud/loadbalancer-controller/pkg/provider/ipvsdr"
+	"github.com/caicloud/loadbalancer-controller/pkg/provider/openstack"
 )
 
 var localRegistryBuilder = plugin.RegistryBuilder{
+	aliyun.AddToRegistry,
+	aws.AddToRegistry,
 	azure.AddToRegistry,
+	bgp.AddToRegistry,
 	external.AddToRegistry,
+	f5.AddToRegistry,
 	ipvsdr.AddToRegistry,
+	openstack.AddToRegistry,
 }
 
 var AddToRegistry = localRegistryBuilder.AddToRegistry
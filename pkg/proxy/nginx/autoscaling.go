@@ -0,0 +1,163 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	autoscalingv2 "github.com/caicloud/loadbalancer-controller/pkg/apis/autoscaling/v2"
+
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog"
+)
+
+const (
+	// default target CPU utilization if no metric is specified
+	defaultTargetCPUUtilizationPercentage = int32(80)
+)
+
+// autoscalingEnabled returns true if the proxy is scaled by a HorizontalPodAutoscaler,
+// proxy running in host network can't be autoscaled
+func autoscalingEnabled(lb *lbapi.LoadBalancer) bool {
+	return lb.Spec.Proxy.Autoscaling != nil && len(lb.Spec.Nodes.Names) == 0
+}
+
+func autoscalingMinReplicas(spec *lbapi.AutoscalingSpec) int32 {
+	if spec.MinReplicas != nil {
+		return *spec.MinReplicas
+	}
+	return 1
+}
+
+func horizontalPodAutoscalerName(lb *lbapi.LoadBalancer) string {
+	return lb.Name + proxyNameSuffix
+}
+
+func (f *nginx) generateHorizontalPodAutoscaler(lb *lbapi.LoadBalancer, deployment string) *autoscalingv2.HorizontalPodAutoscaler {
+	spec := lb.Spec.Proxy.Autoscaling
+	minReplicas := autoscalingMinReplicas(spec)
+	t := true
+
+	metrics := make([]autoscalingv2beta2.MetricSpec, 0, len(spec.Metrics)+1)
+	targetCPU := spec.TargetCPUUtilizationPercentage
+	if targetCPU == nil && len(spec.Metrics) == 0 {
+		cpu := defaultTargetCPUUtilizationPercentage
+		targetCPU = &cpu
+	}
+	if targetCPU != nil {
+		metrics = append(metrics, autoscalingv2beta2.MetricSpec{
+			Type: autoscalingv2beta2.ResourceMetricSourceType,
+			Resource: &autoscalingv2beta2.ResourceMetricSource{
+				Name: v1.ResourceCPU,
+				Target: autoscalingv2beta2.MetricTarget{
+					Type:               autoscalingv2beta2.UtilizationMetricType,
+					AverageUtilization: targetCPU,
+				},
+			},
+		})
+	}
+	for _, metric := range spec.Metrics {
+		value := metric.TargetAverageValue.DeepCopy()
+		metrics = append(metrics, autoscalingv2beta2.MetricSpec{
+			Type: autoscalingv2beta2.PodsMetricSourceType,
+			Pods: &autoscalingv2beta2.PodsMetricSource{
+				Metric: autoscalingv2beta2.MetricIdentifier{
+					Name: metric.Name,
+				},
+				Target: autoscalingv2beta2.MetricTarget{
+					Type:         autoscalingv2beta2.AverageValueMetricType,
+					AverageValue: &value,
+				},
+			},
+		})
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      horizontalPodAutoscalerName(lb),
+			Namespace: lb.Namespace,
+			Labels:    f.selector(lb),
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         api.ControllerKind.GroupVersion().String(),
+					Kind:               api.ControllerKind.Kind,
+					Name:               lb.Name,
+					UID:                lb.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				},
+			},
+		},
+		Spec: autoscalingv2beta2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2beta2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       deployment,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: spec.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+// ensureHorizontalPodAutoscaler creates or updates the HorizontalPodAutoscaler of the
+// deployment, or deletes it if autoscaling is disabled
+func (f *nginx) ensureHorizontalPodAutoscaler(lb *lbapi.LoadBalancer, deployment string) (*lbapi.AutoscalingStatus, error) {
+	name := horizontalPodAutoscalerName(lb)
+	if !autoscalingEnabled(lb) {
+		return nil, f.deleteHorizontalPodAutoscaler(lb)
+	}
+
+	desired := f.generateHorizontalPodAutoscaler(lb, deployment)
+	hpa, err := f.autoscalingClient.GetHorizontalPodAutoscaler(lb.Namespace, name)
+	if errors.IsNotFound(err) {
+		log.Infof("Create HorizontalPodAutoscaler %v for loadbalancer %v", name, lb.Name)
+		hpa, err = f.autoscalingClient.CreateHorizontalPodAutoscaler(desired)
+	} else if err == nil && (!equality.Semantic.DeepEqual(hpa.Spec, desired.Spec) || !metav1.IsControlledBy(hpa, lb)) {
+		copy := hpa.DeepCopy()
+		copy.Labels = desired.Labels
+		copy.OwnerReferences = desired.OwnerReferences
+		copy.Spec = desired.Spec
+		log.Infof("Update HorizontalPodAutoscaler %v for loadbalancer %v", name, lb.Name)
+		hpa, err = f.autoscalingClient.UpdateHorizontalPodAutoscaler(copy)
+	}
+	if err != nil {
+		log.Errorf("Sync HorizontalPodAutoscaler %v error: %v", name, err)
+		return nil, err
+	}
+
+	return &lbapi.AutoscalingStatus{
+		HorizontalPodAutoscaler: name,
+		CurrentReplicas:         hpa.Status.CurrentReplicas,
+		DesiredReplicas:         hpa.Status.DesiredReplicas,
+		LastScaleTime:           hpa.Status.LastScaleTime,
+	}, nil
+}
+
+func (f *nginx) deleteHorizontalPodAutoscaler(lb *lbapi.LoadBalancer) error {
+	err := f.autoscalingClient.DeleteHorizontalPodAutoscaler(lb.Namespace, horizontalPodAutoscalerName(lb))
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Delete HorizontalPodAutoscaler error: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestGenerateHorizontalPodAutoscaler(t *testing.T) {
+	int32p := func(i int32) *int32 { return &i }
+	lb := &lbapi.LoadBalancer{}
+	lb.Name = "test"
+	lb.Namespace = "default"
+
+	lb.Spec.Proxy.Autoscaling = &lbapi.AutoscalingSpec{MaxReplicas: 5}
+	hpa := (&nginx{}).generateHorizontalPodAutoscaler(lb, "test-proxy-nginx-abcde")
+	if hpa.Spec.ScaleTargetRef.Name != "test-proxy-nginx-abcde" || hpa.Spec.ScaleTargetRef.Kind != "Deployment" {
+		t.Errorf("unexpected scale target %v", hpa.Spec.ScaleTargetRef)
+	}
+	if *hpa.Spec.MinReplicas != 1 || hpa.Spec.MaxReplicas != 5 {
+		t.Errorf("unexpected replicas %v-%v", *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+	}
+	if len(hpa.Spec.Metrics) != 1 || *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != defaultTargetCPUUtilizationPercentage {
+		t.Errorf("expected default cpu metric, got %v", hpa.Spec.Metrics)
+	}
+
+	lb.Spec.Proxy.Autoscaling = &lbapi.AutoscalingSpec{
+		MinReplicas: int32p(2),
+		MaxReplicas: 10,
+		Metrics: []lbapi.AutoscalingMetric{
+			{Name: "nginx_vts_main_connections", TargetAverageValue: resource.MustParse("1k")},
+		},
+	}
+	hpa = (&nginx{}).generateHorizontalPodAutoscaler(lb, "test-proxy-nginx-abcde")
+	if len(hpa.Spec.Metrics) != 1 {
+		t.Fatalf("expected only custom metric, got %v", hpa.Spec.Metrics)
+	}
+	metric := hpa.Spec.Metrics[0]
+	if metric.Type != autoscalingv2beta2.PodsMetricSourceType || metric.Pods.Metric.Name != "nginx_vts_main_connections" ||
+		metric.Pods.Target.AverageValue.Cmp(resource.MustParse("1000")) != 0 {
+		t.Errorf("unexpected custom metric %v", metric)
+	}
+
+	lb.Spec.Proxy.Autoscaling.TargetCPUUtilizationPercentage = int32p(60)
+	hpa = (&nginx{}).generateHorizontalPodAutoscaler(lb, "test-proxy-nginx-abcde")
+	if len(hpa.Spec.Metrics) != 2 || *hpa.Spec.Metrics[0].Resource.Target.AverageUtilization != 60 {
+		t.Errorf("unexpected metrics %v", hpa.Spec.Metrics)
+	}
+
+	if !autoscalingEnabled(lb) {
+		t.Error("expected autoscaling enabled")
+	}
+	lb.Spec.Nodes.Names = []string{"node1"}
+	if autoscalingEnabled(lb) {
+		t.Error("expected autoscaling disabled in host network")
+	}
+}
@@ -20,12 +20,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 
 	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
 	log "k8s.io/klog"
 )
 
@@ -51,6 +57,12 @@ var (
 
 	annotationExternalConfigMaps = "external-configs"
 	labelExternalConfig          = "loadbalance.caicloud.io/external-config"
+
+	// external config ConfigMaps are named as cfg-lb-nginx-<scope>[-override],
+	// scope is one of platform, cluster and instance-<lb name>
+	externalConfigMapPrefix          = "cfg-lb-nginx-"
+	externalConfigMapOverridePostfix = "-override"
+	externalConfigScopeInstance      = "instance-"
 )
 
 func mapDel(base map[string]string, dels ...map[string]string) map[string]string {
@@ -84,33 +96,52 @@ func mapAdd(base map[string]string, adds ...map[string]string) map[string]string
 	return ret
 }
 
-func (f *nginx) ensureConfigMaps(lb *lbapi.LoadBalancer) error {
+// configStatus is the status of proxy ConfigMaps reported in ProxyStatus
+type configStatus struct {
+	// portViolations are the stream entries quarantined because of port violations
+	portViolations []lbapi.PortViolation
+	// configIssues are the unknown or invalid keys in config layers
+	configIssues []lbapi.ConfigIssue
+	// provenance is the summary of effective config provenance
+	provenance *lbapi.ConfigProvenance
+}
+
+// ensureConfigMaps ensures the ConfigMaps of proxy
+func (f *nginx) ensureConfigMaps(lb *lbapi.LoadBalancer) (*configStatus, error) {
 	labels := f.selector(lb)
 
 	// For ingress-nginx configuration
 	cmName := fmt.Sprintf(configMapName, lb.Name)
 	cm, err := f.ensureConfigMap(cmName, lb.Namespace, labels)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = f.updateConfig(lb, cm)
+	cs := &configStatus{}
+	err = f.updateConfig(lb, cm, cs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// For L4 TCP rules
 	tcpcmName := fmt.Sprintf(tcpConfigMapName, lb.Name)
-	_, err = f.ensureConfigMap(tcpcmName, lb.Namespace, labels)
+	tcpcm, err := f.ensureConfigMap(tcpcmName, lb.Namespace, labels)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// For L4 UDP rules
 	udpcmName := fmt.Sprintf(udpConfigMapName, lb.Name)
-	_, err = f.ensureConfigMap(udpcmName, lb.Namespace, labels)
+	udpcm, err := f.ensureConfigMap(udpcmName, lb.Namespace, labels)
+	if err != nil {
+		return nil, err
+	}
 
-	return err
+	cs.portViolations, err = f.updateStreams(lb, tcpcm, udpcm)
+	if err != nil {
+		return nil, err
+	}
+	return cs, nil
 }
 
 func (f *nginx) ensureConfigMap(name, namespace string, labels map[string]string) (*v1.ConfigMap, error) {
@@ -133,24 +164,104 @@ func (f *nginx) ensureConfigMap(name, namespace string, labels map[string]string
 	return f.client.Native().CoreV1().ConfigMaps(namespace).Create(cm)
 }
 
-func (f *nginx) updateConfig(lb *lbapi.LoadBalancer, cm *v1.ConfigMap) error {
+// configLayer is a layer of nginx config, the latter layers take precedence
+type configLayer struct {
+	// name of the layer, such as platform, cluster, instance, default, spec, cluster-override
+	name string
+	// configMap is the name of the external config ConfigMap, empty if the layer is not external
+	configMap string
+	data      map[string]string
+}
+
+// validateLayers validates keys in each layer. Invalid keys are removed from the
+// layer, so the value in lower layers or the default value of ingress-nginx is used.
+// Invalid keys in spec never get here since they are rejected by validation.
+func validateLayers(layers []configLayer) ([]configLayer, []lbapi.ConfigIssue) {
+	issues := make([]lbapi.ConfigIssue, 0)
+	valid := make([]configLayer, 0, len(layers))
+	for _, layer := range layers {
+		rejected := make(map[string]string)
+		for _, issue := range lbapi.ValidateNginxConfig(layer.data) {
+			issue.Layer = layer.name
+			issues = append(issues, issue)
+			if issue.Severity == lbapi.ConfigIssueError {
+				rejected[issue.Key] = issue.Value
+			}
+		}
+		layer.data = mapDel(layer.data, rejected)
+		valid = append(valid, layer)
+	}
+	return valid, issues
+}
+
+// invalidKeys returns the keys rejected in layer
+func invalidKeys(issues []lbapi.ConfigIssue, layer string) []string {
+	keys := make([]string, 0)
+	for _, issue := range issues {
+		if issue.Layer == layer && issue.Severity == lbapi.ConfigIssueError {
+			keys = append(keys, issue.Key)
+		}
+	}
+	return keys
+}
+
+func mergeLayers(layers []configLayer) map[string]string {
+	ret := make(map[string]string)
+	for _, layer := range layers {
+		ret = mapAdd(ret, layer.data)
+	}
+	return ret
+}
+
+func logConfigIssues(lb *lbapi.LoadBalancer, issues []lbapi.ConfigIssue) {
+	for _, issue := range issues {
+		if issue.Severity == lbapi.ConfigIssueError {
+			log.Warningf("Reject nginx config %v=%q in layer %v of loadbalancer %v/%v: %v", issue.Key, issue.Value, issue.Layer, lb.Namespace, lb.Name, issue.Reason)
+			continue
+		}
+		log.V(3).Infof("Unknown nginx config %v in layer %v of loadbalancer %v/%v", issue.Key, issue.Layer, lb.Namespace, lb.Name)
+	}
+}
+
+// updateConfig updates the nginx config, and records the config issues and provenance in cs
+func (f *nginx) updateConfig(lb *lbapi.LoadBalancer, cm *v1.ConfigMap, cs *configStatus) error {
 	// 1. if cm has unmanaged config, we should generate cm.Data with old method
-	done, err := f.updateIfHasUnmanagedConfig(lb, cm)
+	done, err := f.updateIfHasUnmanagedConfig(lb, cm, cs)
 	if err != nil || done {
 		return err
 	}
 
 	// 2. otherwise, use new method that generate cm.Data by external configs
-	//externalConfigMaps, preset, override, err := f.getExternalConfig(lb)
-	externalConfigMaps, preset, override, err := f.getExternalConfig(lb)
+	preset, override, err := f.getExternalConfig(lb)
 	if err != nil {
 		return err
 	}
-	newConfig := mapAdd(preset, defaultConfig, lb.Spec.Proxy.Config, override)
+	layers := append(preset,
+		configLayer{name: "default", data: defaultConfig},
+		configLayer{name: "spec", data: lb.Spec.Proxy.Config},
+	)
+	layers = append(layers, override...)
+	layers, cs.configIssues = validateLayers(layers)
+	logConfigIssues(lb, cs.configIssues)
+	newConfig := mergeLayers(layers)
+	report, provenance := computeProvenance(layers)
+	cs.provenance = provenance
+	reportStr := formatProvenance(report)
+
+	externalConfigMaps := []string{}
+	for _, layer := range preset {
+		externalConfigMaps = append(externalConfigMaps, layer.configMap)
+	}
+	externalConfigMaps = append(externalConfigMaps, "") // empty string delimits 'preset' and 'override'
+	for _, layer := range override {
+		externalConfigMaps = append(externalConfigMaps, layer.configMap)
+	}
 	bs, _ := json.Marshal(externalConfigMaps)
 	externalConfigMapsStr := string(bs)
 
-	if reflect.DeepEqual(cm.Data, newConfig) && externalConfigMapsStr == cm.Annotations[annotationExternalConfigMaps] {
+	if reflect.DeepEqual(cm.Data, newConfig) &&
+		externalConfigMapsStr == cm.Annotations[annotationExternalConfigMaps] &&
+		reportStr == cm.Annotations[annotationConfigProvenance] {
 		return nil
 	}
 
@@ -158,13 +269,14 @@ func (f *nginx) updateConfig(lb *lbapi.LoadBalancer, cm *v1.ConfigMap) error {
 		cm.Annotations = make(map[string]string)
 	}
 	cm.Annotations[annotationExternalConfigMaps] = externalConfigMapsStr
+	cm.Annotations[annotationConfigProvenance] = reportStr
 	cm.Data = newConfig
 	log.Infof("About to update ConfigMap %v/%v data, with exnternal configs: %v", cm.Namespace, cm.Name, externalConfigMapsStr)
 	_, err = f.client.Native().CoreV1().ConfigMaps(cm.Namespace).Update(cm)
 	return err
 }
 
-func (f *nginx) updateIfHasUnmanagedConfig(lb *lbapi.LoadBalancer, cm *v1.ConfigMap) (bool, error) {
+func (f *nginx) updateIfHasUnmanagedConfig(lb *lbapi.LoadBalancer, cm *v1.ConfigMap, cs *configStatus) (bool, error) {
 	var err error
 
 	var oldExternalConfigMaps []string
@@ -189,9 +301,29 @@ func (f *nginx) updateIfHasUnmanagedConfig(lb *lbapi.LoadBalancer, cm *v1.Config
 	}
 
 	log.Warningf("Found unmanaged configs in %s/%s: %v", lb.Namespace, lb.Name, unmanagedConifgs)
-	newConfig := mapAdd(unmanagedConifgs, defaultConfig, lb.Spec.Proxy.Config)
-
-	if !reflect.DeepEqual(cm.Data, newConfig) {
+	layers, issues := validateLayers([]configLayer{
+		{name: "unmanaged", data: unmanagedConifgs},
+		{name: "default", data: defaultConfig},
+		{name: "spec", data: lb.Spec.Proxy.Config},
+	})
+	logConfigIssues(lb, issues)
+	cs.configIssues = issues
+	if invalid := invalidKeys(issues, "unmanaged"); len(invalid) > 0 {
+		// unmanaged configs only live in ConfigMap, they are lost if ConfigMap is rewritten without them
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "InvalidUnmanagedConfig",
+			"ConfigMap %v/%v is not updated until its invalid configs %v are fixed", cm.Namespace, cm.Name, strings.Join(invalid, ", "))
+		return true, nil
+	}
+	newConfig := mergeLayers(layers)
+	report, provenance := computeProvenance(layers)
+	reportStr := formatProvenance(report)
+	cs.provenance = provenance
+
+	if !reflect.DeepEqual(cm.Data, newConfig) || reportStr != cm.Annotations[annotationConfigProvenance] {
+		if cm.Annotations == nil {
+			cm.Annotations = make(map[string]string)
+		}
+		cm.Annotations[annotationConfigProvenance] = reportStr
 		cm.Data = newConfig
 		log.Warningf("About to update ConfigMap %v/%v data with old method", cm.Namespace, cm.Name)
 		_, err = f.client.Native().CoreV1().ConfigMaps(cm.Namespace).Update(cm)
@@ -199,44 +331,104 @@ func (f *nginx) updateIfHasUnmanagedConfig(lb *lbapi.LoadBalancer, cm *v1.Config
 	return true, err
 }
 
-func (f *nginx) getExternalConfig(lb *lbapi.LoadBalancer) ([]string, map[string]string, map[string]string, error) {
-
-	presetConfigMaps := []string{}
-	overrideConfigMaps := []string{}
-	presetConfig := make(map[string]string)
-	overrideConfig := make(map[string]string)
-
-	for _, scope := range []string{"platform", "cluster", "instance-%s"} {
-
-		for _, postfix := range []string{"", "-override"} {
-			name := fmt.Sprintf("cfg-lb-nginx-%s%s", scope, postfix)
-			if scope == "instance-%s" {
-				name = fmt.Sprintf(name, lb.Name)
-			}
-			cm, err := f.client.Native().CoreV1().ConfigMaps(lb.Namespace).Get(name, metav1.GetOptions{})
+// getExternalConfig returns the preset and override layers from external config ConfigMaps,
+// layers are in the order of platform, cluster and instance.
+func (f *nginx) getExternalConfig(lb *lbapi.LoadBalancer) ([]configLayer, []configLayer, error) {
+	preset := []configLayer{}
+	override := []configLayer{}
+
+	for _, scope := range []string{"platform", "cluster", externalConfigScopeInstance + lb.Name} {
+		// instance layer is named without the loadbalancer's name
+		layerName := strings.TrimSuffix(scope, "-"+lb.Name)
+
+		for _, postfix := range []string{"", externalConfigMapOverridePostfix} {
+			name := externalConfigMapPrefix + scope + postfix
+			// the lister only caches ConfigMaps with labelExternalConfig,
+			// ConfigMaps without the label are treated as not found
+			cm, err := f.cmLister.ConfigMaps(lb.Namespace).Get(name)
 			if errors.IsNotFound(err) {
 				continue
 			}
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, err
 			}
 
-			if _, ok := cm.Labels[labelExternalConfig]; !ok {
-				log.Infof("external configmap: %s doesn't has a '%s' label", cm.Name, labelExternalConfig)
+			layer := configLayer{name: layerName + postfix, configMap: cm.Name, data: cm.Data}
+			if postfix == "" {
+				preset = append(preset, layer)
 				continue
 			}
+			override = append(override, layer)
+		}
+	}
 
-			if postfix == "" {
-				presetConfig = mapAdd(presetConfig, cm.Data)
-				presetConfigMaps = append(presetConfigMaps, cm.Name)
+	return preset, override, nil
+}
+
+// enqueueForExternalConfig enqueues the loadbalancers affected by the external config ConfigMap.
+// platform and cluster scope affect all loadbalancers in the namespace, instance scope
+// only affects the loadbalancer with the name.
+func (f *nginx) enqueueForExternalConfig(obj interface{}) {
+	cm, ok := obj.(*v1.ConfigMap)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Couldn't get object from tombstone %#v", obj))
+			return
+		}
+		cm, ok = tombstone.Obj.(*v1.ConfigMap)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Tombstone contained object that is not a ConfigMap %#v", obj))
+			return
+		}
+	}
+
+	if !strings.HasPrefix(cm.Name, externalConfigMapPrefix) {
+		return
+	}
+	name := strings.TrimPrefix(cm.Name, externalConfigMapPrefix)
+
+	var lbs []*lbapi.LoadBalancer
+	switch strings.TrimSuffix(name, externalConfigMapOverridePostfix) {
+	case "platform", "cluster":
+		all, err := f.lbLister.LoadBalancers(cm.Namespace).List(labels.Everything())
+		if err != nil {
+			log.Errorf("list loadbalancers in namespace %v error: %v", cm.Namespace, err)
+			return
+		}
+		lbs = all
+	default:
+		if !strings.HasPrefix(name, externalConfigScopeInstance) {
+			return
+		}
+		name = strings.TrimPrefix(name, externalConfigScopeInstance)
+		// the loadbalancer's name may also end with the override postfix
+		for _, lbName := range sets.NewString(name, strings.TrimSuffix(name, externalConfigMapOverridePostfix)).List() {
+			lb, err := f.lbLister.LoadBalancers(cm.Namespace).Get(lbName)
+			if err != nil {
 				continue
 			}
-			overrideConfig = mapAdd(overrideConfig, cm.Data)
-			overrideConfigMaps = append(overrideConfigMaps, cm.Name)
+			lbs = append(lbs, lb)
+		}
+	}
+
+	for _, lb := range lbs {
+		if lb.Spec.Proxy.Type != lbapi.ProxyTypeNginx {
+			continue
 		}
+		log.Infof("External config %v/%v changed, sync proxy of loadbalancer %v/%v", cm.Namespace, cm.Name, lb.Namespace, lb.Name)
+		f.queue.Enqueue(lb)
 	}
+}
 
-	presetConfigMaps = append(presetConfigMaps, "") // empty string delimits 'preset' and 'override'
-	presetConfigMaps = append(presetConfigMaps, overrideConfigMaps...)
-	return presetConfigMaps, presetConfig, overrideConfig, nil
+func (f *nginx) updateExternalConfig(oldObj, curObj interface{}) {
+	old := oldObj.(*v1.ConfigMap)
+	cur := curObj.(*v1.ConfigMap)
+	if old.ResourceVersion == cur.ResourceVersion {
+		return
+	}
+	if reflect.DeepEqual(old.Data, cur.Data) {
+		return
+	}
+	f.enqueueForExternalConfig(cur)
 }
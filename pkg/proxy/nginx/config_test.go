@@ -0,0 +1,79 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/caicloud/clientset/kubernetes"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+func TestUpdateInvalidUnmanagedConfig(t *testing.T) {
+	var mu sync.Mutex
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&v1.Event{})
+	}))
+	defer server.Close()
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &nginx{client: client}
+
+	lb := &lbapi.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb"}}
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb-proxy-nginx-config"},
+		Data:       map[string]string{"client-body-buffer-size": "8kk", "keep-alive": "75"},
+	}
+
+	// invalid unmanaged config is kept in ConfigMap
+	cs := &configStatus{}
+	done, err := f.updateIfHasUnmanagedConfig(lb, cm, cs)
+	if err != nil || !done {
+		t.Fatalf("got done %v error %v", done, err)
+	}
+	if len(requests) != 1 || requests[0] != "POST /api/v1/namespaces/ns/events" {
+		t.Errorf("got requests %v, want only an event", requests)
+	}
+	if len(cs.configIssues) != 1 || cs.configIssues[0].Key != "client-body-buffer-size" || cs.configIssues[0].Layer != "unmanaged" {
+		t.Errorf("got config issues %v", cs.configIssues)
+	}
+
+	// ConfigMap is updated once it is fixed
+	requests = nil
+	cm.Data["client-body-buffer-size"] = "8k"
+	if _, err := f.updateIfHasUnmanagedConfig(lb, cm, &configStatus{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 1 || requests[0] != "PUT /api/v1/namespaces/ns/configmaps/lb-proxy-nginx-config" {
+		t.Errorf("got requests %v, want ConfigMap updated", requests)
+	}
+}
@@ -40,14 +40,26 @@ const (
 	ingressControllerPort = 450
 	// ingress controller use this port to export nginx status page
 	ingressStatusPort = 451
+	// name of the nginx ingress controller container
+	proxyContainerName = "proxy"
 	// ingress controller use this priority class to create pod
 	ingressPriorityClass = "system-node-critical"
 )
 
-func (f *nginx) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
+// generateDeployment generates the deployment of lb, defaultImage is used
+// if lb does not override the image
+func (f *nginx) generateDeployment(lb *lbapi.LoadBalancer, defaultImage string) (*appsv1.Deployment, error) {
+	image, err := lbutil.ResolveLoadBalancerImage(f.client, lb, "nginx", defaultImage, lb.Spec.Proxy.Image, lb.Spec.Proxy.Version, f.allowedImages)
+	if err != nil {
+		return nil, err
+	}
 	terminationGracePeriodSeconds := int64(30)
 	dnsPolicy := v1.DNSClusterFirst
 	replicas, hostNetwork := lbutil.CalculateReplicas(lb)
+	if autoscalingEnabled(lb) {
+		// the initial replicas, then it is managed by autoscaler
+		replicas = autoscalingMinReplicas(lb.Spec.Proxy.Autoscaling)
+	}
 	maxSurge := intstr.FromInt(0)
 	t := true
 	labels := f.selector(lb)
@@ -84,20 +96,11 @@ func (f *nginx) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 		},
 	}
 
-	httpPort := lb.Spec.Proxy.HTTPPort
-	httpsPort := lb.Spec.Proxy.HTTPSPort
-	if httpPort <= 0 {
-		// default http port is 80
-		httpPort = 80
-	}
-	if httpsPort <= 0 {
-		// default https port is 443
-		httpsPort = 443
-	}
+	httpPort, httpsPort := lbutil.HTTPPorts(lb)
 
 	ingressContainer := v1.Container{
-		Name:            "proxy",
-		Image:           f.image,
+		Name:            proxyContainerName,
+		Image:           image,
 		ImagePullPolicy: v1.PullAlways,
 		Resources:       lb.Spec.Proxy.Resources,
 		Ports: []v1.ContainerPort{
@@ -135,7 +138,7 @@ func (f *nginx) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 		// TODO
 		Args: []string{
 			"/nginx-ingress-controller",
-			"--ingress-class=" + fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name),
+			"--ingress-class=" + ingressClassName(lb),
 			"--configmap=" + fmt.Sprintf("%s/"+configMapName, lb.Namespace, lb.Name),
 			"--tcp-services-configmap=" + fmt.Sprintf("%s/"+tcpConfigMapName, lb.Namespace, lb.Name),
 			"--udp-services-configmap=" + fmt.Sprintf("%s/"+udpConfigMapName, lb.Namespace, lb.Name),
@@ -171,8 +174,18 @@ func (f *nginx) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 		},
 	}
 
-	if f.defaultSSLCertificate != "" {
-		ingressContainer.Args = append(ingressContainer.Args, "--default-ssl-certificate="+f.defaultSSLCertificate)
+	podAnnotations := map[string]string{
+		"prometheus.io/port":   strconv.Itoa(ingressControllerPort),
+		"prometheus.io/scrape": "true",
+	}
+	if !lbutil.ImageOlderThan(image, controllerClassVersion) {
+		ingressContainer.Args = append(ingressContainer.Args, "--controller-class="+controllerClass(lb))
+	}
+	if cert := defaultSSLCertificate(lb, f.defaultSSLCertificate); cert != "" {
+		ingressContainer.Args = append(ingressContainer.Args, "--default-ssl-certificate="+cert)
+		if lb.Spec.Proxy.TLS != nil {
+			podAnnotations[annotationDefaultSSLCertificate] = cert
+		}
 	}
 
 	containers := []v1.Container{
@@ -215,11 +228,8 @@ func (f *nginx) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 			},
 			Template: v1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
-					Labels: labels,
-					Annotations: map[string]string{
-						"prometheus.io/port":   strconv.Itoa(ingressControllerPort),
-						"prometheus.io/scrape": "true",
-					},
+					Labels:      labels,
+					Annotations: podAnnotations,
 				},
 				Spec: v1.PodSpec{
 					HostNetwork: hostNetwork,
@@ -235,5 +245,11 @@ func (f *nginx) generateDeployment(lb *lbapi.LoadBalancer) *appsv1.Deployment {
 		},
 	}
 
-	return deploy
+	// apply the pod template overlay
+	err = lbutil.ApplyPodTemplate(deploy, lb.Spec.Proxy.PodTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return deploy, nil
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"strings"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+func TestGenerateDeploymentControllerClass(t *testing.T) {
+	lb := &lbapi.LoadBalancer{}
+	lb.Name = "test"
+	lb.Namespace = "default"
+
+	tests := []struct {
+		image string
+		want  bool
+	}{
+		{"nginx-ingress-controller:0.12.0", false},
+		{"nginx-ingress-controller:0.23.0-cps-1.1", false},
+		{"ingress-nginx/controller:v1.0.0", true},
+		{"ingress-nginx/controller@sha256:abcd", true},
+	}
+	for _, tt := range tests {
+		d, err := (&nginx{}).generateDeployment(lb, tt.image)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := false
+		for _, arg := range d.Spec.Template.Spec.Containers[0].Args {
+			if strings.HasPrefix(arg, "--controller-class=") {
+				got = true
+			}
+		}
+		if got != tt.want {
+			t.Errorf("image %v: got --controller-class %v, want %v", tt.image, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"fmt"
+	"reflect"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	networkingv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/networking/v1"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog"
+)
+
+const (
+	// controllerClassFormat is the controller name of IngressClass, it must be
+	// the same as the --controller-class flag of ingress-nginx
+	controllerClassFormat = lbapi.GroupName + "/%s"
+	// controllerClassVersion is the first ingress-nginx version supporting --controller-class,
+	// older versions select Ingresses by --ingress-class only
+	controllerClassVersion = "v1.0.0"
+)
+
+// ingressClassName returns the name of IngressClass served by lb's proxy
+func ingressClassName(lb *lbapi.LoadBalancer) string {
+	return fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name)
+}
+
+func controllerClass(lb *lbapi.LoadBalancer) string {
+	return fmt.Sprintf(controllerClassFormat, ingressClassName(lb))
+}
+
+func (f *nginx) generateIngressClass(lb *lbapi.LoadBalancer) *networkingv1.IngressClass {
+	apiGroup := lbapi.GroupName
+	scope := networkingv1.IngressClassParametersReferenceScopeNamespace
+	namespace := lb.Namespace
+
+	class := &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ingressClassName(lb),
+			// IngressClass is cluster scoped and can not be owned by the namespaced
+			// loadbalancer, it is deleted by label in cleanup
+			Labels: f.selector(lb),
+		},
+		Spec: networkingv1.IngressClassSpec{
+			Controller: controllerClass(lb),
+			Parameters: &networkingv1.IngressClassParametersReference{
+				APIGroup:  &apiGroup,
+				Kind:      api.ControllerKind.Kind,
+				Name:      lb.Name,
+				Scope:     &scope,
+				Namespace: &namespace,
+			},
+		},
+	}
+	if lb.Spec.Proxy.DefaultIngressClass {
+		class.Annotations = map[string]string{
+			networkingv1.AnnotationIsDefaultIngressClass: "true",
+		}
+	}
+	return class
+}
+
+// ensureIngressClass creates or updates the IngressClass of lb's proxy
+func (f *nginx) ensureIngressClass(lb *lbapi.LoadBalancer) error {
+	desired := f.generateIngressClass(lb)
+
+	class, err := f.networkingClient.GetIngressClass(desired.Name)
+	if errors.IsNotFound(err) {
+		log.Infof("About to create IngressClass %v for loadbalancer %v/%v", desired.Name, lb.Namespace, lb.Name)
+		_, err = f.networkingClient.CreateIngressClass(desired)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	isDefault := class.Annotations[networkingv1.AnnotationIsDefaultIngressClass] == "true"
+	if reflect.DeepEqual(class.Labels, desired.Labels) &&
+		reflect.DeepEqual(class.Spec, desired.Spec) &&
+		isDefault == lb.Spec.Proxy.DefaultIngressClass {
+		return nil
+	}
+
+	class.Labels = desired.Labels
+	class.Spec = desired.Spec
+	if class.Annotations == nil {
+		class.Annotations = make(map[string]string)
+	}
+	if lb.Spec.Proxy.DefaultIngressClass {
+		class.Annotations[networkingv1.AnnotationIsDefaultIngressClass] = "true"
+	} else {
+		delete(class.Annotations, networkingv1.AnnotationIsDefaultIngressClass)
+	}
+	log.Infof("About to update IngressClass %v for loadbalancer %v/%v", class.Name, lb.Namespace, lb.Name)
+	_, err = f.networkingClient.UpdateIngressClass(class)
+	return err
+}
+
+// cleanupIngressClass handles the Ingresses by lb's ingress deletion policy if lb
+// is deleted, and deletes the IngressClass of lb's proxy. Ingresses are kept when
+// the proxy type is changed, the class is served by the new proxy.
+func (f *nginx) cleanupIngressClass(lb *lbapi.LoadBalancer, deleted bool) error {
+	if deleted {
+		err := lbutil.CleanupIngresses(f.client, f.lbLister, lb, ingressClassName(lb))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := f.networkingClient.DeleteIngressClass(ingressClassName(lb))
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Cleanup IngressClass error: %v", err)
+		return err
+	}
+	return nil
+}
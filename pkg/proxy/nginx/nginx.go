@@ -19,6 +19,7 @@ package nginx
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/caicloud/clientset/informers"
@@ -28,9 +29,14 @@ import (
 	controllerutil "github.com/caicloud/clientset/util/controller"
 	"github.com/caicloud/clientset/util/syncqueue"
 	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	autoscalingv2 "github.com/caicloud/loadbalancer-controller/pkg/apis/autoscaling/v2"
+	certmanagerv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/certmanager/v1"
+	networkingv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/networking/v1"
+	policyv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/policy/v1"
 	"github.com/caicloud/loadbalancer-controller/pkg/config"
 
 	"github.com/caicloud/loadbalancer-controller/pkg/plugin"
+	"github.com/caicloud/loadbalancer-controller/pkg/rollout"
 	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -39,6 +45,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	appslisters "k8s.io/client-go/listers/apps/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -51,8 +59,11 @@ const (
 )
 
 type nginx struct {
-	initialized           bool
-	image                 string
+	initialized   bool
+	image         string
+	allowedImages []string
+	// rollout means the default image is granted by staged rollout
+	stagedRollout         bool
 	sidecar               string
 	defaultHTTPbackend    string
 	defaultSSLCertificate string
@@ -60,10 +71,28 @@ type nginx struct {
 
 	client kubernetes.Interface
 	queue  *syncqueue.SyncQueue
+	// networkingClient manages networking.k8s.io/v1 Ingresses and IngressClasses
+	networkingClient *networkingv1.Client
+	// policyClient manages policy/v1 PodDisruptionBudgets
+	policyClient *policyv1.Client
+	// autoscalingClient manages autoscaling/v2 HorizontalPodAutoscalers
+	autoscalingClient *autoscalingv2.Client
+	// certClient manages cert-manager.io/v1 Certificates
+	certClient *certmanagerv1.Client
 
 	lbLister  lblisters.LoadBalancerLister
 	dLister   appslisters.DeploymentLister
+	dsLister  appslisters.DaemonSetLister
 	podLister corelisters.PodLister
+	svcLister corelisters.ServiceLister
+
+	// tlsResyncs are the keys of loadbalancers waiting for the next expiry check of certificate
+	tlsResyncMu sync.Mutex
+	tlsResyncs  sets.String
+
+	// cmInformer only watches the external config ConfigMaps
+	cmInformer cache.SharedIndexInformer
+	cmLister   corelisters.ConfigMapLister
 }
 
 // New creates a new nginx proxy plugin
@@ -84,21 +113,54 @@ func (f *nginx) Init(cfg config.Configuration, sif informers.SharedInformerFacto
 	f.annotationPrefix = cfg.Proxies.Nginx.AnnotationPrefix
 	f.sidecar = cfg.Proxies.Sidecar
 	f.image = cfg.Proxies.Nginx.Image
+	f.allowedImages = cfg.AllowedImages
+	f.stagedRollout = cfg.Rollout.Enabled
 	f.client = cfg.Client
+	f.networkingClient = networkingv1.New(cfg.Client.Native().NetworkingV1().RESTClient())
+	f.policyClient = policyv1.New(cfg.Client.Native().PolicyV1beta1().RESTClient())
+	f.autoscalingClient = autoscalingv2.New(cfg.Client.Native().AutoscalingV2beta2().RESTClient())
+	f.certClient = certmanagerv1.New(cfg.Client.Native().CoreV1().RESTClient())
 
 	// initialize controller
 	lbInformer := sif.Custom().Loadbalance().V1alpha2().LoadBalancers()
 	dInformer := sif.Native().Apps().V1().Deployments()
+	dsInformer := sif.Native().Apps().V1().DaemonSets()
 	podInfomer := sif.Native().Core().V1().Pods()
+	svcInformer := sif.Native().Core().V1().Services()
 
 	f.lbLister = lbInformer.Lister()
 	f.dLister = dInformer.Lister()
+	f.dsLister = dsInformer.Lister()
 	f.podLister = podInfomer.Lister()
+	f.svcLister = svcInformer.Lister()
 
 	f.queue = syncqueue.NewPassthroughSyncQueue(&lbapi.LoadBalancer{}, f.syncLoadBalancer)
 
-	dInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDeployment(f.lbLister, f.dLister, f.queue, f.deploymentFiltered))
+	dInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDeployment(f.lbLister, f.queue, f.deploymentFiltered))
+	dsInformer.Informer().AddEventHandler(lbutil.NewEventHandlerForDaemonSet(f.lbLister, f.queue, f.daemonSetFiltered))
 	podInfomer.Informer().AddEventHandler(lbutil.NewEventHandlerForSyncStatusWithPod(f.lbLister, f.podLister, f.queue, f.podFiltered))
+	// resolve backend services of streams
+	svcInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    f.enqueueForService,
+		UpdateFunc: f.updateService,
+		DeleteFunc: f.enqueueForService,
+	})
+
+	f.cmInformer = coreinformers.NewFilteredConfigMapInformer(
+		cfg.Client.Native(),
+		metav1.NamespaceAll,
+		0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		func(options *metav1.ListOptions) {
+			options.LabelSelector = labelExternalConfig
+		},
+	)
+	f.cmLister = corelisters.NewConfigMapLister(f.cmInformer.GetIndexer())
+	f.cmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    f.enqueueForExternalConfig,
+		UpdateFunc: f.updateExternalConfig,
+		DeleteFunc: f.enqueueForExternalConfig,
+	})
 }
 
 func (f *nginx) Run(stopCh <-chan struct{}) {
@@ -120,6 +182,12 @@ func (f *nginx) Run(stopCh <-chan struct{}) {
 
 	// lb controller has waited all the informer synced
 	// there is no need to wait again here
+	// but the external config informer is not in the shared factory
+	go f.cmInformer.Run(stopCh)
+	if !cache.WaitForCacheSync(stopCh, f.cmInformer.HasSynced) {
+		log.Error("Wait for external config ConfigMaps cache sync timeout")
+		return
+	}
 
 	defer func() {
 		log.Info("Shutting down nginx proxy")
@@ -144,6 +212,11 @@ func (f *nginx) deploymentFiltered(obj *appsv1.Deployment) bool {
 	return f.filteredByLabel(obj)
 }
 
+// filter DaemonSet that controller does not care
+func (f *nginx) daemonSetFiltered(obj *appsv1.DaemonSet) bool {
+	return f.filteredByLabel(obj)
+}
+
 func (f *nginx) podFiltered(obj *v1.Pod) bool {
 	return f.filteredByLabel(obj)
 }
@@ -180,8 +253,10 @@ func (f *nginx) syncLoadBalancer(obj interface{}) error {
 	nlb, err := f.lbLister.LoadBalancers(lb.Namespace).Get(lb.Name)
 	if errors.IsNotFound(err) {
 		log.Warningf("LoadBalancer %v has been deleted, clean up proxy", key)
+		// events of a deleted lb are never changed again
+		lbutil.ForgetEvents(lb)
 
-		return f.cleanup(lb)
+		return f.cleanup(lb, true)
 	}
 	if err != nil {
 		utilruntime.HandleError(fmt.Errorf("Unable to retrieve LoadBalancer %v from store: %v", key, err))
@@ -198,7 +273,7 @@ func (f *nginx) syncLoadBalancer(obj interface{}) error {
 
 	if lb.Spec.Proxy.Type != lbapi.ProxyTypeNginx {
 		// It is not my responsible, clean up legacies
-		return f.cleanup(lb)
+		return f.cleanup(lb, false)
 	}
 
 	ds, err := f.getDeploymentsForLoadBalancer(lb)
@@ -206,12 +281,17 @@ func (f *nginx) syncLoadBalancer(obj interface{}) error {
 		return err
 	}
 
+	dss, err := f.getDaemonSetsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+
 	if lb.DeletionTimestamp != nil {
 		// TODO sync status only
 		return nil
 	}
 
-	return f.sync(lb, ds)
+	return f.sync(lb, ds, dss)
 
 }
 
@@ -245,13 +325,115 @@ func (f *nginx) getDeploymentsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv1
 	return cm.Claim(dList)
 }
 
-// sync generate desired deployment from lb and compare it with existing deployment
-func (f *nginx) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
-	desiredDeploy := f.generateDeployment(lb)
+func (f *nginx) getDaemonSetsForLoadBalancer(lb *lbapi.LoadBalancer) ([]*appsv1.DaemonSet, error) {
 
-	// update
+	// construct selector
+	selector := f.selector(lb).AsSelector()
+
+	// list all
+	dsList, err := f.dsLister.DaemonSets(lb.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	canAdoptFunc := controllerutil.RecheckDeletionTimestamp(func() (metav1.Object, error) {
+		// fresh lb
+		fresh, err := f.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace).Get(lb.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if fresh.UID != lb.UID {
+			return nil, fmt.Errorf("original LoadBalancer %v/%v is gone: got uid %v, wanted %v", lb.Namespace, lb.Name, fresh.UID, lb.UID)
+		}
+		return fresh, nil
+	})
+
+	cm := controllerutil.NewDaemonSetControllerRefManager(f.client.Native(), lb, selector, api.ControllerKind, canAdoptFunc)
+	return cm.Claim(dsList)
+}
+
+// sync generate desired workload from lb and compare it with existing workload
+func (f *nginx) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment, dss []*appsv1.DaemonSet) error {
+	defaultImage := f.image
+	if f.stagedRollout {
+		current := rollout.CurrentDaemonSetImage(dss, proxyContainerName)
+		if current == "" {
+			current = rollout.CurrentImage(dps, proxyContainerName)
+		}
+		defaultImage = rollout.Image(lb, rollout.ComponentNginx, f.image, current)
+	}
+	desiredDeploy, err := f.generateDeployment(lb, defaultImage)
+	if err != nil {
+		log.Errorf("Generate deployment for loadbalancer %v error: %v", lb.Name, err)
+		return err
+	}
+
+	var ds *appsv1.DaemonSet
+	var as *lbapi.AutoscalingStatus
+	if lb.Spec.Proxy.Workload == lbapi.WorkloadDaemonSet {
+		ds, err = f.syncDaemonSet(lb, desiredDeploy, dps, dss)
+	} else {
+		as, err = f.syncDeployment(lb, desiredDeploy, dps, dss)
+	}
+	if err != nil {
+		return err
+	}
+
+	cs, err := f.ensureConfigMaps(lb)
+	if err != nil {
+		return err
+	}
+
+	err = f.ensureIngressClass(lb)
+	if err != nil {
+		return err
+	}
+
+	tls, err := f.ensureCertificate(lb)
+	if err != nil {
+		return err
+	}
+
+	// update status
+	return f.syncStatus(lb, cs, as, ds, tls)
+}
+
+// syncDaemonSet runs proxy with a daemonset on the selected nodes, deployments are deleted
+func (f *nginx) syncDaemonSet(lb *lbapi.LoadBalancer, desiredDeploy *appsv1.Deployment, dps []*appsv1.Deployment, dss []*appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
+	desired := lbutil.GenerateDaemonSet(desiredDeploy, lb.Name+proxyNameSuffix)
+	ds, err := lbutil.EnsureDaemonSet(f.client, lb, desired, dss)
+	if err != nil {
+		return nil, err
+	}
+
+	// switched from deployment, it is deleted after the daemonset is created,
+	// pods of daemonset are scheduled once the host ports are released
+	err = lbutil.DeleteDeployments(f.client, dps...)
+	if err != nil {
+		return nil, err
+	}
+
+	err = f.deleteHorizontalPodAutoscaler(lb)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, _ := lbutil.CalculateReplicas(lb)
+	err = lbutil.EnsurePodDisruptionBudget(f.policyClient, lb, lb.Name+proxyNameSuffix, f.selector(lb), replicas, lbutil.MaxUnavailable(lb))
+	if err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// syncDeployment runs proxy with a deployment, daemonsets are deleted
+func (f *nginx) syncDeployment(lb *lbapi.LoadBalancer, desiredDeploy *appsv1.Deployment, dps []*appsv1.Deployment, dss []*appsv1.DaemonSet) (*lbapi.AutoscalingStatus, error) {
 	var err error
+	// update
 	updated := false
+	// the deployment scaled by autoscaler and its current replicas
+	deployment, replicas := desiredDeploy.Name, *desiredDeploy.Spec.Replicas
 
 	for _, dp := range dps {
 
@@ -272,14 +454,19 @@ func (f *nginx) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
 		}
 
 		updated = true
+		deployment = dp.Name
+		if autoscalingEnabled(lb) && dp.Spec.Replicas != nil {
+			replicas = *dp.Spec.Replicas
+		}
 		// do not change deployment if the loadbalancer is static
 		if !lbutil.IsStatic(lb) {
-			merged, changed := lbutil.MergeDeployment(dp, desiredDeploy)
+			// do not fight with autoscaler over replicas
+			merged, changed := lbutil.MergeDeployment(dp, desiredDeploy, autoscalingEnabled(lb))
 			if changed {
 				log.Infof("Sync nginx deployment %v for loadbalancer %v", dp.Name, lb.Name)
 				_, err = f.client.Native().AppsV1().Deployments(lb.Namespace).Update(merged)
 				if err != nil {
-					return err
+					return nil, err
 				}
 			}
 		}
@@ -291,21 +478,31 @@ func (f *nginx) sync(lb *lbapi.LoadBalancer, dps []*appsv1.Deployment) error {
 		log.Infof("Create nginx deployment %v for loadbalancer %v", desiredDeploy.Name, lb.Name)
 		_, err = f.client.Native().AppsV1().Deployments(lb.Namespace).Create(desiredDeploy)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	err = f.ensureConfigMaps(lb)
+	// switched from daemonset, it is deleted after the deployment is created
+	err = lbutil.DeleteDaemonSets(f.client, dss...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// update status
-	return f.syncStatus(lb)
+	as, err := f.ensureHorizontalPodAutoscaler(lb, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	err = lbutil.EnsurePodDisruptionBudget(f.policyClient, lb, lb.Name+proxyNameSuffix, f.selector(lb), replicas, lbutil.MaxUnavailable(lb))
+	if err != nil {
+		return nil, err
+	}
+	return as, nil
 }
 
-// cleanup deployment and other resource controlled by lb proxy
-func (f *nginx) cleanup(lb *lbapi.LoadBalancer) error {
+// cleanup deployment and other resource controlled by lb proxy, the Ingresses
+// are handled by the ingress deletion policy only if lb is deleted
+func (f *nginx) cleanup(lb *lbapi.LoadBalancer, deleted bool) error {
 
 	selector := f.selector(lb)
 
@@ -314,18 +511,21 @@ func (f *nginx) cleanup(lb *lbapi.LoadBalancer) error {
 		return err
 	}
 
-	policy := metav1.DeletePropagationForeground
-	gracePeriodSeconds := int64(30)
+	err = lbutil.DeleteDeployments(f.client, ds...)
+	if err != nil {
+		log.Errorf("Cleanup proxy error: %v", err)
+		return err
+	}
 
-	for _, d := range ds {
-		err = f.client.Native().AppsV1().Deployments(d.Namespace).Delete(d.Name, &metav1.DeleteOptions{
-			GracePeriodSeconds: &gracePeriodSeconds,
-			PropagationPolicy:  &policy,
-		})
-		if err != nil {
-			log.Errorf("Cleanup proxy error: %v", err)
-			return err
-		}
+	dss, err := f.getDaemonSetsForLoadBalancer(lb)
+	if err != nil {
+		return err
+	}
+
+	err = lbutil.DeleteDaemonSets(f.client, dss...)
+	if err != nil {
+		log.Errorf("Cleanup proxy error: %v", err)
+		return err
 	}
 
 	// clean up config map
@@ -337,27 +537,21 @@ func (f *nginx) cleanup(lb *lbapi.LoadBalancer) error {
 		return err
 	}
 
-	// clean up ingress
-	selector = labels.Set{
-		// createdby ingressClass
-		lbapi.LabelKeyCreatedBy: fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name),
+	err = lbutil.DeletePodDisruptionBudget(f.policyClient, lb.Namespace, lb.Name+proxyNameSuffix)
+	if err != nil {
+		return err
 	}
-	ingresses, err := f.client.Native().ExtensionsV1beta1().Ingresses(metav1.NamespaceAll).List(metav1.ListOptions{
-		LabelSelector: selector.String(),
-	})
 
+	err = f.deleteHorizontalPodAutoscaler(lb)
 	if err != nil {
-		log.Errorf("Cleanup Ingress error: %v", err)
 		return err
 	}
 
-	for _, ingress := range ingresses.Items {
-		err = f.client.Native().ExtensionsV1beta1().Ingresses(ingress.Namespace).Delete(ingress.Name, &metav1.DeleteOptions{})
-		if err != nil {
-			log.Errorf("Cleanup Ingress error: %v", err)
-			return err
-		}
+	err = f.deleteCertificate(lb)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	// clean up ingress and ingress class
+	return f.cleanupIngressClass(lb, deleted)
 }
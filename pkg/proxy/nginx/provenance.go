@@ -0,0 +1,81 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+var (
+	// annotationConfigProvenance records where each effective key of nginx config comes from
+	annotationConfigProvenance = "config-provenance"
+)
+
+// keyProvenance is the provenance of an effective config key
+type keyProvenance struct {
+	// Layer is the layer which the effective value comes from
+	Layer string `json:"layer"`
+	// ConfigMap is the external config ConfigMap of the layer
+	ConfigMap string `json:"configMap,omitempty"`
+	// Shadowed are the lower layers which also set the key, from low to high
+	Shadowed []string `json:"shadowed,omitempty"`
+}
+
+// computeProvenance computes the provenance of each key in the merged layers,
+// and returns the full report and its summary
+func computeProvenance(layers []configLayer) (map[string]keyProvenance, *lbapi.ConfigProvenance) {
+	report := make(map[string]keyProvenance)
+	for _, layer := range layers {
+		for key := range layer.data {
+			p, ok := report[key]
+			if ok {
+				p.Shadowed = append(p.Shadowed, p.Layer)
+			}
+			p.Layer = layer.name
+			p.ConfigMap = layer.configMap
+			report[key] = p
+		}
+	}
+
+	summary := &lbapi.ConfigProvenance{
+		Layers: make(map[string]int),
+		Report: annotationConfigProvenance,
+	}
+	for key, p := range report {
+		summary.Layers[p.Layer]++
+		if strings.HasSuffix(p.Layer, externalConfigMapOverridePostfix) {
+			for _, shadowed := range p.Shadowed {
+				if shadowed == "spec" {
+					summary.ShadowedSpecKeys = append(summary.ShadowedSpecKeys, key)
+					break
+				}
+			}
+		}
+	}
+	sort.Strings(summary.ShadowedSpecKeys)
+	return report, summary
+}
+
+// formatProvenance formats the report to json, keys are sorted by encoding/json
+func formatProvenance(report map[string]keyProvenance) string {
+	bs, _ := json.Marshal(report)
+	return string(bs)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComputeProvenance(t *testing.T) {
+	layers := []configLayer{
+		{name: "cluster", configMap: "cfg-lb-nginx-cluster", data: map[string]string{"proxy-read-timeout": "60", "keep-alive": "75"}},
+		{name: "default", data: map[string]string{"server-tokens": "false"}},
+		{name: "spec", data: map[string]string{"proxy-read-timeout": "120", "keep-alive": "30"}},
+		{name: "cluster-override", configMap: "cfg-lb-nginx-cluster-override", data: map[string]string{"proxy-read-timeout": "30"}},
+	}
+
+	report, summary := computeProvenance(layers)
+
+	wantReport := map[string]keyProvenance{
+		"proxy-read-timeout": {Layer: "cluster-override", ConfigMap: "cfg-lb-nginx-cluster-override", Shadowed: []string{"cluster", "spec"}},
+		"keep-alive":         {Layer: "spec", Shadowed: []string{"cluster"}},
+		"server-tokens":      {Layer: "default"},
+	}
+	if !reflect.DeepEqual(report, wantReport) {
+		t.Errorf("computeProvenance() report = %v, want %v", report, wantReport)
+	}
+	wantLayers := map[string]int{"cluster-override": 1, "spec": 1, "default": 1}
+	if !reflect.DeepEqual(summary.Layers, wantLayers) {
+		t.Errorf("computeProvenance() layers = %v, want %v", summary.Layers, wantLayers)
+	}
+	if want := []string{"proxy-read-timeout"}; !reflect.DeepEqual(summary.ShadowedSpecKeys, want) {
+		t.Errorf("computeProvenance() shadowed spec keys = %v, want %v", summary.ShadowedSpecKeys, want)
+	}
+}
@@ -23,11 +23,23 @@ import (
 	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
 	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
 
+	appsv1 "k8s.io/api/apps/v1"
 	log "k8s.io/klog"
 )
 
-func (f *nginx) syncStatus(lb *lbapi.LoadBalancer) error {
+func (f *nginx) syncStatus(lb *lbapi.LoadBalancer, cs *configStatus, as *lbapi.AutoscalingStatus, ds *appsv1.DaemonSet, tls *lbapi.ProxyTLSStatus) error {
 	replicas, _ := lbutil.CalculateReplicas(lb)
+	if ds != nil {
+		// desired replicas is the number of nodes which daemonset runs on
+		replicas = lbutil.DaemonSetReplicas(lb, ds)
+	}
+	if as != nil {
+		// desired replicas is calculated by autoscaler
+		replicas = as.DesiredReplicas
+		if replicas == 0 {
+			replicas = autoscalingMinReplicas(lb.Spec.Proxy.Autoscaling)
+		}
+	}
 	// caculate proxy status
 	proxyStatus := lbapi.ProxyStatus{
 		PodStatuses: lbapi.PodStatuses{
@@ -36,10 +48,23 @@ func (f *nginx) syncStatus(lb *lbapi.LoadBalancer) error {
 			TotalReplicas: 0,
 			Statuses:      make([]lbapi.PodStatus, 0),
 		},
-		IngressClass: fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name),
+		IngressClass: ingressClassName(lb),
 		ConfigMap:    fmt.Sprintf(configMapName, lb.Name),
 		TCPConfigMap: fmt.Sprintf(tcpConfigMapName, lb.Name),
 		UDPConfigMap: fmt.Sprintf(udpConfigMapName, lb.Name),
+		Streams:      f.streamStatuses(lb),
+	}
+	if len(cs.portViolations) > 0 {
+		proxyStatus.PortViolations = cs.portViolations
+	}
+	if len(cs.configIssues) > 0 {
+		proxyStatus.ConfigIssues = cs.configIssues
+	}
+	proxyStatus.ConfigProvenance = cs.provenance
+	proxyStatus.Autoscaling = as
+	proxyStatus.TLS = tls
+	if ds != nil {
+		proxyStatus.DaemonSet = ds.Name
 	}
 
 	podList, err := f.podLister.List(f.selector(lb).AsSelector())
@@ -60,6 +85,7 @@ func (f *nginx) syncStatus(lb *lbapi.LoadBalancer) error {
 	}
 
 	sort.Sort(lbutil.SortPodStatusByName(proxyStatus.Statuses))
+	proxyStatus.RunningImages = lbutil.RunningImages(podList, proxyContainerName)
 
 	// check whether the statuses are equal
 	if !lbutil.ProxyStatusEqual(lb.Status.ProxyStatus, proxyStatus) {
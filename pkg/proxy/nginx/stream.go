@@ -0,0 +1,273 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+	log "k8s.io/klog"
+)
+
+var (
+	// annotationManagedStreams records the ports rendered from lb.Spec.Proxy.Streams,
+	// other entries in the tcp/udp ConfigMap are left untouched
+	annotationManagedStreams = "managed-streams"
+	// annotationQuarantinedStreams records the entries removed from the tcp/udp ConfigMap
+	// because their ports are not allowed
+	annotationQuarantinedStreams = "quarantined-streams"
+)
+
+func streamProtocol(stream lbapi.StreamSpec) v1.Protocol {
+	if stream.Protocol == "" {
+		return v1.ProtocolTCP
+	}
+	return stream.Protocol
+}
+
+// streamBackend returns the backend of stream in ingress-nginx format
+// <namespace>/<service>:<port>[:PROXY][:PROXY]
+func streamBackend(stream lbapi.StreamSpec) string {
+	backend := fmt.Sprintf("%s/%s:%d", stream.Service.Namespace, stream.Service.Name, stream.Service.Port)
+	if stream.ProxyProtocol == nil {
+		return backend
+	}
+	decode, encode := "", ""
+	if stream.ProxyProtocol.Decode {
+		decode = "PROXY"
+	}
+	if stream.ProxyProtocol.Encode {
+		encode = "PROXY"
+	}
+	if decode == "" && encode == "" {
+		return backend
+	}
+	backend += ":" + decode
+	if encode != "" {
+		backend += ":" + encode
+	}
+	return backend
+}
+
+// renderStreams renders streams with the given protocol to ConfigMap data
+func renderStreams(streams []lbapi.StreamSpec, protocol v1.Protocol) map[string]string {
+	data := make(map[string]string)
+	for _, stream := range streams {
+		if streamProtocol(stream) != protocol {
+			continue
+		}
+		data[strconv.Itoa(int(stream.Port))] = streamBackend(stream)
+	}
+	return data
+}
+
+func (f *nginx) updateStreams(lb *lbapi.LoadBalancer, tcpcm, udpcm *v1.ConfigMap) ([]lbapi.PortViolation, error) {
+	tcpViolations, err := f.updateStreamConfigMap(lb, tcpcm, v1.ProtocolTCP)
+	if err != nil {
+		return nil, err
+	}
+	udpViolations, err := f.updateStreamConfigMap(lb, udpcm, v1.ProtocolUDP)
+	if err != nil {
+		return nil, err
+	}
+	return append(tcpViolations, udpViolations...), nil
+}
+
+// updateStreamConfigMap renders streams in spec to the ConfigMap, and quarantines
+// the entries added by users if their ports are not allowed by PortRanges.
+// Quarantined entries will be restored once they become valid.
+func (f *nginx) updateStreamConfigMap(lb *lbapi.LoadBalancer, cm *v1.ConfigMap, protocol v1.Protocol) ([]lbapi.PortViolation, error) {
+	streams := renderStreams(lb.Spec.Proxy.Streams, protocol)
+	// only host network proxy listens on node's ports
+	_, enforce := lbutil.CalculateReplicas(lb)
+
+	oldManaged := make(map[string]string)
+	if value, ok := cm.Annotations[annotationManagedStreams]; ok {
+		var ports []string
+		if err := json.Unmarshal([]byte(value), &ports); err != nil {
+			return nil, err
+		}
+		for _, port := range ports {
+			oldManaged[port] = ""
+		}
+	}
+	oldQuarantined := make(map[string]string)
+	if value, ok := cm.Annotations[annotationQuarantinedStreams]; ok {
+		if err := json.Unmarshal([]byte(value), &oldQuarantined); err != nil {
+			return nil, err
+		}
+	}
+
+	// the entries added by users, including the quarantined ones
+	unmanaged := mapAdd(oldQuarantined, mapDel(cm.Data, oldManaged))
+
+	data := make(map[string]string)
+	quarantined := make(map[string]string)
+	violations := make([]lbapi.PortViolation, 0)
+	for port, backend := range unmanaged {
+		reason := ""
+		if _, ok := streams[port]; ok {
+			reason = fmt.Sprintf("port %s is used by spec.proxy.streams", port)
+		} else if enforce {
+			reason = lbutil.CheckStreamPort(lb, port)
+		}
+		if reason == "" {
+			data[port] = backend
+			continue
+		}
+		quarantined[port] = backend
+		violations = append(violations, lbapi.PortViolation{
+			Port:     port,
+			Protocol: protocol,
+			Backend:  backend,
+			Reason:   reason,
+		})
+	}
+	data = mapAdd(data, streams)
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Port < violations[j].Port
+	})
+
+	managed := make([]string, 0, len(streams))
+	for port := range streams {
+		managed = append(managed, port)
+	}
+	sort.Strings(managed)
+	bs, _ := json.Marshal(managed)
+	managedStr := string(bs)
+	quarantinedStr := ""
+	if len(quarantined) > 0 {
+		bs, _ = json.Marshal(quarantined)
+		quarantinedStr = string(bs)
+	}
+
+	// nil and empty data are equal
+	dataEqual := len(cm.Data) == len(data) && (len(data) == 0 || reflect.DeepEqual(cm.Data, data))
+	if dataEqual &&
+		cm.Annotations[annotationManagedStreams] == managedStr &&
+		cm.Annotations[annotationQuarantinedStreams] == quarantinedStr {
+		return violations, nil
+	}
+
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	cm.Annotations[annotationManagedStreams] = managedStr
+	if quarantinedStr == "" {
+		delete(cm.Annotations, annotationQuarantinedStreams)
+	} else {
+		cm.Annotations[annotationQuarantinedStreams] = quarantinedStr
+		log.Warningf("Quarantine streams in ConfigMap %v/%v: %v", cm.Namespace, cm.Name, quarantinedStr)
+	}
+	cm.Data = data
+	log.Infof("About to update ConfigMap %v/%v streams: %v", cm.Namespace, cm.Name, managedStr)
+	_, err := f.client.Native().CoreV1().ConfigMaps(cm.Namespace).Update(cm)
+	return violations, err
+}
+
+// streamStatuses resolves the backend services of streams
+func (f *nginx) streamStatuses(lb *lbapi.LoadBalancer) []lbapi.StreamStatus {
+	if len(lb.Spec.Proxy.Streams) == 0 {
+		return nil
+	}
+	statuses := make([]lbapi.StreamStatus, 0, len(lb.Spec.Proxy.Streams))
+	for _, stream := range lb.Spec.Proxy.Streams {
+		status := lbapi.StreamStatus{
+			Port:     stream.Port,
+			Protocol: streamProtocol(stream),
+			Service:  fmt.Sprintf("%s/%s:%d", stream.Service.Namespace, stream.Service.Name, stream.Service.Port),
+		}
+
+		svc, err := f.svcLister.Services(stream.Service.Namespace).Get(stream.Service.Name)
+		switch {
+		case errors.IsNotFound(err):
+			status.Message = "service not found"
+		case err != nil:
+			status.Message = err.Error()
+		default:
+			status.Message = fmt.Sprintf("service has no %s port %d", status.Protocol, stream.Service.Port)
+			for _, port := range svc.Spec.Ports {
+				protocol := port.Protocol
+				if protocol == "" {
+					protocol = v1.ProtocolTCP
+				}
+				if port.Port == stream.Service.Port && protocol == status.Protocol {
+					status.Resolved = true
+					status.Message = ""
+					break
+				}
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// enqueue the loadbalancers whose streams refer to the service
+func (f *nginx) enqueueForService(obj interface{}) {
+	svc, ok := obj.(*v1.Service)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Couldn't get object from tombstone %#v", obj))
+			return
+		}
+		svc, ok = tombstone.Obj.(*v1.Service)
+		if !ok {
+			utilruntime.HandleError(fmt.Errorf("Tombstone contained object that is not a Service %#v", obj))
+			return
+		}
+	}
+
+	lbs, err := f.lbLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("list loadbalancers error: %v", err)
+		return
+	}
+	for _, lb := range lbs {
+		if lb.Spec.Proxy.Type != lbapi.ProxyTypeNginx {
+			continue
+		}
+		for _, stream := range lb.Spec.Proxy.Streams {
+			if stream.Service.Namespace == svc.Namespace && stream.Service.Name == svc.Name {
+				log.V(3).Infof("Service %v/%v changed, sync streams of loadbalancer %v/%v", svc.Namespace, svc.Name, lb.Namespace, lb.Name)
+				f.queue.Enqueue(lb)
+				break
+			}
+		}
+	}
+}
+
+func (f *nginx) updateService(oldObj, curObj interface{}) {
+	old := oldObj.(*v1.Service)
+	cur := curObj.(*v1.Service)
+	if old.ResourceVersion == cur.ResourceVersion || reflect.DeepEqual(old.Spec.Ports, cur.Spec.Ports) {
+		return
+	}
+	f.enqueueForService(cur)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"reflect"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRenderStreams(t *testing.T) {
+	svc := lbapi.StreamServiceReference{Namespace: "default", Name: "mysql", Port: 3306}
+	streams := []lbapi.StreamSpec{
+		{Port: 20001, Service: svc},
+		{Port: 20002, Protocol: v1.ProtocolTCP, Service: svc, ProxyProtocol: &lbapi.StreamProxyProtocol{Decode: true}},
+		{Port: 20003, Service: svc, ProxyProtocol: &lbapi.StreamProxyProtocol{Encode: true}},
+		{Port: 20004, Service: svc, ProxyProtocol: &lbapi.StreamProxyProtocol{Decode: true, Encode: true}},
+		{Port: 20001, Protocol: v1.ProtocolUDP, Service: svc},
+	}
+
+	tests := []struct {
+		protocol v1.Protocol
+		want     map[string]string
+	}{
+		{
+			v1.ProtocolTCP,
+			map[string]string{
+				"20001": "default/mysql:3306",
+				"20002": "default/mysql:3306:PROXY",
+				"20003": "default/mysql:3306::PROXY",
+				"20004": "default/mysql:3306:PROXY:PROXY",
+			},
+		},
+		{
+			v1.ProtocolUDP,
+			map[string]string{
+				"20001": "default/mysql:3306",
+			},
+		},
+	}
+	for _, tt := range tests {
+		if got := renderStreams(streams, tt.protocol); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("renderStreams(%v) = %v, want %v", tt.protocol, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	certmanagerv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/certmanager/v1"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	log "k8s.io/klog"
+)
+
+const (
+	tlsNameSuffix = "-proxy-tls"
+	// the pod template annotation rolls out proxy when the default certificate
+	// changes, args are ignored when comparing deployments
+	annotationDefaultSSLCertificate = lbapi.GroupName + "/default-ssl-certificate"
+	// certificate expiring in this duration is reported
+	certificateExpiryWarning = 30 * 24 * time.Hour
+	// interval to check the expiry of certificate
+	tlsResyncPeriod = time.Hour
+)
+
+// tlsSecretName returns the Secret of default certificate of lb, empty if
+// lb does not have tls
+func tlsSecretName(lb *lbapi.LoadBalancer) string {
+	tls := lb.Spec.Proxy.TLS
+	if tls == nil {
+		return ""
+	}
+	if tls.SecretName != "" {
+		return tls.SecretName
+	}
+	return lb.Name + tlsNameSuffix
+}
+
+// defaultSSLCertificate returns the default certificate of proxy in the form of
+// namespace/name, the certificate set by controller is used if lb does not have tls
+func defaultSSLCertificate(lb *lbapi.LoadBalancer, defaultCertificate string) string {
+	if name := tlsSecretName(lb); name != "" {
+		return lb.Namespace + "/" + name
+	}
+	return defaultCertificate
+}
+
+func generateCertificate(lb *lbapi.LoadBalancer) *certmanagerv1.Certificate {
+	t := true
+	tls := lb.Spec.Proxy.TLS
+	kind := tls.IssuerRef.Kind
+	if kind == "" {
+		kind = certmanagerv1.IssuerKind
+	}
+	return &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      lb.Name + tlsNameSuffix,
+			Namespace: lb.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         api.ControllerKind.GroupVersion().String(),
+					Kind:               api.ControllerKind.Kind,
+					Name:               lb.Name,
+					UID:                lb.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				},
+			},
+		},
+		Spec: certmanagerv1.CertificateSpec{
+			SecretName: tlsSecretName(lb),
+			DNSNames:   tls.DNSNames,
+			IssuerRef: certmanagerv1.ObjectReference{
+				Name:  tls.IssuerRef.Name,
+				Kind:  kind,
+				Group: certmanagerv1.GroupName,
+			},
+		},
+	}
+}
+
+// certificateNotAfter returns the expiration time of the first certificate in pem data
+func certificateNotAfter(data []byte) (time.Time, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, fmt.Errorf("no certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+// ensureCertificate requests the certificate from issuer if needed, and reads the
+// expiration time of certificate, a Warning event is recorded when it expires soon
+func (f *nginx) ensureCertificate(lb *lbapi.LoadBalancer) (*lbapi.ProxyTLSStatus, error) {
+	tls := lb.Spec.Proxy.TLS
+	previous := lb.Status.ProxyStatus.TLS
+	// the Certificate requested for lb is recorded in status, cert-manager
+	// Certificates have no informer in clientset
+	if (tls == nil || tls.IssuerRef == nil) && previous != nil && previous.Certificate != "" {
+		err := f.deleteCertificate(lb)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if tls == nil {
+		return nil, nil
+	}
+
+	status := &lbapi.ProxyTLSStatus{
+		SecretName: tlsSecretName(lb),
+	}
+
+	if tls.IssuerRef != nil {
+		desired := generateCertificate(lb)
+		status.Certificate = desired.Name
+		cert, err := f.certClient.GetCertificate(lb.Namespace, desired.Name)
+		if errors.IsNotFound(err) {
+			log.Infof("Create certificate %v for loadbalancer %v", desired.Name, lb.Name)
+			cert, err = f.certClient.CreateCertificate(desired)
+		} else if err == nil && !reflect.DeepEqual(cert.Spec, desired.Spec) {
+			log.Infof("Sync certificate %v for loadbalancer %v", desired.Name, lb.Name)
+			cert.Spec = desired.Spec
+			cert, err = f.certClient.UpdateCertificate(cert)
+		}
+		if err != nil {
+			log.Errorf("Ensure certificate %v for loadbalancer %v error: %v", desired.Name, lb.Name, err)
+			return nil, err
+		}
+		if cond := cert.Condition(certmanagerv1.CertificateConditionReady); cond != nil && cond.Status != metav1.ConditionTrue {
+			status.Message = cond.Message
+		}
+	}
+
+	secret, err := f.client.Native().CoreV1().Secrets(lb.Namespace).Get(status.SecretName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if status.Message == "" {
+			status.Message = fmt.Sprintf("secret %v not found", status.SecretName)
+		}
+		return status, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	notAfter, err := certificateNotAfter(secret.Data[v1.TLSCertKey])
+	if err != nil {
+		status.Message = fmt.Sprintf("invalid certificate in secret %v: %v", status.SecretName, err)
+		return status, nil
+	}
+	t := metav1.NewTime(notAfter)
+	status.NotAfter = &t
+	status.Expiring = time.Until(notAfter) < certificateExpiryWarning
+
+	if status.Expiring && (previous == nil || !previous.Expiring) {
+		lbutil.RecordEvent(f.client, lb, v1.EventTypeWarning, "CertificateExpiring",
+			"Certificate in secret %v expires at %v", status.SecretName, notAfter.Format(time.RFC3339))
+	}
+
+	f.scheduleTLSResync(lb)
+	return status, nil
+}
+
+// scheduleTLSResync checks the expiry of certificate again later since no event is
+// triggered when time goes by, only one check is pending for each lb
+func (f *nginx) scheduleTLSResync(lb *lbapi.LoadBalancer) {
+	key, _ := cache.MetaNamespaceKeyFunc(lb)
+	f.tlsResyncMu.Lock()
+	defer f.tlsResyncMu.Unlock()
+	if f.tlsResyncs == nil {
+		f.tlsResyncs = sets.NewString()
+	}
+	if f.tlsResyncs.Has(key) {
+		return
+	}
+	f.tlsResyncs.Insert(key)
+
+	namespace, name := lb.Namespace, lb.Name
+	time.AfterFunc(tlsResyncPeriod, func() {
+		f.tlsResyncMu.Lock()
+		f.tlsResyncs.Delete(key)
+		f.tlsResyncMu.Unlock()
+
+		lb, err := f.lbLister.LoadBalancers(namespace).Get(name)
+		if err != nil {
+			return
+		}
+		f.queue.Enqueue(lb)
+	})
+}
+
+// deleteCertificate deletes the certificate requested for lb
+func (f *nginx) deleteCertificate(lb *lbapi.LoadBalancer) error {
+	err := f.certClient.DeleteCertificate(lb.Namespace, lb.Name+tlsNameSuffix)
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Delete certificate of loadbalancer %v error: %v", lb.Name, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nginx
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/caicloud/clientset/kubernetes"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/clientset/util/syncqueue"
+	certmanagerv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/certmanager/v1"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+func generateCertPEM(t *testing.T, notAfter time.Time) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "lb.example.com"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestCertificateNotAfter(t *testing.T) {
+	notAfter := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	got, err := certificateNotAfter(generateCertPEM(t, notAfter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(notAfter) {
+		t.Errorf("notAfter = %v, want %v", got, notAfter)
+	}
+	if _, err := certificateNotAfter([]byte("invalid")); err == nil {
+		t.Errorf("expected error for invalid pem")
+	}
+}
+
+func TestDefaultSSLCertificate(t *testing.T) {
+	lb := &lbapi.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb"}}
+	if got := defaultSSLCertificate(lb, "kube-system/default"); got != "kube-system/default" {
+		t.Errorf("got %v, want the certificate of controller", got)
+	}
+	lb.Spec.Proxy.TLS = &lbapi.ProxyTLSSpec{IssuerRef: &lbapi.IssuerReference{Name: "ca"}, DNSNames: []string{"a.com"}}
+	if got := defaultSSLCertificate(lb, "kube-system/default"); got != "ns/lb-proxy-tls" {
+		t.Errorf("got %v, want ns/lb-proxy-tls", got)
+	}
+	lb.Spec.Proxy.TLS.SecretName = "custom"
+	if got := defaultSSLCertificate(lb, ""); got != "ns/custom" {
+		t.Errorf("got %v, want ns/custom", got)
+	}
+}
+
+// fakeAPIServer serves the cert-manager Certificates, Secrets and Events of namespace ns
+type fakeAPIServer struct {
+	mu           sync.Mutex
+	certificates map[string]*certmanagerv1.Certificate
+	secrets      map[string]*v1.Secret
+	events       []v1.Event
+	deletes      int
+}
+
+func (s *fakeAPIServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	const certificates = "/apis/cert-manager.io/v1/namespaces/ns/certificates"
+	const secrets = "/api/v1/namespaces/ns/secrets/"
+	notFound := func() {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(&metav1.Status{Status: metav1.StatusFailure, Reason: metav1.StatusReasonNotFound, Code: http.StatusNotFound})
+	}
+
+	switch {
+	case r.URL.Path == certificates && r.Method == http.MethodPost,
+		len(r.URL.Path) > len(certificates) && r.Method == http.MethodPut:
+		cert := &certmanagerv1.Certificate{}
+		json.NewDecoder(r.Body).Decode(cert)
+		s.certificates[cert.Name] = cert
+		json.NewEncoder(w).Encode(cert)
+	case len(r.URL.Path) > len(certificates) && r.URL.Path[:len(certificates)] == certificates:
+		name := r.URL.Path[len(certificates)+1:]
+		cert, ok := s.certificates[name]
+		if !ok {
+			notFound()
+			return
+		}
+		if r.Method == http.MethodDelete {
+			s.deletes++
+			delete(s.certificates, name)
+		}
+		json.NewEncoder(w).Encode(cert)
+	case len(r.URL.Path) > len(secrets) && r.URL.Path[:len(secrets)] == secrets:
+		secret, ok := s.secrets[r.URL.Path[len(secrets):]]
+		if !ok {
+			notFound()
+			return
+		}
+		json.NewEncoder(w).Encode(secret)
+	case r.URL.Path == "/api/v1/namespaces/ns/events":
+		event := v1.Event{}
+		json.NewDecoder(r.Body).Decode(&event)
+		s.events = append(s.events, event)
+		json.NewEncoder(w).Encode(&event)
+	default:
+		notFound()
+	}
+}
+
+func TestEnsureCertificate(t *testing.T) {
+	fake := &fakeAPIServer{
+		certificates: map[string]*certmanagerv1.Certificate{},
+		secrets:      map[string]*v1.Secret{},
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &nginx{
+		client:     client,
+		certClient: certmanagerv1.New(client.Native().CoreV1().RESTClient()),
+		queue:      syncqueue.NewPassthroughSyncQueue(&lbapi.LoadBalancer{}, func(obj interface{}) error { return nil }),
+	}
+	defer f.queue.ShutDown()
+
+	lb := &lbapi.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb", UID: "uid"}}
+	// no certificate is deleted if it is never requested
+	if _, err := f.ensureCertificate(lb); err != nil || fake.deletes != 0 {
+		t.Fatalf("got error %v, %d deletes", err, fake.deletes)
+	}
+
+	lb.Spec.Proxy.TLS = &lbapi.ProxyTLSSpec{
+		IssuerRef: &lbapi.IssuerReference{Name: "ca", Kind: certmanagerv1.ClusterIssuerKind},
+		DNSNames:  []string{"lb.example.com"},
+	}
+
+	// certificate is requested, secret is not issued yet
+	status, err := f.ensureCertificate(lb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, ok := fake.certificates["lb-proxy-tls"]
+	if !ok {
+		t.Fatalf("certificate is not created")
+	}
+	if cert.Spec.SecretName != "lb-proxy-tls" || cert.Spec.IssuerRef.Kind != certmanagerv1.ClusterIssuerKind {
+		t.Errorf("unexpected certificate spec %+v", cert.Spec)
+	}
+	if status.Certificate != "lb-proxy-tls" || status.NotAfter != nil || status.Message == "" {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	// the issued certificate expires soon
+	notAfter := time.Now().Add(24 * time.Hour)
+	fake.secrets["lb-proxy-tls"] = &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb-proxy-tls"},
+		Data:       map[string][]byte{v1.TLSCertKey: generateCertPEM(t, notAfter)},
+	}
+	status, err = f.ensureCertificate(lb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.NotAfter == nil || !status.Expiring || status.Message != "" {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if len(fake.events) != 1 || fake.events[0].Reason != "CertificateExpiring" {
+		t.Fatalf("expected a CertificateExpiring event, got %v", fake.events)
+	}
+
+	// no more event once it is reported
+	lb.Status.ProxyStatus.TLS = status
+	if _, err = f.ensureCertificate(lb); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.events) != 1 {
+		t.Errorf("expected no more event, got %v", fake.events)
+	}
+	if f.tlsResyncs.Len() != 1 {
+		t.Errorf("expected one pending expiry check, got %v", f.tlsResyncs.List())
+	}
+
+	// switch to a user secret, the certificate is deleted
+	lb.Spec.Proxy.TLS = &lbapi.ProxyTLSSpec{SecretName: "lb-proxy-tls"}
+	status, err = f.ensureCertificate(lb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fake.certificates["lb-proxy-tls"]; ok || fake.deletes != 1 {
+		t.Errorf("certificate is not deleted")
+	}
+	if status.Certificate != "" {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
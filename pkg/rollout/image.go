@@ -0,0 +1,87 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+const (
+	// ComponentNginx is the rollout component of nginx proxy
+	ComponentNginx = "nginx"
+	// ComponentIpvsdr is the rollout component of ipvsdr provider
+	ComponentIpvsdr = "ipvsdr"
+
+	// AnnotationPriority orders LoadBalancers in rollouts, LoadBalancers with higher
+	// priority are upgraded earlier, default is 0
+	AnnotationPriority = lbapi.GroupName + "/rollout-priority"
+)
+
+// AnnotationImage is the annotation of LoadBalancer recording the image of component
+// granted by rollout
+func AnnotationImage(component string) string {
+	return fmt.Sprintf("%s/rollout-image-%s", lbapi.GroupName, component)
+}
+
+// Image returns the default image of component for lb when staged rollout is enabled.
+// It is the image granted by rollout, or the current image if lb is not granted yet,
+// a new lb without current image uses the target image.
+func Image(lb *lbapi.LoadBalancer, component, target, current string) string {
+	if granted, ok := lb.Annotations[AnnotationImage(component)]; ok && granted != "" {
+		return granted
+	}
+	if current != "" {
+		return current
+	}
+	return target
+}
+
+// CurrentImage returns the image of the container in deployments, deployments
+// which are scaled to zero are ignored if there is another one
+func CurrentImage(dps []*appsv1.Deployment, container string) string {
+	image := ""
+	for _, dp := range dps {
+		for _, c := range dp.Spec.Template.Spec.Containers {
+			if c.Name != container {
+				continue
+			}
+			if dp.Spec.Replicas == nil || *dp.Spec.Replicas != 0 {
+				return c.Image
+			}
+			if image == "" {
+				image = c.Image
+			}
+		}
+	}
+	return image
+}
+
+// CurrentDaemonSetImage returns the image of the container in daemonsets
+func CurrentDaemonSetImage(dss []*appsv1.DaemonSet, container string) string {
+	for _, ds := range dss {
+		for _, c := range ds.Spec.Template.Spec.Containers {
+			if c.Name == container {
+				return c.Image
+			}
+		}
+	}
+	return ""
+}
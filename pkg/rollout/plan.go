@@ -0,0 +1,190 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	rolloutv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/rollout/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultWaveSize                = 1
+	defaultSoakSeconds             = 300
+	defaultProgressDeadlineSeconds = 600
+)
+
+// candidate is a LoadBalancer in a rollout
+type candidate struct {
+	// key is namespace/name of the LoadBalancer
+	key string
+	// granted means the target image has been granted to the LoadBalancer
+	granted bool
+	// ready means all the replicas are ready and running the target image
+	ready bool
+}
+
+// sortLoadBalancers sorts LoadBalancers by priority annotation descending,
+// then by the value of order label, then by namespace/name
+func sortLoadBalancers(lbs []*lbapi.LoadBalancer, orderLabel string) {
+	priority := func(lb *lbapi.LoadBalancer) int {
+		p, _ := strconv.Atoi(lb.Annotations[AnnotationPriority])
+		return p
+	}
+	sort.SliceStable(lbs, func(i, j int) bool {
+		pi, pj := priority(lbs[i]), priority(lbs[j])
+		if pi != pj {
+			return pi > pj
+		}
+		if orderLabel != "" {
+			li, lj := lbs[i].Labels[orderLabel], lbs[j].Labels[orderLabel]
+			if li != lj {
+				return li < lj
+			}
+		}
+		return lbs[i].Namespace+"/"+lbs[i].Name < lbs[j].Namespace+"/"+lbs[j].Name
+	})
+}
+
+// isReady checks the replicas are ready and running the image only
+func isReady(statuses lbapi.PodStatuses, images []string, image string) bool {
+	if statuses.ReadyReplicas != statuses.Replicas {
+		return false
+	}
+	if statuses.Replicas == 0 {
+		return true
+	}
+	return len(images) == 1 && images[0] == image
+}
+
+// nextStatus moves the rollout forward by one step, candidates must be sorted.
+// It returns the new status and the keys of LoadBalancers which the target
+// image should be granted to.
+func nextStatus(spec rolloutv1alpha2.ImageRolloutSpec, status rolloutv1alpha2.ImageRolloutStatus, image string,
+	candidates []candidate, now time.Time) (rolloutv1alpha2.ImageRolloutStatus, []string) {
+
+	if status.Image != image {
+		// a new rollout
+		status = rolloutv1alpha2.ImageRolloutStatus{Image: image}
+	}
+
+	inWave := make(map[string]bool, len(status.CurrentWave))
+	for _, key := range status.CurrentWave {
+		inWave[key] = true
+	}
+
+	// new LoadBalancers running the target image are granted directly
+	grant := make([]string, 0)
+	pending := make([]string, 0)
+	byKey := make(map[string]candidate, len(candidates))
+	for _, c := range candidates {
+		byKey[c.key] = c
+		switch {
+		case c.granted:
+		case c.ready && !inWave[c.key]:
+			grant = append(grant, c.key)
+		default:
+			pending = append(pending, c.key)
+		}
+	}
+	status.Total = int32(len(candidates))
+	status.Upgraded = status.Total - int32(len(pending))
+
+	if status.Phase == rolloutv1alpha2.ImageRolloutHalted {
+		if status.HaltedAttempt == spec.Attempt {
+			return status, grant
+		}
+		// resumed by user, give current wave another chance
+		status.Phase = rolloutv1alpha2.ImageRolloutProgressing
+		status.Message = ""
+		status.WaveStartTime = &metav1.Time{Time: now}
+		status.SoakStartTime = nil
+	}
+
+	if len(status.CurrentWave) > 0 {
+		deadline := time.Duration(valueOrDefault(spec.ProgressDeadlineSeconds, defaultProgressDeadlineSeconds)) * time.Second
+		soak := time.Duration(valueOrDefault(spec.SoakSeconds, defaultSoakSeconds)) * time.Second
+
+		allReady := true
+		for _, key := range status.CurrentWave {
+			c, ok := byKey[key]
+			if !ok || c.ready {
+				// deleted LoadBalancer is ignored
+				continue
+			}
+			allReady = false
+			if status.WaveStartTime != nil && now.Sub(status.WaveStartTime.Time) > deadline {
+				status.Phase = rolloutv1alpha2.ImageRolloutHalted
+				status.HaltedAttempt = spec.Attempt
+				status.SoakStartTime = nil
+				status.Message = fmt.Sprintf("LoadBalancer %s failed readiness in %v, change spec.attempt to resume", key, deadline)
+				return status, grant
+			}
+		}
+		if !allReady {
+			status.Phase = rolloutv1alpha2.ImageRolloutProgressing
+			status.SoakStartTime = nil
+			return status, grant
+		}
+		if status.SoakStartTime == nil {
+			status.SoakStartTime = &metav1.Time{Time: now}
+		}
+		if now.Sub(status.SoakStartTime.Time) < soak {
+			status.Phase = rolloutv1alpha2.ImageRolloutSoaking
+			return status, grant
+		}
+		// current wave is done
+		status.CurrentWave = nil
+		status.WaveStartTime = nil
+		status.SoakStartTime = nil
+	}
+
+	if len(pending) == 0 {
+		status.Phase = rolloutv1alpha2.ImageRolloutCompleted
+		status.Message = ""
+		return status, grant
+	}
+	if spec.Paused {
+		status.Phase = rolloutv1alpha2.ImageRolloutPaused
+		return status, grant
+	}
+
+	size := int(valueOrDefault(spec.WaveSize, defaultWaveSize))
+	if size > len(pending) {
+		size = len(pending)
+	}
+	status.Wave++
+	status.CurrentWave = pending[:size]
+	status.WaveStartTime = &metav1.Time{Time: now}
+	status.Phase = rolloutv1alpha2.ImageRolloutProgressing
+	status.Message = ""
+	status.Upgraded += int32(size)
+	return status, append(grant, status.CurrentWave...)
+}
+
+func valueOrDefault(v, d int32) int32 {
+	if v <= 0 {
+		return d
+	}
+	return v
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rollout upgrades LoadBalancers in waves when the images of proxies and
+// providers set by controller flags change. Plugins keep the current image of a
+// LoadBalancer until the rollout grants it the new image by an annotation, and the
+// progress is recorded in the cluster-scoped ImageRollout of each component.
+package rollout
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	rolloutv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/rollout/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/config"
+	lbutil "github.com/caicloud/loadbalancer-controller/pkg/util/lb"
+
+	v1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	log "k8s.io/klog"
+)
+
+// component is a proxy or provider whose image is rolled out
+type component struct {
+	name  string
+	image string
+	// selected returns true if lb uses the component, and its image is not pinned
+	selected func(lb *lbapi.LoadBalancer) bool
+	// ready returns true if the replicas of component in lb are ready and running the image
+	ready func(lb *lbapi.LoadBalancer, image string) bool
+}
+
+// Controller runs the rollouts of components
+type Controller struct {
+	enabled      bool
+	resyncPeriod time.Duration
+
+	client        kubernetes.Interface
+	rolloutClient *rolloutv1alpha2.Client
+	lbLister      lblisters.LoadBalancerLister
+	components    []component
+}
+
+// NewController creates a new rollout controller
+func NewController(cfg config.Configuration, factory informers.SharedInformerFactory) *Controller {
+	return &Controller{
+		enabled:       cfg.Rollout.Enabled,
+		resyncPeriod:  cfg.Rollout.ResyncPeriod,
+		client:        cfg.Client,
+		rolloutClient: rolloutv1alpha2.New(cfg.Client.Custom().LoadbalanceV1alpha2().RESTClient()),
+		lbLister:      factory.Custom().Loadbalance().V1alpha2().LoadBalancers().Lister(),
+		components: []component{
+			{
+				name:  ComponentNginx,
+				image: cfg.Proxies.Nginx.Image,
+				selected: func(lb *lbapi.LoadBalancer) bool {
+					proxy := lb.Spec.Proxy
+					return proxy.Type == lbapi.ProxyTypeNginx && proxy.Image == "" && proxy.Version == ""
+				},
+				ready: func(lb *lbapi.LoadBalancer, image string) bool {
+					status := lb.Status.ProxyStatus
+					return isReady(status.PodStatuses, status.RunningImages, image)
+				},
+			},
+			{
+				name:  ComponentIpvsdr,
+				image: cfg.Providers.Ipvsdr.Image,
+				selected: func(lb *lbapi.LoadBalancer) bool {
+					ipvsdr := lb.Spec.Providers.Ipvsdr
+					return ipvsdr != nil && ipvsdr.Image == "" && ipvsdr.Version == ""
+				},
+				ready: func(lb *lbapi.LoadBalancer, image string) bool {
+					status := lb.Status.ProvidersStatuses.Ipvsdr
+					return status != nil && isReady(status.PodStatuses, status.RunningImages, image)
+				},
+			},
+		},
+	}
+}
+
+// Enabled returns true if staged rollout is enabled
+func (c *Controller) Enabled() bool {
+	return c.enabled
+}
+
+// Run starts the rollouts, it does nothing if staged rollout is disabled
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	if !c.enabled {
+		log.Info("Staged image rollout is disabled")
+		return
+	}
+	if err := c.ensureResource(); err != nil {
+		log.Errorf("Ensure ImageRollout resource error: %v", err)
+		return
+	}
+	log.Info("Startting image rollout controller")
+	go wait.Until(c.resync, c.resyncPeriod, stopCh)
+}
+
+func (c *Controller) resync() {
+	lbs, err := c.lbLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("List LoadBalancers error: %v", err)
+		return
+	}
+	for _, comp := range c.components {
+		if err := c.sync(comp, lbs); err != nil {
+			log.Errorf("Sync %v image rollout error: %v", comp.name, err)
+		}
+	}
+}
+
+// sync moves the rollout of component forward by one step
+func (c *Controller) sync(comp component, lbs []*lbapi.LoadBalancer) error {
+	rollout, err := c.ensureImageRollout(comp.name)
+	if err != nil {
+		return err
+	}
+
+	selected := make([]*lbapi.LoadBalancer, 0)
+	for _, lb := range lbs {
+		if lb.DeletionTimestamp == nil && comp.selected(lb) {
+			selected = append(selected, lb)
+		}
+	}
+	sortLoadBalancers(selected, rollout.Spec.OrderLabel)
+
+	byKey := make(map[string]*lbapi.LoadBalancer, len(selected))
+	candidates := make([]candidate, 0, len(selected))
+	for _, lb := range selected {
+		key, _ := cache.MetaNamespaceKeyFunc(lb)
+		byKey[key] = lb
+		candidates = append(candidates, candidate{
+			key:     key,
+			granted: lb.Annotations[AnnotationImage(comp.name)] == comp.image,
+			ready:   comp.ready(lb, comp.image),
+		})
+	}
+
+	status, grant := nextStatus(rollout.Spec, rollout.Status, comp.image, candidates, time.Now())
+	for _, key := range grant {
+		if err := c.grant(byKey[key], comp.name, comp.image); err != nil {
+			return err
+		}
+	}
+
+	if reflect.DeepEqual(rollout.Status, status) {
+		return nil
+	}
+	if status.Phase != rollout.Status.Phase && status.Phase == rolloutv1alpha2.ImageRolloutHalted {
+		log.Warningf("Image rollout %v is halted: %v", comp.name, status.Message)
+	}
+	rollout.Status = status
+	_, err = c.rolloutClient.UpdateImageRolloutStatus(rollout)
+	return err
+}
+
+// grant sets the image of component on lb, the plugin upgrades lb in the next sync
+func (c *Controller) grant(lb *lbapi.LoadBalancer, name, image string) error {
+	log.Infof("Grant %v image %v to loadbalancer %v/%v", name, image, lb.Namespace, lb.Name)
+	_, err := lbutil.UpdateLBWithRetries(
+		c.client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+		c.lbLister,
+		lb.Namespace,
+		lb.Name,
+		func(lb *lbapi.LoadBalancer) error {
+			if lb.Annotations == nil {
+				lb.Annotations = make(map[string]string)
+			}
+			lb.Annotations[AnnotationImage(name)] = image
+			return nil
+		},
+	)
+	if err != nil {
+		log.Errorf("Grant image to loadbalancer %v/%v error: %v", lb.Namespace, lb.Name, err)
+		return err
+	}
+	lbutil.RecordEvent(c.client, lb, v1.EventTypeNormal, "ImageRolloutGranted", "%s image is upgraded to %s by rollout", name, image)
+	return nil
+}
+
+// ensureImageRollout gets the ImageRollout of component, creates it if it does not exist
+func (c *Controller) ensureImageRollout(name string) (*rolloutv1alpha2.ImageRollout, error) {
+	rollout, err := c.rolloutClient.GetImageRollout(name)
+	if !errors.IsNotFound(err) {
+		return rollout, err
+	}
+	log.Infof("Create ImageRollout %v", name)
+	return c.rolloutClient.CreateImageRollout(&rolloutv1alpha2.ImageRollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	})
+}
+
+// ensureResource creates the CustomResourceDefinition of ImageRollout
+func (c *Controller) ensureResource() error {
+	xPreserveUnknownFields := true
+	crd := &apiextensions.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: rolloutv1alpha2.Plural + "." + rolloutv1alpha2.GroupName,
+		},
+		Spec: apiextensions.CustomResourceDefinitionSpec{
+			Group: rolloutv1alpha2.GroupName,
+			Scope: apiextensions.ClusterScoped,
+			Names: apiextensions.CustomResourceDefinitionNames{
+				Plural:   rolloutv1alpha2.Plural,
+				Singular: "imagerollout",
+				Kind:     rolloutv1alpha2.Kind,
+				ListKind: rolloutv1alpha2.Kind + "List",
+			},
+			Versions: []apiextensions.CustomResourceDefinitionVersion{
+				{
+					Name: rolloutv1alpha2.Version,
+					AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+						{
+							Name:     "IMAGE",
+							Type:     "string",
+							JSONPath: ".status.image",
+						},
+						{
+							Name:     "PHASE",
+							Type:     "string",
+							JSONPath: ".status.phase",
+						},
+						{
+							Name:     "UPGRADED",
+							Type:     "integer",
+							JSONPath: ".status.upgraded",
+						},
+						{
+							Name:     "TOTAL",
+							Type:     "integer",
+							JSONPath: ".status.total",
+						},
+					},
+					Schema: &apiextensions.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensions.JSONSchemaProps{
+							Type:                   "object",
+							XPreserveUnknownFields: &xPreserveUnknownFields,
+						},
+					},
+					Subresources: &apiextensions.CustomResourceSubresources{
+						Status: &apiextensions.CustomResourceSubresourceStatus{},
+					},
+					Served:  true,
+					Storage: true,
+				},
+			},
+		},
+	}
+	_, err := c.client.Apiextensions().ApiextensionsV1().CustomResourceDefinitions().Create(crd)
+	if errors.IsAlreadyExists(err) {
+		log.Info("Skip the creation for CustomResourceDefinition ImageRollout because it has already been created")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Info("Create CustomResourceDefinition ImageRollout successfully")
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	rolloutv1alpha2 "github.com/caicloud/loadbalancer-controller/pkg/apis/rollout/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSortLoadBalancers(t *testing.T) {
+	newLB := func(name, priority, tier string) *lbapi.LoadBalancer {
+		lb := &lbapi.LoadBalancer{}
+		lb.Namespace = "default"
+		lb.Name = name
+		if priority != "" {
+			lb.Annotations = map[string]string{AnnotationPriority: priority}
+		}
+		if tier != "" {
+			lb.Labels = map[string]string{"tier": tier}
+		}
+		return lb
+	}
+	lbs := []*lbapi.LoadBalancer{
+		newLB("d", "", "2"),
+		newLB("c", "", "1"),
+		newLB("b", "", "1"),
+		newLB("canary", "10", "3"),
+	}
+	sortLoadBalancers(lbs, "tier")
+
+	got := make([]string, 0, len(lbs))
+	for _, lb := range lbs {
+		got = append(got, lb.Name)
+	}
+	want := []string{"canary", "b", "c", "d"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sortLoadBalancers() = %v, want %v", got, want)
+	}
+}
+
+func TestNextStatus(t *testing.T) {
+	image := "nginx:v2"
+	spec := rolloutv1alpha2.ImageRolloutSpec{WaveSize: 2, SoakSeconds: 60, ProgressDeadlineSeconds: 300}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// a new rollout starts the first wave, the new lb already on target is granted directly
+	candidates := []candidate{
+		{key: "default/a"},
+		{key: "default/b"},
+		{key: "default/c"},
+		{key: "default/new", ready: true},
+	}
+	status, grant := nextStatus(spec, rolloutv1alpha2.ImageRolloutStatus{Image: "nginx:v1", Phase: rolloutv1alpha2.ImageRolloutCompleted}, image, candidates, start)
+	if status.Image != image || status.Wave != 1 || status.Phase != rolloutv1alpha2.ImageRolloutProgressing {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	if want := []string{"default/new", "default/a", "default/b"}; !reflect.DeepEqual(grant, want) {
+		t.Errorf("grant = %v, want %v", grant, want)
+	}
+	if status.Total != 4 || status.Upgraded != 3 {
+		t.Errorf("upgraded %d/%d, want 3/4", status.Upgraded, status.Total)
+	}
+
+	// wave is not ready
+	candidates = []candidate{
+		{key: "default/a", granted: true, ready: true},
+		{key: "default/b", granted: true},
+		{key: "default/c"},
+		{key: "default/new", granted: true, ready: true},
+	}
+	status, grant = nextStatus(spec, status, image, candidates, start.Add(time.Minute))
+	if status.Phase != rolloutv1alpha2.ImageRolloutProgressing || len(grant) != 0 {
+		t.Fatalf("unexpected status %+v, grant %v", status, grant)
+	}
+
+	// wave is ready and soaking
+	candidates[1].ready = true
+	status, _ = nextStatus(spec, status, image, candidates, start.Add(2*time.Minute))
+	if status.Phase != rolloutv1alpha2.ImageRolloutSoaking || status.SoakStartTime == nil {
+		t.Fatalf("unexpected status %+v", status)
+	}
+	status, grant = nextStatus(spec, status, image, candidates, start.Add(150*time.Second))
+	if status.Phase != rolloutv1alpha2.ImageRolloutSoaking || len(grant) != 0 {
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	// soaked, next wave starts
+	status, grant = nextStatus(spec, status, image, candidates, start.Add(3*time.Minute))
+	if status.Wave != 2 || !reflect.DeepEqual(grant, []string{"default/c"}) || status.Upgraded != 4 {
+		t.Fatalf("unexpected status %+v, grant %v", status, grant)
+	}
+
+	// c fails readiness after deadline, rollout is halted
+	candidates[2].granted = true
+	halted, _ := nextStatus(spec, status, image, candidates, start.Add(9*time.Minute))
+	if halted.Phase != rolloutv1alpha2.ImageRolloutHalted || halted.Message == "" {
+		t.Fatalf("expected halted, got %+v", halted)
+	}
+	candidates[2].ready = true
+	if s, _ := nextStatus(spec, halted, image, candidates, start.Add(10*time.Minute)); s.Phase != rolloutv1alpha2.ImageRolloutHalted {
+		t.Fatalf("expected still halted, got %+v", s)
+	}
+
+	// resumed by changing attempt
+	spec.Attempt++
+	status, _ = nextStatus(spec, halted, image, candidates, start.Add(11*time.Minute))
+	if status.Phase != rolloutv1alpha2.ImageRolloutSoaking {
+		t.Fatalf("expected soaking, got %+v", status)
+	}
+	status.SoakStartTime = &metav1.Time{Time: start}
+	status, _ = nextStatus(spec, status, image, candidates, start.Add(12*time.Minute))
+	if status.Phase != rolloutv1alpha2.ImageRolloutCompleted || len(status.CurrentWave) != 0 {
+		t.Fatalf("expected completed, got %+v", status)
+	}
+}
+
+func TestNextStatusPaused(t *testing.T) {
+	spec := rolloutv1alpha2.ImageRolloutSpec{Paused: true}
+	status, grant := nextStatus(spec, rolloutv1alpha2.ImageRolloutStatus{}, "nginx:v2", []candidate{{key: "default/a"}}, time.Now())
+	if status.Phase != rolloutv1alpha2.ImageRolloutPaused || len(grant) != 0 {
+		t.Errorf("unexpected status %+v, grant %v", status, grant)
+	}
+}
+
+func TestImage(t *testing.T) {
+	lb := &lbapi.LoadBalancer{}
+	if got := Image(lb, ComponentNginx, "nginx:v2", ""); got != "nginx:v2" {
+		t.Errorf("new lb should use target image, got %v", got)
+	}
+	if got := Image(lb, ComponentNginx, "nginx:v2", "nginx:v1"); got != "nginx:v1" {
+		t.Errorf("lb not granted should keep current image, got %v", got)
+	}
+	lb.Annotations = map[string]string{AnnotationImage(ComponentNginx): "nginx:v2"}
+	if got := Image(lb, ComponentNginx, "nginx:v3", "nginx:v1"); got != "nginx:v2" {
+		t.Errorf("lb should use granted image, got %v", got)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"github.com/caicloud/clientset/kubernetes"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	log "k8s.io/klog"
+)
+
+// EnsureDaemonSet creates the desired daemonset of lb or updates the existing one,
+// the other daemonsets in dss are deleted
+func EnsureDaemonSet(client kubernetes.Interface, lb *lbapi.LoadBalancer, desired *appsv1.DaemonSet, dss []*appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
+	var current *appsv1.DaemonSet
+	for _, ds := range dss {
+		if ds.Name != desired.Name || current != nil {
+			// unexpected daemonset
+			if err := DeleteDaemonSets(client, ds); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		current = ds
+		// do not change daemonset if the loadbalancer is static
+		if IsStatic(lb) {
+			continue
+		}
+		merged, changed := MergeDaemonSet(ds, desired)
+		if !changed {
+			continue
+		}
+		log.Infof("Sync daemonset %v for loadbalancer %v", ds.Name, lb.Name)
+		updated, err := client.Native().AppsV1().DaemonSets(lb.Namespace).Update(merged)
+		if err != nil {
+			return nil, err
+		}
+		current = updated
+	}
+
+	if current != nil {
+		return current, nil
+	}
+	log.Infof("Create daemonset %v for loadbalancer %v", desired.Name, lb.Name)
+	return client.Native().AppsV1().DaemonSets(lb.Namespace).Create(desired)
+}
+
+// DeleteDeployments deletes the given deployments and their pods
+func DeleteDeployments(client kubernetes.Interface, dps ...*appsv1.Deployment) error {
+	policy := metav1.DeletePropagationForeground
+	gracePeriodSeconds := int64(30)
+	for _, d := range dps {
+		err := client.Native().AppsV1().Deployments(d.Namespace).Delete(d.Name, &metav1.DeleteOptions{
+			GracePeriodSeconds: &gracePeriodSeconds,
+			PropagationPolicy:  &policy,
+		})
+		if err != nil {
+			log.Errorf("Delete deployment %v/%v error: %v", d.Namespace, d.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteDaemonSets deletes the given daemonsets and their pods
+func DeleteDaemonSets(client kubernetes.Interface, dss ...*appsv1.DaemonSet) error {
+	policy := metav1.DeletePropagationForeground
+	gracePeriodSeconds := int64(30)
+	for _, ds := range dss {
+		err := client.Native().AppsV1().DaemonSets(ds.Namespace).Delete(ds.Name, &metav1.DeleteOptions{
+			GracePeriodSeconds: &gracePeriodSeconds,
+			PropagationPolicy:  &policy,
+		})
+		if err != nil {
+			log.Errorf("Delete daemonset %v/%v error: %v", ds.Namespace, ds.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// DaemonSetReplicas returns the desired replicas of pods managed by daemonset,
+// the number of selected nodes is used before the daemonset is observed
+func DaemonSetReplicas(lb *lbapi.LoadBalancer, ds *appsv1.DaemonSet) int32 {
+	if ds != nil && ds.Status.ObservedGeneration > 0 {
+		return ds.Status.DesiredNumberScheduled
+	}
+	replicas, _ := CalculateReplicas(lb)
+	return replicas
+}
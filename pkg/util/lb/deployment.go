@@ -3,12 +3,18 @@ package lb
 import (
 	"github.com/caicloud/loadbalancer-controller/pkg/kubelab"
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // MergeDeployment merges fields in src into dst, and igornes some fields
-// by default for debugging
-func MergeDeployment(dst, src *appsv1.Deployment) (*appsv1.Deployment, bool) {
+// by default for debugging. If ignoreReplicas is true, the replicas of dst
+// are kept, it is used when the deployment is scaled by an autoscaler.
+func MergeDeployment(dst, src *appsv1.Deployment, ignoreReplicas bool) (*appsv1.Deployment, bool) {
 	dstcopy := dst.DeepCopy()
+	if ignoreReplicas {
+		src = src.DeepCopy()
+		src.Spec.Replicas = dst.Spec.Replicas
+	}
 	helper := kubelab.New().Apps().V1().Deployments()
 
 	_ = helper.Merge(dstcopy, src)
@@ -23,3 +29,53 @@ func MergeDeployment(dst, src *appsv1.Deployment) (*appsv1.Deployment, bool) {
 	})
 	return dstcopy, !equal
 }
+
+// MergeDaemonSet merges fields in src into dst, and igornes some fields
+// by default for debugging
+func MergeDaemonSet(dst, src *appsv1.DaemonSet) (*appsv1.DaemonSet, bool) {
+	dstcopy := dst.DeepCopy()
+	helper := kubelab.New().Apps().V1().DaemonSets()
+
+	_ = helper.Merge(dstcopy, src)
+
+	equal := helper.IsEqual(dst, dstcopy, func(in *appsv1.DaemonSet) {
+		// ignore some fields for debug
+		for i := range in.Spec.Template.Spec.Containers {
+			in.Spec.Template.Spec.Containers[i].Args = nil
+			in.Spec.Template.Spec.Containers[i].LivenessProbe = nil
+			in.Spec.Template.Spec.Containers[i].ReadinessProbe = nil
+		}
+	})
+	return dstcopy, !equal
+}
+
+// GenerateDaemonSet generates a DaemonSet named name from the desired deployment.
+// The DaemonSet runs one pod on every node selected by the node affinity of
+// deployment, so the pod anti-affinity is dropped.
+func GenerateDaemonSet(deploy *appsv1.Deployment, name string) *appsv1.DaemonSet {
+	// the same as the rolling update of deployment, 25% is the default of apps/v1
+	maxUnavailable := intstr.FromString("25%")
+	if deploy.Spec.Strategy.RollingUpdate != nil && deploy.Spec.Strategy.RollingUpdate.MaxUnavailable != nil {
+		maxUnavailable = *deploy.Spec.Strategy.RollingUpdate.MaxUnavailable
+	}
+	template := deploy.Spec.Template.DeepCopy()
+	if template.Spec.Affinity != nil {
+		template.Spec.Affinity.PodAntiAffinity = nil
+	}
+
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: *deploy.ObjectMeta.DeepCopy(),
+		Spec: appsv1.DaemonSetSpec{
+			Selector: deploy.Spec.Selector.DeepCopy(),
+			Template: *template,
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+		},
+	}
+	ds.Name = name
+	return ds
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestGenerateDaemonSet(t *testing.T) {
+	labels := map[string]string{"app": "lb"}
+	replicas := int32(3)
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "lb-proxy-nginx-abcde",
+			Labels: labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: v1.PodSpec{
+					Affinity: &v1.Affinity{
+						NodeAffinity:    &v1.NodeAffinity{},
+						PodAntiAffinity: &v1.PodAntiAffinity{},
+					},
+					Containers: []v1.Container{{Name: "proxy", Image: "nginx"}},
+				},
+			},
+		},
+	}
+
+	ds := GenerateDaemonSet(deploy, "lb-proxy-nginx")
+	if ds.Name != "lb-proxy-nginx" {
+		t.Errorf("expected name lb-proxy-nginx, got %v", ds.Name)
+	}
+	if ds.Spec.Selector.MatchLabels["app"] != "lb" || ds.Labels["app"] != "lb" {
+		t.Errorf("expected labels of deployment, got %v", ds.Labels)
+	}
+	affinity := ds.Spec.Template.Spec.Affinity
+	if affinity.NodeAffinity == nil || affinity.PodAntiAffinity != nil {
+		t.Errorf("expected node affinity only, got %v", affinity)
+	}
+	if ds.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType {
+		t.Errorf("expected rolling update, got %v", ds.Spec.UpdateStrategy.Type)
+	}
+	if got := ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable.String(); got != "25%" {
+		t.Errorf("expected default maxUnavailable of deployment, got %v", got)
+	}
+	if deploy.Spec.Template.Spec.Affinity.PodAntiAffinity == nil {
+		t.Errorf("deployment should not be changed")
+	}
+
+	two := intstr.FromInt(2)
+	deploy.Spec.Strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{MaxUnavailable: &two}
+	ds = GenerateDaemonSet(deploy, "lb-proxy-nginx")
+	if got := ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable.String(); got != "2" {
+		t.Errorf("expected maxUnavailable of deployment, got %v", got)
+	}
+}
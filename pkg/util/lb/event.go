@@ -0,0 +1,175 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/caicloud/clientset/kubernetes"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	log "k8s.io/klog"
+)
+
+const (
+	// EventComponent is the source component of events
+	EventComponent = "loadbalancer-controller"
+	// eventAggregationWindow is the max interval of the same events aggregated into one
+	eventAggregationWindow = 10 * time.Minute
+)
+
+// eventKey identifies the same events of a loadbalancer
+type eventKey struct {
+	uid       types.UID
+	eventType string
+	reason    string
+	message   string
+}
+
+// recordedEvents caches the events created recently, the same events are
+// aggregated by increasing their count like client-go's event correlator,
+// which is not vendored.
+var recordedEvents = struct {
+	sync.Mutex
+	events map[eventKey]*v1.Event
+}{events: make(map[eventKey]*v1.Event)}
+
+// RecordEvent creates an event for the loadbalancer, or increases the count of
+// the same event created in the last 10 minutes. The clientset has no event
+// recorder, so the event is written directly and errors are only logged.
+func RecordEvent(client kubernetes.Interface, lb *lbapi.LoadBalancer, eventType, reason, messageFmt string, args ...interface{}) {
+	now := metav1.NewTime(time.Now())
+	message := fmt.Sprintf(messageFmt, args...)
+	key := eventKey{eventUID(lb), eventType, reason, message}
+
+	recordedEvents.Lock()
+	defer recordedEvents.Unlock()
+	for k, e := range recordedEvents.events {
+		if now.Sub(e.LastTimestamp.Time) > eventAggregationWindow {
+			delete(recordedEvents.events, k)
+		}
+	}
+
+	if last, ok := recordedEvents.events[key]; ok {
+		event := last.DeepCopy()
+		event.Count++
+		event.LastTimestamp = now
+		patch, _ := json.Marshal(map[string]interface{}{
+			"count":         event.Count,
+			"lastTimestamp": event.LastTimestamp,
+		})
+		_, err := client.Native().CoreV1().Events(lb.Namespace).Patch(event.Name, types.StrategicMergePatchType, patch)
+		if err == nil {
+			recordedEvents.events[key] = event
+			return
+		}
+		if !errors.IsNotFound(err) {
+			log.Errorf("Update event %v for loadbalancer %v/%v error: %v", reason, lb.Namespace, lb.Name, err)
+			return
+		}
+		// the event has expired, create a new one
+	}
+
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", lb.Name, now.UnixNano()),
+			Namespace: lb.Namespace,
+		},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion:      api.ControllerKind.GroupVersion().String(),
+			Kind:            api.ControllerKind.Kind,
+			Namespace:       lb.Namespace,
+			Name:            lb.Name,
+			UID:             lb.UID,
+			ResourceVersion: lb.ResourceVersion,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Source: v1.EventSource{
+			Component: EventComponent,
+		},
+	}
+	if _, err := client.Native().CoreV1().Events(lb.Namespace).Create(event); err != nil {
+		log.Errorf("Create event %v for loadbalancer %v/%v error: %v", reason, lb.Namespace, lb.Name, err)
+		return
+	}
+	recordedEvents.events[key] = event
+}
+
+// lastEvents are the messages of events recorded by RecordEventOnChange by the
+// uid of loadbalancer and key, a new loadbalancer with the same name starts over
+var lastEvents = struct {
+	sync.Mutex
+	messages map[types.UID]map[string]string
+}{messages: make(map[types.UID]map[string]string)}
+
+// eventUID returns the uid of lb which events are cached by
+func eventUID(lb *lbapi.LoadBalancer) types.UID {
+	if lb.UID == "" {
+		return types.UID(lb.Namespace + "/" + lb.Name)
+	}
+	return lb.UID
+}
+
+// RecordEventOnChange records the event only if its message differs from the last one
+// recorded for the key of lb, it is used for the decisions retried until lb changes
+func RecordEventOnChange(client kubernetes.Interface, lb *lbapi.LoadBalancer, key, eventType, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	uid := eventUID(lb)
+	lastEvents.Lock()
+	if lastEvents.messages[uid] == nil {
+		lastEvents.messages[uid] = make(map[string]string)
+	}
+	changed := lastEvents.messages[uid][key] != message
+	lastEvents.messages[uid][key] = message
+	lastEvents.Unlock()
+	if changed {
+		RecordEvent(client, lb, eventType, reason, "%s", message)
+	}
+}
+
+// ForgetEvent forgets the last event recorded for the key of lb, so the event
+// is recorded again when the decision changes back
+func ForgetEvent(lb *lbapi.LoadBalancer, key string) {
+	uid := eventUID(lb)
+	lastEvents.Lock()
+	delete(lastEvents.messages[uid], key)
+	if len(lastEvents.messages[uid]) == 0 {
+		delete(lastEvents.messages, uid)
+	}
+	lastEvents.Unlock()
+}
+
+// ForgetEvents forgets the last events of all keys of lb, plugins call it when
+// lb is deleted
+func ForgetEvents(lb *lbapi.LoadBalancer) {
+	lastEvents.Lock()
+	delete(lastEvents.messages, eventUID(lb))
+	lastEvents.Unlock()
+}
@@ -19,103 +19,143 @@ package lb
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
 	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
-	controllerutil "github.com/caicloud/clientset/util/controller"
 	"github.com/caicloud/clientset/util/syncqueue"
 	"github.com/caicloud/loadbalancer-controller/pkg/api"
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
-	appslisters "k8s.io/client-go/listers/apps/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	log "k8s.io/klog"
 )
 
-var _ cache.ResourceEventHandler = &EventHandlerForDeployment{}
+var _ cache.ResourceEventHandler = &EventHandlerForWorkload{}
 var _ cache.ResourceEventHandler = &EventHandlerForSyncStatusWithPod{}
+var _ cache.ResourceEventHandler = &EventHandlerForNode{}
 
 type filterDeploymentFunc func(obj *appsv1.Deployment) bool
+type filterDaemonSetFunc func(obj *appsv1.DaemonSet) bool
+type filterWorkloadFunc func(obj metav1.Object) bool
 type filterPodFunc func(obj *v1.Pod) bool
-
-// EventHandlerForDeployment helps you create a event handler to handle with
-// deployments event quickly, makes you focus on you own code
-type EventHandlerForDeployment struct {
+type filterLoadBalancerFunc func(obj *lbapi.LoadBalancer) bool
+
+// EventHandlerForWorkload helps you create a event handler to handle with
+// workloads event quickly, such as deployments and daemonsets, makes you
+// focus on you own code
+type EventHandlerForWorkload struct {
+	// kind of the workload, only used in logs
+	kind  string
 	queue *syncqueue.SyncQueue
 
 	lbLister lblisters.LoadBalancerLister
-	dLister  appslisters.DeploymentLister
 
-	filtered filterDeploymentFunc
+	filtered filterWorkloadFunc
 }
 
+// EventHandlerForDeployment is the event handler for deployments
+type EventHandlerForDeployment = EventHandlerForWorkload
+
 // NewEventHandlerForDeployment ...
 func NewEventHandlerForDeployment(
 	lbLister lblisters.LoadBalancerLister,
-	dLister appslisters.DeploymentLister,
 	queue *syncqueue.SyncQueue,
 	filterFunc filterDeploymentFunc,
 ) *EventHandlerForDeployment {
-	return &EventHandlerForDeployment{
+	return &EventHandlerForWorkload{
+		kind:     "Deployment",
 		queue:    queue,
 		lbLister: lbLister,
-		dLister:  dLister,
-		filtered: filterFunc,
+		filtered: func(obj metav1.Object) bool {
+			d, ok := obj.(*appsv1.Deployment)
+			return !ok || filterFunc(d)
+		},
+	}
+}
+
+// NewEventHandlerForDaemonSet ...
+func NewEventHandlerForDaemonSet(
+	lbLister lblisters.LoadBalancerLister,
+	queue *syncqueue.SyncQueue,
+	filterFunc filterDaemonSetFunc,
+) *EventHandlerForWorkload {
+	return &EventHandlerForWorkload{
+		kind:     "DaemonSet",
+		queue:    queue,
+		lbLister: lbLister,
+		filtered: func(obj metav1.Object) bool {
+			ds, ok := obj.(*appsv1.DaemonSet)
+			return !ok || filterFunc(ds)
+		},
 	}
 }
 
 // OnAdd ...
-func (eh *EventHandlerForDeployment) OnAdd(obj interface{}) {
-	d := obj.(*appsv1.Deployment)
+func (eh *EventHandlerForWorkload) OnAdd(obj interface{}) {
+	d, err := meta.Accessor(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
 
-	if d.DeletionTimestamp != nil {
+	if d.GetDeletionTimestamp() != nil {
 		// On a restart of the controller manager, it's possible for an object to
 		// show up in a state that is already pending deletion.
-		eh.OnDelete(d)
+		eh.OnDelete(obj)
 		return
 	}
 
-	// filter Deployment that controller does not care
-	// this Deployment maybe controlled by other proxy
+	// filter workload that controller does not care
+	// this workload maybe controlled by other proxy
 	if eh.filtered(d) {
 		return
 	}
 
 	// If it has a ControllerRef, that's all that matters.
-	if controllerRef := controllerutil.GetControllerOf(d); controllerRef != nil {
-		lb := eh.resolveControllerRef(d.Namespace, controllerRef)
+	if controllerRef := metav1.GetControllerOf(d); controllerRef != nil {
+		lb := eh.resolveControllerRef(d.GetNamespace(), controllerRef)
 		if lb == nil {
 			return
 		}
-		log.Infof("Deployment %v added, belongs to loadbalancer %v", d.Name, lb.Name)
+		log.Infof("%v %v added, belongs to loadbalancer %v", eh.kind, d.GetName(), lb.Name)
 		eh.queue.Enqueue(lb)
 		return
 	}
 
-	// Otherwise, it's an orphan. Get a matching LoadBalancer for deployment
+	// Otherwise, it's an orphan. Get a matching LoadBalancer for workload
 	lb, err := eh.lbLister.GetLoadBalancerForControllee(d)
 	if err != nil {
-		log.Errorf("Can not get loadbalancer for orpha Deployment %v, ignore it, deployment's labels %v", d.Name, d.Labels)
+		log.Errorf("Can not get loadbalancer for orpha %v %v, ignore it, labels %v", eh.kind, d.GetName(), d.GetLabels())
 		return
 	}
-	log.Infof("Orphan Deployment %v added", d.Name)
+	log.Infof("Orphan %v %v added", eh.kind, d.GetName())
 	eh.queue.Enqueue(lb)
 
 }
 
 // OnUpdate ...
-func (eh *EventHandlerForDeployment) OnUpdate(oldObj, curObj interface{}) {
+func (eh *EventHandlerForWorkload) OnUpdate(oldObj, curObj interface{}) {
 
-	old := oldObj.(*appsv1.Deployment)
-	cur := curObj.(*appsv1.Deployment)
+	old, err := meta.Accessor(oldObj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	cur, err := meta.Accessor(curObj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
 
-	if old.ResourceVersion == cur.ResourceVersion {
+	if old.GetResourceVersion() == cur.GetResourceVersion() {
 		// Periodic resync will send update events for all known LoadBalancer.
 		// Two different versions of the same LoadBalancer will always have different RVs.
 		return
@@ -129,46 +169,46 @@ func (eh *EventHandlerForDeployment) OnUpdate(oldObj, curObj interface{}) {
 		return
 	}
 
-	curControllerRef := controllerutil.GetControllerOf(cur)
-	oldControllerRef := controllerutil.GetControllerOf(old)
+	curControllerRef := metav1.GetControllerOf(cur)
+	oldControllerRef := metav1.GetControllerOf(old)
 	controllerRefChanged := !reflect.DeepEqual(curControllerRef, oldControllerRef)
 
 	// do not sync deletion update
-	if !oldfiltered && old.DeletionTimestamp != nil {
+	if !oldfiltered && old.GetDeletionTimestamp() != nil {
 		// if controller changed and this proxy is interested in the old d, sync it
 		if controllerRefChanged && oldControllerRef != nil {
-			if lb := eh.resolveControllerRef(old.Namespace, oldControllerRef); lb != nil {
+			if lb := eh.resolveControllerRef(old.GetNamespace(), oldControllerRef); lb != nil {
 				// The ControllerRef was changed. Sync the old controller, if any.
-				log.Infof("Deployment updated, ControllerRef changed, sync for old controller %v/%v", old.Namespace, old.Name)
+				log.Infof("%v updated, ControllerRef changed, sync for old controller %v/%v", eh.kind, old.GetNamespace(), old.GetName())
 				eh.queue.Enqueue(lb)
 			}
 		}
 	}
 
 	// do not sync deletion update
-	if !curfiltered && cur.DeletionTimestamp != nil {
+	if !curfiltered && cur.GetDeletionTimestamp() != nil {
 		// If it has a ControllerRef and this proxy is interested in it, that's all that matters.
 		if curControllerRef != nil {
-			lb := eh.resolveControllerRef(cur.Namespace, curControllerRef)
+			lb := eh.resolveControllerRef(cur.GetNamespace(), curControllerRef)
 			if lb == nil {
 				return
 			}
-			log.Infof("Deployment %v updated", cur.Name)
+			log.Infof("%v %v updated", eh.kind, cur.GetName())
 			eh.queue.Enqueue(lb)
 			return
 		}
 
-		// Otherwise, it's an orphan. Get a list of all matching deployment and sync
+		// Otherwise, it's an orphan. Get a list of all matching workload and sync
 		// them to see if anyone wants to adopt it.
-		labelChanged := !reflect.DeepEqual(cur.Labels, old.Labels)
+		labelChanged := !reflect.DeepEqual(cur.GetLabels(), old.GetLabels())
 
 		if labelChanged || controllerRefChanged {
 			lb, err := eh.lbLister.GetLoadBalancerForControllee(cur)
 			if err != nil {
-				log.Errorf("Can not get loadbalancer for orpha Deployment %v/%v, ignore it, deployment's labels %v", cur.Namespace, cur.Name, cur.Labels)
+				log.Errorf("Can not get loadbalancer for orpha %v %v/%v, ignore it, labels %v", eh.kind, cur.GetNamespace(), cur.GetName(), cur.GetLabels())
 				return
 			}
-			log.Infof("Orphan Deployment %v updated", cur.Name)
+			log.Infof("Orphan %v %v updated", eh.kind, cur.GetName())
 			eh.queue.Enqueue(lb)
 		}
 	}
@@ -177,46 +217,40 @@ func (eh *EventHandlerForDeployment) OnUpdate(oldObj, curObj interface{}) {
 }
 
 // OnDelete ...
-func (eh *EventHandlerForDeployment) OnDelete(obj interface{}) {
-	d, ok := obj.(*appsv1.Deployment)
-
-	if !ok {
-		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
-		if !ok {
-			utilruntime.HandleError(fmt.Errorf("Couldn't get object from tombstone %#v", obj))
-			return
-		}
-		d, ok = tombstone.Obj.(*appsv1.Deployment)
-		if !ok {
-			utilruntime.HandleError(fmt.Errorf("Tombstone contained object that is not a LoadBalancer %#v", obj))
-			return
-		}
+func (eh *EventHandlerForWorkload) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	d, err := meta.Accessor(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("Couldn't get object from tombstone %#v", obj))
+		return
 	}
 
-	// filter Deployment that controller does not care
+	// filter workload that controller does not care
 	if eh.filtered(d) {
 		return
 	}
 
-	controllerRef := controllerutil.GetControllerOf(d)
+	controllerRef := metav1.GetControllerOf(d)
 	if controllerRef == nil {
 		// No controller should care about orphans being deleted.
 		return
 	}
 
-	lb := eh.resolveControllerRef(d.Namespace, controllerRef)
+	lb := eh.resolveControllerRef(d.GetNamespace(), controllerRef)
 	if lb == nil {
 		return
 	}
 
-	log.Infof("Deployment %v deleted, belongs to loadbalancer %v", d.Name, lb.Name)
+	log.Infof("%v %v deleted, belongs to loadbalancer %v", eh.kind, d.GetName(), lb.Name)
 	eh.queue.Enqueue(lb)
 }
 
 // resolveControllerRef returns the controller referenced by a ControllerRef,
 // or nil if the ControllerRef could not be resolved to a matching controller
 // of the corrrect Kind.
-func (eh *EventHandlerForDeployment) resolveControllerRef(namespace string, controllerRef *metav1.OwnerReference) *lbapi.LoadBalancer {
+func (eh *EventHandlerForWorkload) resolveControllerRef(namespace string, controllerRef *metav1.OwnerReference) *lbapi.LoadBalancer {
 	// We can't look up by UID, so look up by Name and then verify UID.
 	// Don't even try to look up by Name if it's the wrong Kind.
 	if controllerRef.Kind != api.ControllerKind.Kind {
@@ -234,16 +268,6 @@ func (eh *EventHandlerForDeployment) resolveControllerRef(namespace string, cont
 	return lb
 }
 
-// GetLoadBalancerForDeployments get a list of all matching LoadBalancer for deployment
-func (eh *EventHandlerForDeployment) GetLoadBalancerForDeployments(d *appsv1.Deployment) *lbapi.LoadBalancer {
-	lb, err := eh.lbLister.GetLoadBalancerForControllee(d)
-	if err != nil || lb == nil {
-		log.Error("Error get loadbalancers for deployments")
-		return nil
-	}
-	return lb
-}
-
 // EventHandlerForSyncStatusWithPod helps you create a event handler to sync status
 // with pod event quickly, makes you focus on you own code
 type EventHandlerForSyncStatusWithPod struct {
@@ -377,3 +401,66 @@ func (eh *EventHandlerForSyncStatusWithPod) getLoadbalancerForPod(pod *v1.Pod) *
 	}
 	return lb
 }
+
+// EventHandlerForNode helps you create a event handler to enqueue the LoadBalancers
+// running on nodes when nodes change, the nodes of a LoadBalancer have its unique label
+type EventHandlerForNode struct {
+	queue    *syncqueue.SyncQueue
+	lbLister lblisters.LoadBalancerLister
+	filtered filterLoadBalancerFunc
+}
+
+// NewEventHandlerForNode ...
+func NewEventHandlerForNode(
+	lbLister lblisters.LoadBalancerLister,
+	queue *syncqueue.SyncQueue,
+	filterFunc filterLoadBalancerFunc,
+) *EventHandlerForNode {
+	return &EventHandlerForNode{
+		queue:    queue,
+		lbLister: lbLister,
+		filtered: filterFunc,
+	}
+}
+
+// OnAdd ...
+func (eh *EventHandlerForNode) OnAdd(obj interface{}) {
+	eh.enqueueLoadBalancersOfNode(obj)
+}
+
+// OnUpdate ...
+func (eh *EventHandlerForNode) OnUpdate(oldObj, curObj interface{}) {
+	eh.enqueueLoadBalancersOfNode(oldObj)
+	eh.enqueueLoadBalancersOfNode(curObj)
+}
+
+// OnDelete ...
+func (eh *EventHandlerForNode) OnDelete(obj interface{}) {
+	eh.enqueueLoadBalancersOfNode(obj)
+}
+
+func (eh *EventHandlerForNode) enqueueLoadBalancersOfNode(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	node, ok := obj.(*v1.Node)
+	if !ok {
+		return
+	}
+	prefix := lbapi.GroupName + "/"
+	for key, value := range node.Labels {
+		if value != "true" || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		// the unique label is in the format of group/namespace.name
+		parts := strings.SplitN(strings.TrimPrefix(key, prefix), ".", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		lb, err := eh.lbLister.LoadBalancers(parts[0]).Get(parts[1])
+		if err != nil || eh.filtered(lb) {
+			continue
+		}
+		eh.queue.Enqueue(lb)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/caicloud/clientset/kubernetes"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+func TestRecordEventAggregation(t *testing.T) {
+	var mu sync.Mutex
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&v1.Event{})
+	}))
+	defer server.Close()
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lb := &lbapi.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb", UID: "aggregation"}}
+	RecordEvent(client, lb, v1.EventTypeWarning, "ImageNotAllowed", "image %v is not allowed", "a")
+	RecordEvent(client, lb, v1.EventTypeWarning, "ImageNotAllowed", "image %v is not allowed", "a")
+	RecordEvent(client, lb, v1.EventTypeWarning, "ImageNotAllowed", "image %v is not allowed", "b")
+
+	if want := []string{http.MethodPost, http.MethodPatch, http.MethodPost}; !reflect.DeepEqual(requests, want) {
+		t.Errorf("got requests %v, want %v", requests, want)
+	}
+	key := eventKey{lb.UID, v1.EventTypeWarning, "ImageNotAllowed", "image a is not allowed"}
+	if event := recordedEvents.events[key]; event == nil || event.Count != 2 {
+		t.Errorf("got event %v, want count 2", event)
+	}
+}
+
+func TestRecordEventOnChange(t *testing.T) {
+	var mu sync.Mutex
+	events := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		event := &v1.Event{}
+		_ = json.NewDecoder(r.Body).Decode(event)
+		events = append(events, r.Method+" "+event.Message)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(event)
+	}))
+	defer server.Close()
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lb := &lbapi.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb", UID: "on-change"}}
+	allowed := []string{"proxy:*"}
+	resolve := func(image string) {
+		_, _ = ResolveLoadBalancerImage(client, lb, "nginx", "proxy:v1", image, "", allowed)
+	}
+	resolve("other:v1")
+	resolve("other:v1")
+	resolve("other:v2")
+	resolve("proxy:v2")
+	resolve("other:v2")
+
+	// the same event is aggregated after the image is allowed and rejected again
+	want := []string{
+		"POST image other:v1 is not allowed by controller",
+		"POST image other:v2 is not allowed by controller",
+		"PATCH ",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("got events %v, want %v", events, want)
+	}
+
+	// a new lb with the same name does not inherit the last events
+	events = events[:0]
+	fresh := lb.DeepCopy()
+	fresh.UID = "on-change-fresh"
+	_, _ = ResolveLoadBalancerImage(client, fresh, "nginx", "proxy:v1", "other:v3", "", allowed)
+	if want := []string{"POST image other:v3 is not allowed by controller"}; !reflect.DeepEqual(events, want) {
+		t.Errorf("got events %v of new lb, want %v", events, want)
+	}
+
+	ForgetEvents(lb)
+	ForgetEvents(fresh)
+	if len(lastEvents.messages[lb.UID])+len(lastEvents.messages[fresh.UID]) != 0 {
+		t.Errorf("got last events %v after forgetting, want none", lastEvents.messages)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/caicloud/clientset/kubernetes"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// ResolveImage returns the image used by a LoadBalancer. The default image is
+// the image set by controller flags, image overrides it and version overrides
+// its tag. Overrides must be permitted by allowed images.
+func ResolveImage(defaultImage, image, version string, allowed []string) (string, error) {
+	switch {
+	case image != "":
+	case version != "":
+		image = ReplaceImageTag(defaultImage, version)
+	default:
+		return defaultImage, nil
+	}
+	if image != defaultImage && !ImageAllowed(image, allowed) {
+		return "", fmt.Errorf("image %s is not allowed by controller", image)
+	}
+	return image, nil
+}
+
+// ResolveLoadBalancerImage resolves the image of component by ResolveImage, an
+// ImageNotAllowed event is recorded when the image of component becomes not allowed
+func ResolveLoadBalancerImage(client kubernetes.Interface, lb *lbapi.LoadBalancer, component, defaultImage, image, version string, allowed []string) (string, error) {
+	key := component + "/image"
+	resolved, err := ResolveImage(defaultImage, image, version, allowed)
+	if err != nil {
+		RecordEventOnChange(client, lb, key, v1.EventTypeWarning, "ImageNotAllowed", "%v", err)
+		return "", err
+	}
+	ForgetEvent(lb, key)
+	return resolved, nil
+}
+
+// ReplaceImageTag replaces the tag or digest of image with version,
+// version starting with sha256: is used as a digest
+func ReplaceImageTag(image, version string) string {
+	repo := imageRepository(image)
+	if strings.HasPrefix(version, "sha256:") {
+		return repo + "@" + version
+	}
+	return repo + ":" + version
+}
+
+// imageRepository trims the tag and digest of image
+func imageRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	// the colon before the last slash belongs to registry port
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	return image
+}
+
+// ImageAllowed checks if the image matches one of allowed images. Allowed images
+// are glob patterns, a pattern without tag permits all tags of the repository.
+func ImageAllowed(image string, allowed []string) bool {
+	for _, pattern := range allowed {
+		target := image
+		if imageRepository(pattern) == pattern {
+			target = imageRepository(image)
+		}
+		if ok, _ := path.Match(pattern, target); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ImageOlderThan returns true if the tag of image is a version lower than minimum,
+// such as v0.3.2 and v0.4.0. Images pinned by digest or tagged by other names are
+// considered new enough since their versions are unknown.
+func ImageOlderThan(image, minimum string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	repo := imageRepository(image)
+	if repo == image {
+		return false
+	}
+	version, ok := parseVersion(image[len(repo)+1:])
+	if !ok {
+		return false
+	}
+	min, ok := parseVersion(minimum)
+	if !ok {
+		return false
+	}
+	for i := range version {
+		if version[i] != min[i] {
+			return version[i] < min[i]
+		}
+	}
+	return false
+}
+
+// parseVersion parses versions like v1.2.3, 1.2 and v1.2.3-rc.1, the pre-release is ignored
+func parseVersion(s string) ([3]int, bool) {
+	var version [3]int
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexAny(s, "-+"); i >= 0 {
+		s = s[:i]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) > len(version) {
+		return version, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return version, false
+		}
+		version[i] = n
+	}
+	return version, true
+}
+
+// RunningImages returns the sorted distinct images of the container in pods,
+// it returns nil if there is no pod
+func RunningImages(pods []*v1.Pod, container string) []string {
+	seen := make(map[string]bool)
+	var images []string
+	for _, pod := range pods {
+		for _, c := range pod.Spec.Containers {
+			if c.Name != container || seen[c.Image] {
+				continue
+			}
+			seen[c.Image] = true
+			images = append(images, c.Image)
+		}
+	}
+	sort.Strings(images)
+	return images
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"testing"
+)
+
+func TestReplaceImageTag(t *testing.T) {
+	tests := []struct {
+		image, version, want string
+	}{
+		{"nginx", "1.19", "nginx:1.19"},
+		{"cargo.caicloud.io/caicloud/nginx:0.12.0", "0.13.0", "cargo.caicloud.io/caicloud/nginx:0.13.0"},
+		{"registry:5000/nginx:0.12.0", "0.13.0", "registry:5000/nginx:0.13.0"},
+		{"registry:5000/nginx", "0.13.0", "registry:5000/nginx:0.13.0"},
+		{"nginx@sha256:abc", "sha256:def", "nginx@sha256:def"},
+		{"nginx:0.12.0", "sha256:def", "nginx@sha256:def"},
+	}
+	for _, tt := range tests {
+		if got := ReplaceImageTag(tt.image, tt.version); got != tt.want {
+			t.Errorf("ReplaceImageTag(%q, %q) = %q, want %q", tt.image, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestResolveImage(t *testing.T) {
+	defaultImage := "cargo.caicloud.io/caicloud/nginx:0.12.0"
+	allowed := []string{"cargo.caicloud.io/caicloud/nginx", "cargo.caicloud.io/canary/*:0.13.*"}
+
+	tests := []struct {
+		name, image, version, want string
+		wantErr                    bool
+	}{
+		{"default", "", "", defaultImage, false},
+		{"version of allowed repository", "", "0.13.0", "cargo.caicloud.io/caicloud/nginx:0.13.0", false},
+		{"allowed pattern", "cargo.caicloud.io/canary/nginx:0.13.1", "", "cargo.caicloud.io/canary/nginx:0.13.1", false},
+		{"not allowed tag", "cargo.caicloud.io/canary/nginx:0.14.0", "", "", true},
+		{"not allowed repository", "docker.io/nginx:latest", "", "", true},
+		{"default is always allowed", defaultImage, "", defaultImage, false},
+	}
+	for _, tt := range tests {
+		got, err := ResolveImage(defaultImage, tt.image, tt.version, allowed)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("%s: ResolveImage() = %q, %v, want %q, error %v", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if _, err := ResolveImage(defaultImage, "", "0.13.0", nil); err == nil {
+		t.Error("expected error without allowed images")
+	}
+}
+
+func TestImageOlderThan(t *testing.T) {
+	tests := []struct {
+		image string
+		want  bool
+	}{
+		{"cargo.caicloud.io/caicloud/loadbalancer-provider-ipvsdr:v0.3.2", true},
+		{"cargo.caicloud.io/caicloud/loadbalancer-provider-ipvsdr:v0.4.0", false},
+		{"cargo.caicloud.io/caicloud/loadbalancer-provider-ipvsdr:v0.10.1-rc.1", false},
+		{"registry:5000/loadbalancer-provider-ipvsdr:0.3", true},
+		{"registry:5000/loadbalancer-provider-ipvsdr", false},
+		{"loadbalancer-provider-ipvsdr:latest", false},
+		{"loadbalancer-provider-ipvsdr@sha256:abc", false},
+	}
+	for _, tt := range tests {
+		if got := ImageOlderThan(tt.image, "v0.4.0"); got != tt.want {
+			t.Errorf("ImageOlderThan(%q) = %v, want %v", tt.image, got, tt.want)
+		}
+	}
+}
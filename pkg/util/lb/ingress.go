@@ -0,0 +1,173 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	networkingv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/networking/v1"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	log "k8s.io/klog"
+)
+
+// IngressClassName returns the ingress class served by the proxy of lb
+func IngressClassName(lb *lbapi.LoadBalancer) string {
+	if lb.Status.ProxyStatus.IngressClass != "" {
+		return lb.Status.ProxyStatus.IngressClass
+	}
+	return fmt.Sprintf(lbapi.LabelValueFormatCreateby, lb.Namespace, lb.Name)
+}
+
+// IngressDeletionPolicy returns the ingress deletion policy of lb, default is Orphan
+// because Ingresses are owned by application teams rather than the loadbalancer
+func IngressDeletionPolicy(lb *lbapi.LoadBalancer) lbapi.IngressDeletionPolicy {
+	if lb.Spec.Proxy.IngressDeletionPolicy == "" {
+		return lbapi.IngressDeletionPolicyOrphan
+	}
+	return lb.Spec.Proxy.IngressDeletionPolicy
+}
+
+// CleanupIngresses handles the Ingresses of lb's proxy by spec.proxy.ingressDeletionPolicy
+// when lb is deleted. Delete deletes the Ingresses labeled as created by the class,
+// Reassign moves the Ingresses labeled with or referring to the class to the class of
+// the fallback loadbalancer, and Orphan leaves them untouched. All proxy plugins should
+// call it in cleanup.
+func CleanupIngresses(client kubernetes.Interface, lbLister lblisters.LoadBalancerLister, lb *lbapi.LoadBalancer, className string) error {
+	policy := IngressDeletionPolicy(lb)
+	if policy == lbapi.IngressDeletionPolicyOrphan {
+		RecordEvent(client, lb, v1.EventTypeNormal, "IngressesOrphaned", "Ingresses of class %s are orphaned", className)
+		return nil
+	}
+
+	networkingClient := networkingv1.New(client.Native().NetworkingV1().RESTClient())
+	switch policy {
+	case lbapi.IngressDeletionPolicyDelete:
+		selector := labels.Set{
+			// createdby ingressClass
+			lbapi.LabelKeyCreatedBy: className,
+		}
+		list, err := networkingClient.ListIngresses(metav1.NamespaceAll, metav1.ListOptions{
+			LabelSelector: selector.String(),
+		})
+		if err != nil {
+			log.Errorf("Cleanup Ingress error: %v", err)
+			return err
+		}
+		deleted := make([]string, 0)
+		for _, ing := range list.Items {
+			err = networkingClient.DeleteIngress(ing.Namespace, ing.Name)
+			if err != nil && !errors.IsNotFound(err) {
+				log.Errorf("Cleanup Ingress error: %v", err)
+				return err
+			}
+			deleted = append(deleted, ing.Namespace+"/"+ing.Name)
+		}
+		RecordEvent(client, lb, v1.EventTypeNormal, "IngressesDeleted", "Deleted %d Ingresses of class %s: %s",
+			len(deleted), className, strings.Join(deleted, ", "))
+		return nil
+
+	case lbapi.IngressDeletionPolicyReassign:
+		fallbackClass, err := fallbackIngressClass(lbLister, lb)
+		if err != nil {
+			// keep the Ingresses rather than deleting them
+			RecordEvent(client, lb, v1.EventTypeWarning, "IngressReassignFailed", "Ingresses of class %s are orphaned: %v", className, err)
+			return nil
+		}
+		// Ingresses refer to the class by annotation or spec.ingressClassName
+		// which can not be selected by labels
+		list, err := networkingClient.ListIngresses(metav1.NamespaceAll, metav1.ListOptions{})
+		if err != nil {
+			log.Errorf("Cleanup Ingress error: %v", err)
+			return err
+		}
+		reassigned := make([]string, 0)
+		for _, ing := range list.Items {
+			patch := reassignPatch(&ing, className, fallbackClass)
+			if patch == nil {
+				continue
+			}
+			_, err = networkingClient.PatchIngress(ing.Namespace, ing.Name, types.MergePatchType, patch)
+			if err != nil && !errors.IsNotFound(err) {
+				log.Errorf("Reassign Ingress %v/%v error: %v", ing.Namespace, ing.Name, err)
+				return err
+			}
+			reassigned = append(reassigned, ing.Namespace+"/"+ing.Name)
+		}
+		RecordEvent(client, lb, v1.EventTypeNormal, "IngressesReassigned", "Reassigned %d Ingresses from class %s to %s: %s",
+			len(reassigned), className, fallbackClass, strings.Join(reassigned, ", "))
+		return nil
+	}
+	return nil
+}
+
+// fallbackIngressClass returns the ingress class of lb's fallback loadbalancer
+func fallbackIngressClass(lbLister lblisters.LoadBalancerLister, lb *lbapi.LoadBalancer) (string, error) {
+	ref := lb.Spec.Proxy.IngressFallback
+	if ref == nil {
+		return "", fmt.Errorf("ingressFallback is not set")
+	}
+	if ref.Namespace == lb.Namespace && ref.Name == lb.Name {
+		return "", fmt.Errorf("ingressFallback can not be the loadbalancer itself")
+	}
+	fallback, err := lbLister.LoadBalancers(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		return "", fmt.Errorf("get fallback loadbalancer %s/%s error: %v", ref.Namespace, ref.Name, err)
+	}
+	if fallback.DeletionTimestamp != nil {
+		return "", fmt.Errorf("fallback loadbalancer %s/%s is being deleted", ref.Namespace, ref.Name)
+	}
+	return IngressClassName(fallback), nil
+}
+
+// reassignPatch returns the merge patch which moves the Ingress from class to
+// fallbackClass, or nil if the Ingress does not belong to class
+func reassignPatch(ing *networkingv1.Ingress, class, fallbackClass string) []byte {
+	metadata := make(map[string]interface{})
+	spec := make(map[string]interface{})
+	if ing.Labels[lbapi.LabelKeyCreatedBy] == class {
+		metadata["labels"] = map[string]string{lbapi.LabelKeyCreatedBy: fallbackClass}
+	}
+	if ing.Annotations[networkingv1.AnnotationIngressClass] == class {
+		metadata["annotations"] = map[string]string{networkingv1.AnnotationIngressClass: fallbackClass}
+	}
+	if ing.Spec.IngressClassName != nil && *ing.Spec.IngressClassName == class {
+		spec["ingressClassName"] = fallbackClass
+	}
+	if len(metadata) == 0 && len(spec) == 0 {
+		return nil
+	}
+
+	patch := make(map[string]interface{})
+	if len(metadata) > 0 {
+		patch["metadata"] = metadata
+	}
+	if len(spec) > 0 {
+		patch["spec"] = spec
+	}
+	bs, _ := json.Marshal(patch)
+	return bs
+}
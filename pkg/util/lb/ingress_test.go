@@ -0,0 +1,76 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	networkingv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/networking/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReassignPatch(t *testing.T) {
+	class := "default.lb"
+	other := "default.other"
+
+	tests := []struct {
+		name string
+		ing  networkingv1.Ingress
+		want string
+	}{
+		{
+			name: "labeled with spec class",
+			ing: networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{lbapi.LabelKeyCreatedBy: class}},
+				Spec:       networkingv1.IngressSpec{IngressClassName: &class},
+			},
+			want: `{"metadata":{"labels":{"loadbalance.caicloud.io/created-by":"default.fallback"}},"spec":{"ingressClassName":"default.fallback"}}`,
+		},
+		{
+			name: "annotation class",
+			ing: networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{networkingv1.AnnotationIngressClass: class}},
+			},
+			want: `{"metadata":{"annotations":{"kubernetes.io/ingress.class":"default.fallback"}}}`,
+		},
+		{
+			name: "other class",
+			ing: networkingv1.Ingress{
+				Spec: networkingv1.IngressSpec{IngressClassName: &other},
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		if got := string(reassignPatch(&tt.ing, class, "default.fallback")); got != tt.want {
+			t.Errorf("reassignPatch(%v) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIngressDeletionPolicy(t *testing.T) {
+	lb := &lbapi.LoadBalancer{}
+	if got := IngressDeletionPolicy(lb); got != lbapi.IngressDeletionPolicyOrphan {
+		t.Errorf("got default policy %v, want Orphan", got)
+	}
+	lb.Spec.Proxy.IngressDeletionPolicy = lbapi.IngressDeletionPolicyDelete
+	if got := IngressDeletionPolicy(lb); got != lbapi.IngressDeletionPolicyDelete {
+		t.Errorf("got policy %v, want Delete", got)
+	}
+}
@@ -98,6 +98,16 @@ func IpvsdrProviderStatusEqual(a, b lbapi.IpvsdrProviderStatus) bool {
 	return reflect.DeepEqual(a, b)
 }
 
+// BGPProviderStatusEqual check whether the given two Statuses are equal
+func BGPProviderStatusEqual(a, b lbapi.BGPProviderStatus) bool {
+	if !PodStatusesEqual(a.PodStatuses, b.PodStatuses) {
+		return false
+	}
+	a.PodStatuses = lbapi.PodStatuses{}
+	b.PodStatuses = lbapi.PodStatuses{}
+	return reflect.DeepEqual(a, b)
+}
+
 // ExternalProviderStatusEqual check whether the given two ExpternalProviderStatus are equal
 func ExternalProviderStatusEqual(a, b lbapi.ExpternalProviderStatus) bool {
 	return reflect.DeepEqual(a, b)
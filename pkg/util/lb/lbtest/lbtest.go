@@ -0,0 +1,88 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lbtest contains the helpers of tests of providers managing load balancers
+// outside of cluster
+package lbtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Mock is embedded by the in-memory stand-ins of the APIs of load balancers. Handlers
+// hold the lock while serving a request, and record the changes as actions.
+type Mock struct {
+	sync.Mutex
+	next    int
+	actions []string
+}
+
+// Record records a change, the lock must be held
+func (m *Mock) Record(action string) {
+	m.actions = append(m.actions, action)
+}
+
+// NextID returns an increasing number for ids of resources, the lock must be held
+func (m *Mock) NextID() int {
+	m.next++
+	return m.next
+}
+
+// TakeActions returns the changes since last call
+func (m *Mock) TakeActions() []string {
+	m.Lock()
+	defer m.Unlock()
+	actions := m.actions
+	m.actions = nil
+	return actions
+}
+
+// RespondJSON writes v in JSON
+func RespondJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// NewLoadBalancer returns a LoadBalancer default/lb with the default http and https
+// ports, tcp and udp streams on port 20053 and a tcp stream on port 20080
+func NewLoadBalancer() *lbapi.LoadBalancer {
+	lb := &lbapi.LoadBalancer{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "lb"}}
+	lb.Spec.Proxy.Streams = []lbapi.StreamSpec{
+		{Port: 20053, Protocol: v1.ProtocolTCP},
+		{Port: 20053, Protocol: v1.ProtocolUDP},
+		{Port: 20080},
+	}
+	return lb
+}
+
+// NewNode returns a node with internal ip and provider id, ip is omitted if empty
+func NewNode(name, ip, providerID string) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1.NodeSpec{ProviderID: providerID},
+	}
+	if ip != "" {
+		node.Status.Addresses = []v1.NodeAddress{{Type: v1.NodeInternalIP, Address: ip}}
+	}
+	return node
+}
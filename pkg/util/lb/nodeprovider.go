@@ -0,0 +1,280 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/caicloud/clientset/informers"
+	"github.com/caicloud/clientset/kubernetes"
+	lblisters "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/clientset/util/syncqueue"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	log "k8s.io/klog"
+)
+
+// NodeProviderHandler manages the load balancer outside of cluster of a NodeProvider
+type NodeProviderHandler interface {
+	// Enabled checks whether lb uses the provider
+	Enabled(lb *lbapi.LoadBalancer) bool
+	// Pending checks whether lb needs a periodic resync, nothing in cluster
+	// changes while its load balancer is provisioning or drifting
+	Pending(lb *lbapi.LoadBalancer) bool
+	// Sync syncs the load balancer of lb with the nodes of lb
+	Sync(lb *lbapi.LoadBalancer, nodes []*v1.Node) error
+	// Release deletes the status of lb whose provider is removed from spec
+	Release(lb *lbapi.LoadBalancer) error
+	// Cleanup deletes the load balancer of a deleted lb
+	Cleanup(lb *lbapi.LoadBalancer) error
+}
+
+// NodeProvider runs the providers managing a load balancer outside of cluster whose
+// backends are the nodes of LoadBalancer, such as aliyun, aws, openstack and f5. LoadBalancers
+// are synced when they or their nodes change, and resynced periodically if pending.
+type NodeProvider struct {
+	name         string
+	handler      NodeProviderHandler
+	resyncPeriod time.Duration
+
+	queue      *syncqueue.SyncQueue
+	lbLister   lblisters.LoadBalancerLister
+	nodeLister corelisters.NodeLister
+}
+
+// NewNodeProvider creates a NodeProvider named name with handler
+func NewNodeProvider(name string, sif informers.SharedInformerFactory, resyncPeriod time.Duration, handler NodeProviderHandler) *NodeProvider {
+	lbInformer := sif.Custom().Loadbalance().V1alpha2().LoadBalancers()
+	nodeInformer := sif.Native().Core().V1().Nodes()
+
+	p := &NodeProvider{
+		name:         name,
+		handler:      handler,
+		resyncPeriod: resyncPeriod,
+		lbLister:     lbInformer.Lister(),
+		nodeLister:   nodeInformer.Lister(),
+	}
+	p.queue = syncqueue.NewPassthroughSyncQueue(&lbapi.LoadBalancer{}, p.syncLoadBalancer)
+
+	// backends are the nodes of lb
+	nodeInformer.Informer().AddEventHandler(NewEventHandlerForNode(p.lbLister, p.queue, func(lb *lbapi.LoadBalancer) bool {
+		return !handler.Enabled(lb)
+	}))
+	return p
+}
+
+// Run runs the provider until stopCh is closed
+func (p *NodeProvider) Run(stopCh <-chan struct{}) {
+
+	workers := 1
+
+	if p == nil {
+		panic("Please initialize provider before you run it")
+	}
+
+	defer utilruntime.HandleCrash()
+
+	log.Infof("Starting %v provider, workers %v", p.name, workers)
+
+	// lb controller has waited all the informer synced
+	// there is no need to wait again here
+
+	defer func() {
+		log.Infof("Shutting down %v provider", p.name)
+		p.queue.ShutDown()
+	}()
+
+	p.queue.Run(workers)
+
+	go wait.Until(p.resync, p.resyncPeriod, stopCh)
+
+	<-stopCh
+}
+
+// OnSync enqueues lb
+func (p *NodeProvider) OnSync(lb *lbapi.LoadBalancer) {
+	log.Infof("Syncing providers, triggered by loadbalancer %v/%v", lb.Namespace, lb.Name)
+	p.queue.Enqueue(lb)
+}
+
+// Queue returns the queue of LoadBalancers, providers running pods in cluster
+// enqueue LoadBalancers by it when the pods change
+func (p *NodeProvider) Queue() *syncqueue.SyncQueue {
+	return p.queue
+}
+
+// resync enqueues the pending LoadBalancers using the provider
+func (p *NodeProvider) resync() {
+	lbs, err := p.lbLister.List(labels.Everything())
+	if err != nil {
+		log.Errorf("List loadbalancers error: %v", err)
+		return
+	}
+	for _, lb := range lbs {
+		if p.handler.Enabled(lb) && p.handler.Pending(lb) {
+			p.queue.Enqueue(lb)
+		}
+	}
+}
+
+func (p *NodeProvider) syncLoadBalancer(obj interface{}) error {
+	lb, ok := obj.(*lbapi.LoadBalancer)
+	if !ok {
+		return fmt.Errorf("expect loadbalancer, got %v", obj)
+	}
+
+	key, _ := cache.DeletionHandlingMetaNamespaceKeyFunc(lb)
+
+	startTime := time.Now()
+	defer func() {
+		log.V(5).Infof("Finished syncing %v provider for %v, usedTime %v", p.name, key, time.Since(startTime))
+	}()
+
+	nlb, err := p.lbLister.LoadBalancers(lb.Namespace).Get(lb.Name)
+	if errors.IsNotFound(err) {
+		log.Warningf("LoadBalancer %v has been deleted, clean up provider", key)
+		// events of a deleted lb are never changed again
+		ForgetEvents(lb)
+
+		if !p.handler.Enabled(lb) {
+			return nil
+		}
+		return p.handler.Cleanup(lb)
+	}
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("Unable to retrieve LoadBalancer %v from store: %v", key, err))
+		return err
+	}
+
+	// fresh lb
+	if lb.UID != nlb.UID {
+		return nil
+	}
+	lb = nlb.DeepCopy()
+
+	if !p.handler.Enabled(lb) {
+		// It is not my responsible, clean up legacies
+		return p.handler.Release(lb)
+	}
+
+	if lb.DeletionTimestamp != nil {
+		// TODO sync status only
+		return nil
+	}
+
+	selector := labels.Set{fmt.Sprintf(lbapi.UniqueLabelKeyFormat, lb.Namespace, lb.Name): "true"}.AsSelector()
+	nodes, err := p.nodeLister.List(selector)
+	if err != nil {
+		return err
+	}
+	return p.handler.Sync(lb, nodes)
+}
+
+// SyncProviderStatus updates the status of provider in lb from current to desired by set,
+// nothing is done if they are equal. desired is a nil pointer to delete the status.
+func SyncProviderStatus(client kubernetes.Interface, lbLister lblisters.LoadBalancerLister, lb *lbapi.LoadBalancer,
+	provider string, current, desired interface{}, set func(*lbapi.LoadBalancer)) error {
+	if reflect.DeepEqual(current, desired) {
+		return nil
+	}
+	if v := reflect.ValueOf(desired); v.Kind() == reflect.Ptr && v.IsNil() {
+		log.Infof("delete %v status for loadbalancer %v/%v", provider, lb.Namespace, lb.Name)
+	} else {
+		log.Infof("update %v status of lb %v/%v", provider, lb.Namespace, lb.Name)
+	}
+	_, err := UpdateLBWithRetries(
+		client.Custom().LoadbalanceV1alpha2().LoadBalancers(lb.Namespace),
+		lbLister,
+		lb.Namespace,
+		lb.Name,
+		func(lb *lbapi.LoadBalancer) error {
+			set(lb)
+			return nil
+		},
+	)
+	if err != nil {
+		log.Errorf("Update loadbalancer status error: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ProxyListener is a port of proxy served by the load balancer outside of cluster
+type ProxyListener struct {
+	Protocol v1.Protocol
+	Port     int
+}
+
+// ProxyListeners returns the tcp listeners of http and https port and the listeners
+// of streams of lb's proxy, sorted by port and protocol
+func ProxyListeners(lb *lbapi.LoadBalancer) []ProxyListener {
+	httpPort, httpsPort := HTTPPorts(lb)
+	seen := make(map[ProxyListener]bool)
+	listeners := make([]ProxyListener, 0)
+	add := func(l ProxyListener) {
+		if !seen[l] {
+			seen[l] = true
+			listeners = append(listeners, l)
+		}
+	}
+	add(ProxyListener{Protocol: v1.ProtocolTCP, Port: httpPort})
+	add(ProxyListener{Protocol: v1.ProtocolTCP, Port: httpsPort})
+	for _, stream := range lb.Spec.Proxy.Streams {
+		protocol := v1.ProtocolTCP
+		if stream.Protocol == v1.ProtocolUDP {
+			protocol = v1.ProtocolUDP
+		}
+		add(ProxyListener{Protocol: protocol, Port: int(stream.Port)})
+	}
+	sort.Slice(listeners, func(i, j int) bool {
+		if listeners[i].Port != listeners[j].Port {
+			return listeners[i].Port < listeners[j].Port
+		}
+		return listeners[i].Protocol < listeners[j].Protocol
+	})
+	return listeners
+}
+
+// NodeInternalIPs returns the sorted internal ips of nodes, nodes without one are skipped
+func NodeInternalIPs(nodes []*v1.Node) []string {
+	addresses := sets.NewString()
+	for _, node := range nodes {
+		found := false
+		for _, address := range node.Status.Addresses {
+			if address.Type == v1.NodeInternalIP {
+				addresses.Insert(address.Address)
+				found = true
+				break
+			}
+		}
+		if !found {
+			log.Warningf("Node %v has no internal ip, skip it", node.Name)
+		}
+	}
+	return addresses.List()
+}
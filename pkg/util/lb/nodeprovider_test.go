@@ -0,0 +1,52 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/caicloud/loadbalancer-controller/pkg/util/lb/lbtest"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestProxyListeners(t *testing.T) {
+	lb := lbtest.NewLoadBalancer()
+	got := ProxyListeners(lb)
+	want := []ProxyListener{
+		{Protocol: v1.ProtocolTCP, Port: 80},
+		{Protocol: v1.ProtocolTCP, Port: 443},
+		{Protocol: v1.ProtocolTCP, Port: 20053},
+		{Protocol: v1.ProtocolUDP, Port: 20053},
+		{Protocol: v1.ProtocolTCP, Port: 20080},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got listeners %v, want %v", got, want)
+	}
+}
+
+func TestNodeInternalIPs(t *testing.T) {
+	nodes := []*v1.Node{
+		lbtest.NewNode("n2", "10.0.0.2", ""),
+		lbtest.NewNode("n1", "10.0.0.1", ""),
+		lbtest.NewNode("n3", "", ""),
+	}
+	if got, want := NodeInternalIPs(nodes), []string{"10.0.0.1", "10.0.0.2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got ips %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	"github.com/caicloud/loadbalancer-controller/pkg/api"
+	policyv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/policy/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	log "k8s.io/klog"
+)
+
+// MaxUnavailable returns the max unavailable pods of lb's proxy, default is 1
+func MaxUnavailable(lb *lbapi.LoadBalancer) *intstr.IntOrString {
+	if lb.Spec.Proxy.Disruption != nil && lb.Spec.Proxy.Disruption.MaxUnavailable != nil {
+		return lb.Spec.Proxy.Disruption.MaxUnavailable
+	}
+	one := intstr.FromInt(1)
+	return &one
+}
+
+// GeneratePodDisruptionBudget generates a PodDisruptionBudget owned by lb for the pods
+// selected by labels. MinAvailable is sized from replicas and maxUnavailable,
+// percentage is rounded up, nil maxUnavailable means 1.
+func GeneratePodDisruptionBudget(lb *lbapi.LoadBalancer, name string, labels map[string]string, replicas int32, maxUnavailable *intstr.IntOrString) (*policyv1.PodDisruptionBudget, error) {
+	if maxUnavailable == nil {
+		one := intstr.FromInt(1)
+		maxUnavailable = &one
+	}
+	unavailable, err := intstr.GetValueFromIntOrPercent(maxUnavailable, int(replicas), true)
+	if err != nil {
+		return nil, err
+	}
+	available := int(replicas) - unavailable
+	if available < 0 {
+		available = 0
+	}
+	minAvailable := intstr.FromInt(available)
+	t := true
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: lb.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         api.ControllerKind.GroupVersion().String(),
+					Kind:               api.ControllerKind.Kind,
+					Name:               lb.Name,
+					UID:                lb.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				},
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &minAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+		},
+	}
+	return pdb, nil
+}
+
+// EnsurePodDisruptionBudget creates or updates the PodDisruptionBudget for the pods
+// selected by labels, it is deleted if no pod needs to be kept available, such as
+// a single replica with the default maxUnavailable.
+func EnsurePodDisruptionBudget(client *policyv1.Client, lb *lbapi.LoadBalancer, name string, labels map[string]string, replicas int32, maxUnavailable *intstr.IntOrString) error {
+	if replicas == 0 {
+		return DeletePodDisruptionBudget(client, lb.Namespace, name)
+	}
+
+	desired, err := GeneratePodDisruptionBudget(lb, name, labels, replicas, maxUnavailable)
+	if err != nil {
+		return err
+	}
+	if desired.Spec.MinAvailable.IntValue() == 0 {
+		return DeletePodDisruptionBudget(client, lb.Namespace, name)
+	}
+
+	pdb, err := client.GetPodDisruptionBudget(lb.Namespace, name)
+	if errors.IsNotFound(err) {
+		log.Infof("Create PodDisruptionBudget %v for loadbalancer %v", name, lb.Name)
+		_, err = client.CreatePodDisruptionBudget(desired)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if pdb.Spec.MinAvailable != nil && *pdb.Spec.MinAvailable == *desired.Spec.MinAvailable &&
+		pdb.Spec.MaxUnavailable == nil && metav1.IsControlledBy(pdb, lb) {
+		return nil
+	}
+
+	// the selector of policy/v1 PodDisruptionBudget is mutable
+	copy := pdb.DeepCopy()
+	copy.Labels = desired.Labels
+	copy.OwnerReferences = desired.OwnerReferences
+	copy.Spec = desired.Spec
+	log.Infof("Update PodDisruptionBudget %v for loadbalancer %v", name, lb.Name)
+	_, err = client.UpdatePodDisruptionBudget(copy)
+	return err
+}
+
+// DeletePodDisruptionBudget deletes the PodDisruptionBudget, it is ok if it does not exist
+func DeletePodDisruptionBudget(client *policyv1.Client, namespace, name string) error {
+	err := client.DeletePodDisruptionBudget(namespace, name)
+	if err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Delete PodDisruptionBudget %v/%v error: %v", namespace, name, err)
+		return err
+	}
+	return nil
+}
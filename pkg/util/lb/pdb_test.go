@@ -0,0 +1,109 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/caicloud/clientset/kubernetes"
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	policyv1 "github.com/caicloud/loadbalancer-controller/pkg/apis/policy/v1"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
+)
+
+func TestGeneratePodDisruptionBudget(t *testing.T) {
+	percent := func(s string) *intstr.IntOrString {
+		v := intstr.FromString(s)
+		return &v
+	}
+	number := func(i int) *intstr.IntOrString {
+		v := intstr.FromInt(i)
+		return &v
+	}
+
+	tests := []struct {
+		name           string
+		replicas       int32
+		maxUnavailable *intstr.IntOrString
+		want           int
+	}{
+		{"default", 3, nil, 2},
+		{"single replica", 1, nil, 0},
+		{"number", 5, number(2), 3},
+		{"more than replicas", 2, number(3), 0},
+		{"percent rounded up", 3, percent("50%"), 1},
+		{"zero percent", 3, percent("0%"), 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lb := &lbapi.LoadBalancer{}
+			lb.Name = "test"
+			lb.Namespace = "default"
+			if tt.maxUnavailable != nil {
+				lb.Spec.Proxy.Disruption = &lbapi.DisruptionSpec{MaxUnavailable: tt.maxUnavailable}
+			}
+			pdb, err := GeneratePodDisruptionBudget(lb, "test-pdb", map[string]string{"app": "test"}, tt.replicas, MaxUnavailable(lb))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := pdb.Spec.MinAvailable.IntValue(); got != tt.want {
+				t.Errorf("minAvailable = %d, want %d", got, tt.want)
+			}
+			if pdb.Spec.Selector.MatchLabels["app"] != "test" {
+				t.Errorf("unexpected selector %v", pdb.Spec.Selector)
+			}
+		})
+	}
+
+	lb := &lbapi.LoadBalancer{}
+	if _, err := GeneratePodDisruptionBudget(lb, "test-pdb", nil, 3, percent("abc")); err == nil {
+		t.Error("expected error for invalid maxUnavailable")
+	}
+}
+
+func TestEnsurePodDisruptionBudgetSingleReplica(t *testing.T) {
+	requests := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+	client, err := kubernetes.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lb := &lbapi.LoadBalancer{}
+	lb.Name = "test"
+	lb.Namespace = "default"
+	// a budget keeping no pod available is deleted rather than created
+	err = EnsurePodDisruptionBudget(policyv1.New(client.Native().PolicyV1beta1().RESTClient()), lb, "test-pdb", nil, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"DELETE /apis/policy/v1/namespaces/default/poddisruptionbudgets/test-pdb"}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("got requests %v, want %v", requests, want)
+	}
+}
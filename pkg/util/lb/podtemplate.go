@@ -0,0 +1,102 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// ApplyPodTemplate strategic-merge-patches the overlay onto the pod template of
+// deployment. The image, command, args and ports of generated containers are
+// owned by controller, overriding them is rejected.
+func ApplyPodTemplate(deploy *appsv1.Deployment, overlay *v1.PodTemplateSpec) error {
+	if overlay == nil {
+		return nil
+	}
+
+	generated := make(map[string]bool, len(deploy.Spec.Template.Spec.Containers))
+	for _, c := range deploy.Spec.Template.Spec.Containers {
+		generated[c.Name] = true
+	}
+	for _, c := range overlay.Spec.Containers {
+		if !generated[c.Name] {
+			continue
+		}
+		if c.Image != "" || len(c.Command) != 0 || len(c.Args) != 0 || len(c.Ports) != 0 {
+			return fmt.Errorf("podTemplate: image, command, args and ports of container %s are owned by controller", c.Name)
+		}
+	}
+
+	original, err := json.Marshal(deploy.Spec.Template)
+	if err != nil {
+		return err
+	}
+	patch, err := podTemplatePatch(overlay)
+	if err != nil {
+		return err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, patch, v1.PodTemplateSpec{})
+	if err != nil {
+		return fmt.Errorf("podTemplate: %v", err)
+	}
+
+	template := v1.PodTemplateSpec{}
+	err = json.Unmarshal(patched, &template)
+	if err != nil {
+		return err
+	}
+	deploy.Spec.Template = template
+	return nil
+}
+
+// podTemplatePatch encodes the overlay as a patch, null means deleting the field in
+// a strategic merge patch, so the nulls of fields not set in overlay are dropped.
+func podTemplatePatch(overlay *v1.PodTemplateSpec) ([]byte, error) {
+	data, err := json.Marshal(overlay)
+	if err != nil {
+		return nil, err
+	}
+	var patch interface{}
+	err = json.Unmarshal(data, &patch)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(dropNulls(patch))
+}
+
+func dropNulls(obj interface{}) interface{} {
+	switch t := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range t {
+			if v == nil {
+				delete(t, k)
+				continue
+			}
+			t[k] = dropNulls(v)
+		}
+	case []interface{}:
+		for i := range t {
+			t[i] = dropNulls(t[i])
+		}
+	}
+	return obj
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestApplyPodTemplate(t *testing.T) {
+	grace := int64(30)
+	newDeploy := func() *appsv1.Deployment {
+		d := &appsv1.Deployment{}
+		d.Spec.Template.Labels = map[string]string{"app": "test"}
+		d.Spec.Template.Spec = v1.PodSpec{
+			TerminationGracePeriodSeconds: &grace,
+			PriorityClassName:             "system-node-critical",
+			Containers: []v1.Container{
+				{Name: "proxy", Image: "proxy:v1", ImagePullPolicy: v1.PullAlways, Args: []string{"--v=1"}},
+				{Name: "sidecar", Image: "sidecar:v1"},
+			},
+		}
+		return d
+	}
+
+	longer := int64(120)
+	deploy := newDeploy()
+	err := ApplyPodTemplate(deploy, &v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			TerminationGracePeriodSeconds: &longer,
+			PriorityClassName:             "proxy-critical",
+			ImagePullSecrets:              []v1.LocalObjectReference{{Name: "registry"}},
+			Containers: []v1.Container{
+				{
+					Name:            "proxy",
+					ImagePullPolicy: v1.PullIfNotPresent,
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := deploy.Spec.Template.Spec
+	if *spec.TerminationGracePeriodSeconds != 120 || spec.PriorityClassName != "proxy-critical" || len(spec.ImagePullSecrets) != 1 {
+		t.Errorf("pod spec is not patched: %+v", spec)
+	}
+	if len(spec.Containers) != 2 {
+		t.Fatalf("expected 2 containers, got %v", spec.Containers)
+	}
+	proxy := spec.Containers[0]
+	if proxy.Name != "proxy" || proxy.Image != "proxy:v1" || proxy.ImagePullPolicy != v1.PullIfNotPresent ||
+		len(proxy.Args) != 1 || proxy.Resources.Limits.Cpu().String() != "1" {
+		t.Errorf("proxy container is not merged: %+v", proxy)
+	}
+	if deploy.Spec.Template.Labels["app"] != "test" {
+		t.Errorf("labels are dropped: %v", deploy.Spec.Template.Labels)
+	}
+
+	deploy = newDeploy()
+	err = ApplyPodTemplate(deploy, &v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "proxy", Image: "proxy:v2"}},
+		},
+	})
+	if err == nil {
+		t.Error("expected error when overriding image of generated container")
+	}
+
+	deploy = newDeploy()
+	err = ApplyPodTemplate(deploy, &v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "extra", Image: "extra:v1"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deploy.Spec.Template.Spec.Containers) != 3 {
+		t.Errorf("expected extra container, got %v", deploy.Spec.Template.Spec.Containers)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+// HTTPPorts returns the http and https port of lb's proxy
+func HTTPPorts(lb *lbapi.LoadBalancer) (int, int) {
+	httpPort := lb.Spec.Proxy.HTTPPort
+	httpsPort := lb.Spec.Proxy.HTTPSPort
+	if httpPort <= 0 {
+		httpPort = lbapi.DefaultHTTPPort
+	}
+	if httpsPort <= 0 {
+		httpsPort = lbapi.DefaultHTTPSPort
+	}
+	return httpPort, httpsPort
+}
+
+// PortRanges returns the port ranges which lb's proxy can use for streams
+func PortRanges(lb *lbapi.LoadBalancer) []lbapi.PortRange {
+	if len(lb.Spec.Proxy.PortRanges) == 0 {
+		return lbapi.DefaultPortRanges
+	}
+	return lb.Spec.Proxy.PortRanges
+}
+
+// ProxyPortRanges returns all the ports lb's proxy listens on,
+// including http, https port and the port ranges
+func ProxyPortRanges(lb *lbapi.LoadBalancer) []lbapi.PortRange {
+	httpPort, httpsPort := HTTPPorts(lb)
+	ranges := []lbapi.PortRange{
+		{Start: int32(httpPort), End: int32(httpPort)},
+		{Start: int32(httpsPort), End: int32(httpsPort)},
+	}
+	ranges = append(ranges, PortRanges(lb)...)
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start < ranges[j].Start
+	})
+	return ranges
+}
+
+// FormatPortRanges formats port ranges to a comma separated list,
+// such as 80,443,20000-29999
+func FormatPortRanges(ranges []lbapi.PortRange) string {
+	parts := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		if r.Start == r.End {
+			parts = append(parts, strconv.Itoa(int(r.Start)))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%d-%d", r.Start, r.End))
+	}
+	return strings.Join(parts, ",")
+}
+
+// CheckStreamPort checks if the port of a stream entry can be used by lb's proxy,
+// it returns the reason why the port is not allowed, or empty string if allowed
+func CheckStreamPort(lb *lbapi.LoadBalancer, port string) string {
+	p, err := strconv.Atoi(port)
+	if err != nil || p <= 0 || p > 65535 {
+		return fmt.Sprintf("port %q is invalid", port)
+	}
+	httpPort, httpsPort := HTTPPorts(lb)
+	if p == httpPort || p == httpsPort {
+		return fmt.Sprintf("port %d conflicts with http or https port", p)
+	}
+	ranges := PortRanges(lb)
+	if !lbapi.PortInRanges(int32(p), ranges) {
+		return fmt.Sprintf("port %d is out of port ranges %s", p, FormatPortRanges(ranges))
+	}
+	return ""
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lb
+
+import (
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+)
+
+func TestCheckStreamPort(t *testing.T) {
+	lb := &lbapi.LoadBalancer{
+		Spec: lbapi.LoadBalancerSpec{
+			Proxy: lbapi.ProxySpec{
+				HTTPPort:   8080,
+				PortRanges: []lbapi.PortRange{{Start: 8000, End: 8100}},
+			},
+		},
+	}
+
+	tests := []struct {
+		port    string
+		allowed bool
+	}{
+		{"8001", true},
+		{"8100", true},
+		{"8080", false},
+		{"443", false},
+		{"20000", false},
+		{"abc", false},
+		{"70000", false},
+	}
+	for _, tt := range tests {
+		if got := CheckStreamPort(lb, tt.port) == ""; got != tt.allowed {
+			t.Errorf("CheckStreamPort(%v) allowed = %v, want %v", tt.port, got, tt.allowed)
+		}
+	}
+}
+
+func TestFormatPortRanges(t *testing.T) {
+	lb := &lbapi.LoadBalancer{}
+	want := "80,443,20000-29999"
+	if got := FormatPortRanges(ProxyPortRanges(lb)); got != want {
+		t.Errorf("FormatPortRanges() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package restjson helps to access the APIs which are not in the vendored
+// clientset with a rest client, objects are encoded and decoded by json.
+package restjson
+
+import (
+	"encoding/json"
+
+	"k8s.io/client-go/rest"
+)
+
+// Do sends the request and decodes the response into obj, obj can be nil
+func Do(req *rest.Request, obj interface{}) error {
+	raw, err := req.Do().Raw()
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, obj)
+}
+
+// Body encodes obj as the json body of the request
+func Body(req *rest.Request, obj interface{}) (*rest.Request, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return req.SetHeader("Content-Type", "application/json").Body(data), nil
+}
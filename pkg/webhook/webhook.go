@@ -0,0 +1,132 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	log "k8s.io/klog"
+)
+
+const (
+	// ValidatePath is the path of the validating webhook of LoadBalancers
+	ValidatePath = "/validate-loadbalancer"
+)
+
+// admissionReview is the subset of admission.k8s.io/v1 AdmissionReview read
+// by webhook, k8s.io/api/admission is not vendored
+type admissionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *admissionRequest  `json:"request,omitempty"`
+	Response        *admissionResponse `json:"response,omitempty"`
+}
+
+type admissionRequest struct {
+	UID       types.UID       `json:"uid"`
+	Operation string          `json:"operation"`
+	Object    json.RawMessage `json:"object,omitempty"`
+}
+
+type admissionResponse struct {
+	UID     types.UID      `json:"uid"`
+	Allowed bool           `json:"allowed"`
+	Result  *metav1.Status `json:"result,omitempty"`
+}
+
+// Server serves the validating webhook of LoadBalancers
+type Server struct {
+	port     int
+	certFile string
+	keyFile  string
+}
+
+// NewServer creates a webhook server listening on port with the tls cert and key
+func NewServer(port int, certFile, keyFile string) *Server {
+	return &Server{
+		port:     port,
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+}
+
+// Run serves the webhook until stopCh is closed
+func (s *Server) Run(stopCh <-chan struct{}) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidatePath, ServeValidate)
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", s.port),
+		Handler: mux,
+	}
+	go func() {
+		<-stopCh
+		_ = server.Close()
+	}()
+
+	log.Infof("Starting loadbalancer webhook on port %v", s.port)
+	if err := server.ListenAndServeTLS(s.certFile, s.keyFile); err != nil && err != http.ErrServerClosed {
+		log.Errorf("Serve loadbalancer webhook error: %v", err)
+	}
+}
+
+// ServeValidate rejects the LoadBalancers which can not pass validation. Vips
+// are checked against VIPPools by controller, not here.
+func ServeValidate(w http.ResponseWriter, r *http.Request) {
+	review := &admissionReview{}
+	if err := json.NewDecoder(r.Body).Decode(review); err != nil || review.Request == nil {
+		http.Error(w, fmt.Sprintf("invalid admission review: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	review.Response = validate(review.Request)
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		log.Errorf("Write admission review error: %v", err)
+	}
+}
+
+func validate(req *admissionRequest) *admissionResponse {
+	resp := &admissionResponse{
+		UID:     req.UID,
+		Allowed: true,
+	}
+	if req.Operation != "CREATE" && req.Operation != "UPDATE" {
+		return resp
+	}
+
+	lb := &lbapi.LoadBalancer{}
+	err := json.Unmarshal(req.Object, lb)
+	if err == nil {
+		err = lbapi.ValidateLoadBalancer(lb)
+	}
+	if err != nil {
+		resp.Allowed = false
+		resp.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Reason:  metav1.StatusReasonInvalid,
+			Message: err.Error(),
+			Code:    http.StatusUnprocessableEntity,
+		}
+	}
+	return resp
+}
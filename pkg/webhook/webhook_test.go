@@ -0,0 +1,81 @@
+/*
+Copyright 2017 Caicloud authors. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	lbapi "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServeValidate(t *testing.T) {
+	lb := &lbapi.LoadBalancer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "lb"},
+		Spec: lbapi.LoadBalancerSpec{
+			Proxy: lbapi.ProxySpec{
+				Type:   lbapi.ProxyTypeNginx,
+				Config: map[string]string{"proxy-body-size": "5GG"},
+			},
+		},
+	}
+
+	review := func(operation string) *admissionResponse {
+		object, _ := json.Marshal(lb)
+		body, _ := json.Marshal(&admissionReview{
+			TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+			Request:  &admissionRequest{UID: "uid", Operation: operation, Object: object},
+		})
+		w := httptest.NewRecorder()
+		ServeValidate(w, httptest.NewRequest(http.MethodPost, ValidatePath, strings.NewReader(string(body))))
+		if w.Code != http.StatusOK {
+			t.Fatalf("got status %v", w.Code)
+		}
+		got := &admissionReview{}
+		if err := json.Unmarshal(w.Body.Bytes(), got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Response == nil || got.Response.UID != "uid" || got.APIVersion != "admission.k8s.io/v1" {
+			t.Fatalf("got review %+v", got)
+		}
+		return got.Response
+	}
+
+	if resp := review("CREATE"); resp.Allowed || !strings.Contains(resp.Result.Message, "proxy-body-size") {
+		t.Errorf("invalid config is allowed: %+v", resp)
+	}
+	if resp := review("DELETE"); !resp.Allowed {
+		t.Errorf("delete is rejected: %+v", resp.Result)
+	}
+	lb.Spec.Proxy.Config["proxy-body-size"] = "5G"
+	if resp := review("UPDATE"); !resp.Allowed {
+		t.Errorf("valid config is rejected: %+v", resp.Result)
+	}
+}
+
+func TestServeValidateBadRequest(t *testing.T) {
+	w := httptest.NewRecorder()
+	ServeValidate(w, httptest.NewRequest(http.MethodPost, ValidatePath, strings.NewReader("{}")))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("got status %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/alerting/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AlertingSubRulesGetter has a method to return a AlertingSubRuleInterface.
+// A group's client should implement this interface.
+type AlertingSubRulesGetter interface {
+	AlertingSubRules() AlertingSubRuleInterface
+}
+
+// AlertingSubRuleInterface has methods to work with AlertingSubRule resources.
+type AlertingSubRuleInterface interface {
+	Create(*v1alpha1.AlertingSubRule) (*v1alpha1.AlertingSubRule, error)
+	Update(*v1alpha1.AlertingSubRule) (*v1alpha1.AlertingSubRule, error)
+	UpdateStatus(*v1alpha1.AlertingSubRule) (*v1alpha1.AlertingSubRule, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.AlertingSubRule, error)
+	List(opts v1.ListOptions) (*v1alpha1.AlertingSubRuleList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.AlertingSubRule, err error)
+	AlertingSubRuleExpansion
+}
+
+// alertingSubRules implements AlertingSubRuleInterface
+type alertingSubRules struct {
+	client rest.Interface
+}
+
+// newAlertingSubRules returns a AlertingSubRules
+func newAlertingSubRules(c *AlertingV1alpha1Client) *alertingSubRules {
+	return &alertingSubRules{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the alertingSubRule, and returns the corresponding alertingSubRule object, and an error if there is any.
+func (c *alertingSubRules) Get(name string, options v1.GetOptions) (result *v1alpha1.AlertingSubRule, err error) {
+	result = &v1alpha1.AlertingSubRule{}
+	err = c.client.Get().
+		Resource("alertingsubrules").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AlertingSubRules that match those selectors.
+func (c *alertingSubRules) List(opts v1.ListOptions) (result *v1alpha1.AlertingSubRuleList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.AlertingSubRuleList{}
+	err = c.client.Get().
+		Resource("alertingsubrules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested alertingSubRules.
+func (c *alertingSubRules) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("alertingsubrules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a alertingSubRule and creates it.  Returns the server's representation of the alertingSubRule, and an error, if there is any.
+func (c *alertingSubRules) Create(alertingSubRule *v1alpha1.AlertingSubRule) (result *v1alpha1.AlertingSubRule, err error) {
+	result = &v1alpha1.AlertingSubRule{}
+	err = c.client.Post().
+		Resource("alertingsubrules").
+		Body(alertingSubRule).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a alertingSubRule and updates it. Returns the server's representation of the alertingSubRule, and an error, if there is any.
+func (c *alertingSubRules) Update(alertingSubRule *v1alpha1.AlertingSubRule) (result *v1alpha1.AlertingSubRule, err error) {
+	result = &v1alpha1.AlertingSubRule{}
+	err = c.client.Put().
+		Resource("alertingsubrules").
+		Name(alertingSubRule.Name).
+		Body(alertingSubRule).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *alertingSubRules) UpdateStatus(alertingSubRule *v1alpha1.AlertingSubRule) (result *v1alpha1.AlertingSubRule, err error) {
+	result = &v1alpha1.AlertingSubRule{}
+	err = c.client.Put().
+		Resource("alertingsubrules").
+		Name(alertingSubRule.Name).
+		SubResource("status").
+		Body(alertingSubRule).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the alertingSubRule and deletes it. Returns an error if one occurs.
+func (c *alertingSubRules) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("alertingsubrules").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *alertingSubRules) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("alertingsubrules").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched alertingSubRule.
+func (c *alertingSubRules) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.AlertingSubRule, err error) {
+	result = &v1alpha1.AlertingSubRule{}
+	err = c.client.Patch(pt).
+		Resource("alertingsubrules").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
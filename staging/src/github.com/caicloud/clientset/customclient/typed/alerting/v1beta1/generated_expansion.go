@@ -0,0 +1,11 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+type AlertingRuleExpansion interface{}
+
+type AlertingSubRuleExpansion interface{}
@@ -0,0 +1,9 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+type APIServiceExpansion interface{}
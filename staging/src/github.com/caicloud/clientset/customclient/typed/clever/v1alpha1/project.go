@@ -0,0 +1,179 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ProjectsGetter has a method to return a ProjectInterface.
+// A group's client should implement this interface.
+type ProjectsGetter interface {
+	Projects(namespace string) ProjectInterface
+}
+
+// ProjectInterface has methods to work with Project resources.
+type ProjectInterface interface {
+	Create(*v1alpha1.Project) (*v1alpha1.Project, error)
+	Update(*v1alpha1.Project) (*v1alpha1.Project, error)
+	UpdateStatus(*v1alpha1.Project) (*v1alpha1.Project, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Project, error)
+	List(opts v1.ListOptions) (*v1alpha1.ProjectList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Project, err error)
+	ProjectExpansion
+}
+
+// projects implements ProjectInterface
+type projects struct {
+	client rest.Interface
+	ns     string
+}
+
+// newProjects returns a Projects
+func newProjects(c *CleverV1alpha1Client, namespace string) *projects {
+	return &projects{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the project, and returns the corresponding project object, and an error if there is any.
+func (c *projects) Get(name string, options v1.GetOptions) (result *v1alpha1.Project, err error) {
+	result = &v1alpha1.Project{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("projects").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Projects that match those selectors.
+func (c *projects) List(opts v1.ListOptions) (result *v1alpha1.ProjectList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ProjectList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("projects").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested projects.
+func (c *projects) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("projects").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a project and creates it.  Returns the server's representation of the project, and an error, if there is any.
+func (c *projects) Create(project *v1alpha1.Project) (result *v1alpha1.Project, err error) {
+	result = &v1alpha1.Project{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("projects").
+		Body(project).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a project and updates it. Returns the server's representation of the project, and an error, if there is any.
+func (c *projects) Update(project *v1alpha1.Project) (result *v1alpha1.Project, err error) {
+	result = &v1alpha1.Project{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("projects").
+		Name(project.Name).
+		Body(project).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *projects) UpdateStatus(project *v1alpha1.Project) (result *v1alpha1.Project, err error) {
+	result = &v1alpha1.Project{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("projects").
+		Name(project.Name).
+		SubResource("status").
+		Body(project).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the project and deletes it. Returns an error if one occurs.
+func (c *projects) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("projects").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *projects) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("projects").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched project.
+func (c *projects) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Project, err error) {
+	result = &v1alpha1.Project{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("projects").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TemplatesGetter has a method to return a TemplateInterface.
+// A group's client should implement this interface.
+type TemplatesGetter interface {
+	Templates() TemplateInterface
+}
+
+// TemplateInterface has methods to work with Template resources.
+type TemplateInterface interface {
+	Create(*v1alpha1.Template) (*v1alpha1.Template, error)
+	Update(*v1alpha1.Template) (*v1alpha1.Template, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Template, error)
+	List(opts v1.ListOptions) (*v1alpha1.TemplateList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Template, err error)
+	TemplateExpansion
+}
+
+// templates implements TemplateInterface
+type templates struct {
+	client rest.Interface
+}
+
+// newTemplates returns a Templates
+func newTemplates(c *CleverV1alpha1Client) *templates {
+	return &templates{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the template, and returns the corresponding template object, and an error if there is any.
+func (c *templates) Get(name string, options v1.GetOptions) (result *v1alpha1.Template, err error) {
+	result = &v1alpha1.Template{}
+	err = c.client.Get().
+		Resource("templates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Templates that match those selectors.
+func (c *templates) List(opts v1.ListOptions) (result *v1alpha1.TemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.TemplateList{}
+	err = c.client.Get().
+		Resource("templates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested templates.
+func (c *templates) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("templates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a template and creates it.  Returns the server's representation of the template, and an error, if there is any.
+func (c *templates) Create(template *v1alpha1.Template) (result *v1alpha1.Template, err error) {
+	result = &v1alpha1.Template{}
+	err = c.client.Post().
+		Resource("templates").
+		Body(template).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a template and updates it. Returns the server's representation of the template, and an error, if there is any.
+func (c *templates) Update(template *v1alpha1.Template) (result *v1alpha1.Template, err error) {
+	result = &v1alpha1.Template{}
+	err = c.client.Put().
+		Resource("templates").
+		Name(template.Name).
+		Body(template).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the template and deletes it. Returns an error if one occurs.
+func (c *templates) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("templates").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *templates) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("templates").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched template.
+func (c *templates) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Template, err error) {
+	result = &v1alpha1.Template{}
+	err = c.client.Patch(pt).
+		Resource("templates").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
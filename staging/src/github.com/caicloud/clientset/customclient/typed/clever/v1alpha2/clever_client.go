@@ -0,0 +1,92 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"github.com/caicloud/clientset/customclient/scheme"
+	v1alpha2 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha2"
+	rest "k8s.io/client-go/rest"
+)
+
+type CleverV1alpha2Interface interface {
+	RESTClient() rest.Interface
+	FlavorsGetter
+	MLNeuronsGetter
+	MLNeuronTaskOwnersGetter
+	ProjectsGetter
+}
+
+// CleverV1alpha2Client is used to interact with features provided by the clever.caicloud.io group.
+type CleverV1alpha2Client struct {
+	restClient rest.Interface
+}
+
+func (c *CleverV1alpha2Client) Flavors() FlavorInterface {
+	return newFlavors(c)
+}
+
+func (c *CleverV1alpha2Client) MLNeurons(namespace string) MLNeuronInterface {
+	return newMLNeurons(c, namespace)
+}
+
+func (c *CleverV1alpha2Client) MLNeuronTaskOwners(namespace string) MLNeuronTaskOwnerInterface {
+	return newMLNeuronTaskOwners(c, namespace)
+}
+
+func (c *CleverV1alpha2Client) Projects(namespace string) ProjectInterface {
+	return newProjects(c, namespace)
+}
+
+// NewForConfig creates a new CleverV1alpha2Client for the given config.
+func NewForConfig(c *rest.Config) (*CleverV1alpha2Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &CleverV1alpha2Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new CleverV1alpha2Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *CleverV1alpha2Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new CleverV1alpha2Client for the given RESTClient.
+func New(c rest.Interface) *CleverV1alpha2Client {
+	return &CleverV1alpha2Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1alpha2.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *CleverV1alpha2Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -0,0 +1,15 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+type FlavorExpansion interface{}
+
+type MLNeuronExpansion interface{}
+
+type MLNeuronTaskOwnerExpansion interface{}
+
+type ProjectExpansion interface{}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"github.com/caicloud/clientset/customclient/scheme"
+	v1alpha3 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha3"
+	rest "k8s.io/client-go/rest"
+)
+
+type CleverV1alpha3Interface interface {
+	RESTClient() rest.Interface
+	FlavorsGetter
+	MLNeuronsGetter
+	MLProjectsGetter
+	MLTasksGetter
+}
+
+// CleverV1alpha3Client is used to interact with features provided by the clever.caicloud.io group.
+type CleverV1alpha3Client struct {
+	restClient rest.Interface
+}
+
+func (c *CleverV1alpha3Client) Flavors() FlavorInterface {
+	return newFlavors(c)
+}
+
+func (c *CleverV1alpha3Client) MLNeurons(namespace string) MLNeuronInterface {
+	return newMLNeurons(c, namespace)
+}
+
+func (c *CleverV1alpha3Client) MLProjects(namespace string) MLProjectInterface {
+	return newMLProjects(c, namespace)
+}
+
+func (c *CleverV1alpha3Client) MLTasks(namespace string) MLTaskInterface {
+	return newMLTasks(c, namespace)
+}
+
+// NewForConfig creates a new CleverV1alpha3Client for the given config.
+func NewForConfig(c *rest.Config) (*CleverV1alpha3Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &CleverV1alpha3Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new CleverV1alpha3Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *CleverV1alpha3Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new CleverV1alpha3Client for the given RESTClient.
+func New(c rest.Interface) *CleverV1alpha3Client {
+	return &CleverV1alpha3Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1alpha3.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *CleverV1alpha3Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
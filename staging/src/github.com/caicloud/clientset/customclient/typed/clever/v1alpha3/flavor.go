@@ -0,0 +1,152 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha3 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// FlavorsGetter has a method to return a FlavorInterface.
+// A group's client should implement this interface.
+type FlavorsGetter interface {
+	Flavors() FlavorInterface
+}
+
+// FlavorInterface has methods to work with Flavor resources.
+type FlavorInterface interface {
+	Create(*v1alpha3.Flavor) (*v1alpha3.Flavor, error)
+	Update(*v1alpha3.Flavor) (*v1alpha3.Flavor, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha3.Flavor, error)
+	List(opts v1.ListOptions) (*v1alpha3.FlavorList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha3.Flavor, err error)
+	FlavorExpansion
+}
+
+// flavors implements FlavorInterface
+type flavors struct {
+	client rest.Interface
+}
+
+// newFlavors returns a Flavors
+func newFlavors(c *CleverV1alpha3Client) *flavors {
+	return &flavors{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the flavor, and returns the corresponding flavor object, and an error if there is any.
+func (c *flavors) Get(name string, options v1.GetOptions) (result *v1alpha3.Flavor, err error) {
+	result = &v1alpha3.Flavor{}
+	err = c.client.Get().
+		Resource("flavors").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Flavors that match those selectors.
+func (c *flavors) List(opts v1.ListOptions) (result *v1alpha3.FlavorList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha3.FlavorList{}
+	err = c.client.Get().
+		Resource("flavors").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested flavors.
+func (c *flavors) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("flavors").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a flavor and creates it.  Returns the server's representation of the flavor, and an error, if there is any.
+func (c *flavors) Create(flavor *v1alpha3.Flavor) (result *v1alpha3.Flavor, err error) {
+	result = &v1alpha3.Flavor{}
+	err = c.client.Post().
+		Resource("flavors").
+		Body(flavor).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a flavor and updates it. Returns the server's representation of the flavor, and an error, if there is any.
+func (c *flavors) Update(flavor *v1alpha3.Flavor) (result *v1alpha3.Flavor, err error) {
+	result = &v1alpha3.Flavor{}
+	err = c.client.Put().
+		Resource("flavors").
+		Name(flavor.Name).
+		Body(flavor).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the flavor and deletes it. Returns an error if one occurs.
+func (c *flavors) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("flavors").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *flavors) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("flavors").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched flavor.
+func (c *flavors) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha3.Flavor, err error) {
+	result = &v1alpha3.Flavor{}
+	err = c.client.Patch(pt).
+		Resource("flavors").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,15 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+type FlavorExpansion interface{}
+
+type MLNeuronExpansion interface{}
+
+type MLProjectExpansion interface{}
+
+type MLTaskExpansion interface{}
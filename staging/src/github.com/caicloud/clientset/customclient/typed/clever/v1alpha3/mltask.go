@@ -0,0 +1,179 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha3 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// MLTasksGetter has a method to return a MLTaskInterface.
+// A group's client should implement this interface.
+type MLTasksGetter interface {
+	MLTasks(namespace string) MLTaskInterface
+}
+
+// MLTaskInterface has methods to work with MLTask resources.
+type MLTaskInterface interface {
+	Create(*v1alpha3.MLTask) (*v1alpha3.MLTask, error)
+	Update(*v1alpha3.MLTask) (*v1alpha3.MLTask, error)
+	UpdateStatus(*v1alpha3.MLTask) (*v1alpha3.MLTask, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha3.MLTask, error)
+	List(opts v1.ListOptions) (*v1alpha3.MLTaskList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha3.MLTask, err error)
+	MLTaskExpansion
+}
+
+// mLTasks implements MLTaskInterface
+type mLTasks struct {
+	client rest.Interface
+	ns     string
+}
+
+// newMLTasks returns a MLTasks
+func newMLTasks(c *CleverV1alpha3Client, namespace string) *mLTasks {
+	return &mLTasks{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the mLTask, and returns the corresponding mLTask object, and an error if there is any.
+func (c *mLTasks) Get(name string, options v1.GetOptions) (result *v1alpha3.MLTask, err error) {
+	result = &v1alpha3.MLTask{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("mltasks").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of MLTasks that match those selectors.
+func (c *mLTasks) List(opts v1.ListOptions) (result *v1alpha3.MLTaskList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha3.MLTaskList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("mltasks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested mLTasks.
+func (c *mLTasks) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("mltasks").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a mLTask and creates it.  Returns the server's representation of the mLTask, and an error, if there is any.
+func (c *mLTasks) Create(mLTask *v1alpha3.MLTask) (result *v1alpha3.MLTask, err error) {
+	result = &v1alpha3.MLTask{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("mltasks").
+		Body(mLTask).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a mLTask and updates it. Returns the server's representation of the mLTask, and an error, if there is any.
+func (c *mLTasks) Update(mLTask *v1alpha3.MLTask) (result *v1alpha3.MLTask, err error) {
+	result = &v1alpha3.MLTask{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("mltasks").
+		Name(mLTask.Name).
+		Body(mLTask).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *mLTasks) UpdateStatus(mLTask *v1alpha3.MLTask) (result *v1alpha3.MLTask, err error) {
+	result = &v1alpha3.MLTask{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("mltasks").
+		Name(mLTask.Name).
+		SubResource("status").
+		Body(mLTask).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the mLTask and deletes it. Returns an error if one occurs.
+func (c *mLTasks) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("mltasks").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *mLTasks) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("mltasks").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched mLTask.
+func (c *mLTasks) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha3.MLTask, err error) {
+	result = &v1alpha3.MLTask{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("mltasks").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
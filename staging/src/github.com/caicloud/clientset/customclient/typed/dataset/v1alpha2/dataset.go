@@ -0,0 +1,152 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha2 "github.com/caicloud/clientset/pkg/apis/dataset/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DatasetsGetter has a method to return a DatasetInterface.
+// A group's client should implement this interface.
+type DatasetsGetter interface {
+	Datasets() DatasetInterface
+}
+
+// DatasetInterface has methods to work with Dataset resources.
+type DatasetInterface interface {
+	Create(*v1alpha2.Dataset) (*v1alpha2.Dataset, error)
+	Update(*v1alpha2.Dataset) (*v1alpha2.Dataset, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha2.Dataset, error)
+	List(opts v1.ListOptions) (*v1alpha2.DatasetList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.Dataset, err error)
+	DatasetExpansion
+}
+
+// datasets implements DatasetInterface
+type datasets struct {
+	client rest.Interface
+}
+
+// newDatasets returns a Datasets
+func newDatasets(c *DatasetV1alpha2Client) *datasets {
+	return &datasets{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the dataset, and returns the corresponding dataset object, and an error if there is any.
+func (c *datasets) Get(name string, options v1.GetOptions) (result *v1alpha2.Dataset, err error) {
+	result = &v1alpha2.Dataset{}
+	err = c.client.Get().
+		Resource("datasets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Datasets that match those selectors.
+func (c *datasets) List(opts v1.ListOptions) (result *v1alpha2.DatasetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha2.DatasetList{}
+	err = c.client.Get().
+		Resource("datasets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested datasets.
+func (c *datasets) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("datasets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a dataset and creates it.  Returns the server's representation of the dataset, and an error, if there is any.
+func (c *datasets) Create(dataset *v1alpha2.Dataset) (result *v1alpha2.Dataset, err error) {
+	result = &v1alpha2.Dataset{}
+	err = c.client.Post().
+		Resource("datasets").
+		Body(dataset).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a dataset and updates it. Returns the server's representation of the dataset, and an error, if there is any.
+func (c *datasets) Update(dataset *v1alpha2.Dataset) (result *v1alpha2.Dataset, err error) {
+	result = &v1alpha2.Dataset{}
+	err = c.client.Put().
+		Resource("datasets").
+		Name(dataset.Name).
+		Body(dataset).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the dataset and deletes it. Returns an error if one occurs.
+func (c *datasets) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("datasets").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *datasets) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("datasets").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched dataset.
+func (c *datasets) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha2.Dataset, err error) {
+	result = &v1alpha2.Dataset{}
+	err = c.client.Patch(pt).
+		Resource("datasets").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
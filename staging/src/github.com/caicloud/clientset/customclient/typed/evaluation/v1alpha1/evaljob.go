@@ -0,0 +1,179 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/evaluation/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// EvalJobsGetter has a method to return a EvalJobInterface.
+// A group's client should implement this interface.
+type EvalJobsGetter interface {
+	EvalJobs(namespace string) EvalJobInterface
+}
+
+// EvalJobInterface has methods to work with EvalJob resources.
+type EvalJobInterface interface {
+	Create(*v1alpha1.EvalJob) (*v1alpha1.EvalJob, error)
+	Update(*v1alpha1.EvalJob) (*v1alpha1.EvalJob, error)
+	UpdateStatus(*v1alpha1.EvalJob) (*v1alpha1.EvalJob, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.EvalJob, error)
+	List(opts v1.ListOptions) (*v1alpha1.EvalJobList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.EvalJob, err error)
+	EvalJobExpansion
+}
+
+// evalJobs implements EvalJobInterface
+type evalJobs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newEvalJobs returns a EvalJobs
+func newEvalJobs(c *EvaluationV1alpha1Client, namespace string) *evalJobs {
+	return &evalJobs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the evalJob, and returns the corresponding evalJob object, and an error if there is any.
+func (c *evalJobs) Get(name string, options v1.GetOptions) (result *v1alpha1.EvalJob, err error) {
+	result = &v1alpha1.EvalJob{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of EvalJobs that match those selectors.
+func (c *evalJobs) List(opts v1.ListOptions) (result *v1alpha1.EvalJobList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.EvalJobList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested evalJobs.
+func (c *evalJobs) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a evalJob and creates it.  Returns the server's representation of the evalJob, and an error, if there is any.
+func (c *evalJobs) Create(evalJob *v1alpha1.EvalJob) (result *v1alpha1.EvalJob, err error) {
+	result = &v1alpha1.EvalJob{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		Body(evalJob).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a evalJob and updates it. Returns the server's representation of the evalJob, and an error, if there is any.
+func (c *evalJobs) Update(evalJob *v1alpha1.EvalJob) (result *v1alpha1.EvalJob, err error) {
+	result = &v1alpha1.EvalJob{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		Name(evalJob.Name).
+		Body(evalJob).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *evalJobs) UpdateStatus(evalJob *v1alpha1.EvalJob) (result *v1alpha1.EvalJob, err error) {
+	result = &v1alpha1.EvalJob{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		Name(evalJob.Name).
+		SubResource("status").
+		Body(evalJob).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the evalJob and deletes it. Returns an error if one occurs.
+func (c *evalJobs) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *evalJobs) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("evaljobs").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched evalJob.
+func (c *evalJobs) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.EvalJob, err error) {
+	result = &v1alpha1.EvalJob{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("evaljobs").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
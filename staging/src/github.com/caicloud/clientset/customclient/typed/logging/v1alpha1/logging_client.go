@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/logging/v1alpha1"
+	rest "k8s.io/client-go/rest"
+)
+
+type LoggingV1alpha1Interface interface {
+	RESTClient() rest.Interface
+	LogEndpointsGetter
+}
+
+// LoggingV1alpha1Client is used to interact with features provided by the logging.caicloud.io group.
+type LoggingV1alpha1Client struct {
+	restClient rest.Interface
+}
+
+func (c *LoggingV1alpha1Client) LogEndpoints() LogEndpointInterface {
+	return newLogEndpoints(c)
+}
+
+// NewForConfig creates a new LoggingV1alpha1Client for the given config.
+func NewForConfig(c *rest.Config) (*LoggingV1alpha1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &LoggingV1alpha1Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new LoggingV1alpha1Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *LoggingV1alpha1Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new LoggingV1alpha1Client for the given RESTClient.
+func New(c rest.Interface) *LoggingV1alpha1Client {
+	return &LoggingV1alpha1Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1alpha1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *LoggingV1alpha1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/model/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ModelsGetter has a method to return a ModelInterface.
+// A group's client should implement this interface.
+type ModelsGetter interface {
+	Models() ModelInterface
+}
+
+// ModelInterface has methods to work with Model resources.
+type ModelInterface interface {
+	Create(*v1alpha1.Model) (*v1alpha1.Model, error)
+	Update(*v1alpha1.Model) (*v1alpha1.Model, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Model, error)
+	List(opts v1.ListOptions) (*v1alpha1.ModelList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Model, err error)
+	ModelExpansion
+}
+
+// models implements ModelInterface
+type models struct {
+	client rest.Interface
+}
+
+// newModels returns a Models
+func newModels(c *ModelV1alpha1Client) *models {
+	return &models{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the model, and returns the corresponding model object, and an error if there is any.
+func (c *models) Get(name string, options v1.GetOptions) (result *v1alpha1.Model, err error) {
+	result = &v1alpha1.Model{}
+	err = c.client.Get().
+		Resource("models").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Models that match those selectors.
+func (c *models) List(opts v1.ListOptions) (result *v1alpha1.ModelList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ModelList{}
+	err = c.client.Get().
+		Resource("models").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested models.
+func (c *models) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("models").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a model and creates it.  Returns the server's representation of the model, and an error, if there is any.
+func (c *models) Create(model *v1alpha1.Model) (result *v1alpha1.Model, err error) {
+	result = &v1alpha1.Model{}
+	err = c.client.Post().
+		Resource("models").
+		Body(model).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a model and updates it. Returns the server's representation of the model, and an error, if there is any.
+func (c *models) Update(model *v1alpha1.Model) (result *v1alpha1.Model, err error) {
+	result = &v1alpha1.Model{}
+	err = c.client.Put().
+		Resource("models").
+		Name(model.Name).
+		Body(model).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the model and deletes it. Returns an error if one occurs.
+func (c *models) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("models").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *models) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("models").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched model.
+func (c *models) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Model, err error) {
+	result = &v1alpha1.Model{}
+	err = c.client.Patch(pt).
+		Resource("models").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
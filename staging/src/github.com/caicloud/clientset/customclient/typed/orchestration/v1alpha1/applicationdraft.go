@@ -0,0 +1,162 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/orchestration/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ApplicationDraftsGetter has a method to return a ApplicationDraftInterface.
+// A group's client should implement this interface.
+type ApplicationDraftsGetter interface {
+	ApplicationDrafts(namespace string) ApplicationDraftInterface
+}
+
+// ApplicationDraftInterface has methods to work with ApplicationDraft resources.
+type ApplicationDraftInterface interface {
+	Create(*v1alpha1.ApplicationDraft) (*v1alpha1.ApplicationDraft, error)
+	Update(*v1alpha1.ApplicationDraft) (*v1alpha1.ApplicationDraft, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.ApplicationDraft, error)
+	List(opts v1.ListOptions) (*v1alpha1.ApplicationDraftList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ApplicationDraft, err error)
+	ApplicationDraftExpansion
+}
+
+// applicationDrafts implements ApplicationDraftInterface
+type applicationDrafts struct {
+	client rest.Interface
+	ns     string
+}
+
+// newApplicationDrafts returns a ApplicationDrafts
+func newApplicationDrafts(c *OrchestrationV1alpha1Client, namespace string) *applicationDrafts {
+	return &applicationDrafts{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the applicationDraft, and returns the corresponding applicationDraft object, and an error if there is any.
+func (c *applicationDrafts) Get(name string, options v1.GetOptions) (result *v1alpha1.ApplicationDraft, err error) {
+	result = &v1alpha1.ApplicationDraft{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ApplicationDrafts that match those selectors.
+func (c *applicationDrafts) List(opts v1.ListOptions) (result *v1alpha1.ApplicationDraftList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ApplicationDraftList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested applicationDrafts.
+func (c *applicationDrafts) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a applicationDraft and creates it.  Returns the server's representation of the applicationDraft, and an error, if there is any.
+func (c *applicationDrafts) Create(applicationDraft *v1alpha1.ApplicationDraft) (result *v1alpha1.ApplicationDraft, err error) {
+	result = &v1alpha1.ApplicationDraft{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		Body(applicationDraft).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a applicationDraft and updates it. Returns the server's representation of the applicationDraft, and an error, if there is any.
+func (c *applicationDrafts) Update(applicationDraft *v1alpha1.ApplicationDraft) (result *v1alpha1.ApplicationDraft, err error) {
+	result = &v1alpha1.ApplicationDraft{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		Name(applicationDraft.Name).
+		Body(applicationDraft).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the applicationDraft and deletes it. Returns an error if one occurs.
+func (c *applicationDrafts) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *applicationDrafts) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched applicationDraft.
+func (c *applicationDrafts) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ApplicationDraft, err error) {
+	result = &v1alpha1.ApplicationDraft{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("applicationdrafts").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,13 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+type CanaryReleaseExpansion interface{}
+
+type ReleaseExpansion interface{}
+
+type ReleaseHistoryExpansion interface{}
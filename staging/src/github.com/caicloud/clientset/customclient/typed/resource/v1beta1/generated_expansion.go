@@ -0,0 +1,39 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+type ClusterExpansion interface{}
+
+type ConfigExpansion interface{}
+
+type ExtendedResourceExpansion interface{}
+
+type InfraNetworkExpansion interface{}
+
+type MachineExpansion interface{}
+
+type MachineAutoScalingGroupExpansion interface{}
+
+type NetworkExpansion interface{}
+
+type NodeClaimExpansion interface{}
+
+type NodeLocalStorageExpansion interface{}
+
+type RequirementGapExpansion interface{}
+
+type ResourceClassExpansion interface{}
+
+type SnapshotExpansion interface{}
+
+type StorageServiceExpansion interface{}
+
+type StorageTypeExpansion interface{}
+
+type TagExpansion interface{}
+
+type WorkloadNetworkExpansion interface{}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"github.com/caicloud/clientset/customclient/scheme"
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	rest "k8s.io/client-go/rest"
+)
+
+type ResourceV1beta1Interface interface {
+	RESTClient() rest.Interface
+	ClustersGetter
+	ConfigsGetter
+	ExtendedResourcesGetter
+	InfraNetworksGetter
+	MachinesGetter
+	MachineAutoScalingGroupsGetter
+	NetworksGetter
+	NodeClaimsGetter
+	NodeLocalStoragesGetter
+	RequirementGapsGetter
+	ResourceClassesGetter
+	SnapshotsGetter
+	StorageServicesGetter
+	StorageTypesGetter
+	TagsGetter
+	WorkloadNetworksGetter
+}
+
+// ResourceV1beta1Client is used to interact with features provided by the resource.caicloud.io group.
+type ResourceV1beta1Client struct {
+	restClient rest.Interface
+}
+
+func (c *ResourceV1beta1Client) Clusters() ClusterInterface {
+	return newClusters(c)
+}
+
+func (c *ResourceV1beta1Client) Configs() ConfigInterface {
+	return newConfigs(c)
+}
+
+func (c *ResourceV1beta1Client) ExtendedResources() ExtendedResourceInterface {
+	return newExtendedResources(c)
+}
+
+func (c *ResourceV1beta1Client) InfraNetworks() InfraNetworkInterface {
+	return newInfraNetworks(c)
+}
+
+func (c *ResourceV1beta1Client) Machines() MachineInterface {
+	return newMachines(c)
+}
+
+func (c *ResourceV1beta1Client) MachineAutoScalingGroups() MachineAutoScalingGroupInterface {
+	return newMachineAutoScalingGroups(c)
+}
+
+func (c *ResourceV1beta1Client) Networks() NetworkInterface {
+	return newNetworks(c)
+}
+
+func (c *ResourceV1beta1Client) NodeClaims() NodeClaimInterface {
+	return newNodeClaims(c)
+}
+
+func (c *ResourceV1beta1Client) NodeLocalStorages() NodeLocalStorageInterface {
+	return newNodeLocalStorages(c)
+}
+
+func (c *ResourceV1beta1Client) RequirementGaps() RequirementGapInterface {
+	return newRequirementGaps(c)
+}
+
+func (c *ResourceV1beta1Client) ResourceClasses() ResourceClassInterface {
+	return newResourceClasses(c)
+}
+
+func (c *ResourceV1beta1Client) Snapshots(namespace string) SnapshotInterface {
+	return newSnapshots(c, namespace)
+}
+
+func (c *ResourceV1beta1Client) StorageServices() StorageServiceInterface {
+	return newStorageServices(c)
+}
+
+func (c *ResourceV1beta1Client) StorageTypes() StorageTypeInterface {
+	return newStorageTypes(c)
+}
+
+func (c *ResourceV1beta1Client) Tags() TagInterface {
+	return newTags(c)
+}
+
+func (c *ResourceV1beta1Client) WorkloadNetworks(namespace string) WorkloadNetworkInterface {
+	return newWorkloadNetworks(c, namespace)
+}
+
+// NewForConfig creates a new ResourceV1beta1Client for the given config.
+func NewForConfig(c *rest.Config) (*ResourceV1beta1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return &ResourceV1beta1Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new ResourceV1beta1Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *ResourceV1beta1Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new ResourceV1beta1Client for the given RESTClient.
+func New(c rest.Interface) *ResourceV1beta1Client {
+	return &ResourceV1beta1Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1beta1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *ResourceV1beta1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/servicemesh/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// IstiosGetter has a method to return a IstioInterface.
+// A group's client should implement this interface.
+type IstiosGetter interface {
+	Istios() IstioInterface
+}
+
+// IstioInterface has methods to work with Istio resources.
+type IstioInterface interface {
+	Create(*v1alpha1.Istio) (*v1alpha1.Istio, error)
+	Update(*v1alpha1.Istio) (*v1alpha1.Istio, error)
+	UpdateStatus(*v1alpha1.Istio) (*v1alpha1.Istio, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Istio, error)
+	List(opts v1.ListOptions) (*v1alpha1.IstioList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Istio, err error)
+	IstioExpansion
+}
+
+// istios implements IstioInterface
+type istios struct {
+	client rest.Interface
+}
+
+// newIstios returns a Istios
+func newIstios(c *ServicemeshV1alpha1Client) *istios {
+	return &istios{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the istio, and returns the corresponding istio object, and an error if there is any.
+func (c *istios) Get(name string, options v1.GetOptions) (result *v1alpha1.Istio, err error) {
+	result = &v1alpha1.Istio{}
+	err = c.client.Get().
+		Resource("istios").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Istios that match those selectors.
+func (c *istios) List(opts v1.ListOptions) (result *v1alpha1.IstioList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.IstioList{}
+	err = c.client.Get().
+		Resource("istios").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested istios.
+func (c *istios) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("istios").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a istio and creates it.  Returns the server's representation of the istio, and an error, if there is any.
+func (c *istios) Create(istio *v1alpha1.Istio) (result *v1alpha1.Istio, err error) {
+	result = &v1alpha1.Istio{}
+	err = c.client.Post().
+		Resource("istios").
+		Body(istio).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a istio and updates it. Returns the server's representation of the istio, and an error, if there is any.
+func (c *istios) Update(istio *v1alpha1.Istio) (result *v1alpha1.Istio, err error) {
+	result = &v1alpha1.Istio{}
+	err = c.client.Put().
+		Resource("istios").
+		Name(istio.Name).
+		Body(istio).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *istios) UpdateStatus(istio *v1alpha1.Istio) (result *v1alpha1.Istio, err error) {
+	result = &v1alpha1.Istio{}
+	err = c.client.Put().
+		Resource("istios").
+		Name(istio.Name).
+		SubResource("status").
+		Body(istio).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the istio and deletes it. Returns an error if one occurs.
+func (c *istios) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("istios").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *istios) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("istios").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched istio.
+func (c *istios) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Istio, err error) {
+	result = &v1alpha1.Istio{}
+	err = c.client.Patch(pt).
+		Resource("istios").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
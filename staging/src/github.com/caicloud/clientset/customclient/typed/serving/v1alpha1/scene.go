@@ -0,0 +1,179 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/serving/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ScenesGetter has a method to return a SceneInterface.
+// A group's client should implement this interface.
+type ScenesGetter interface {
+	Scenes(namespace string) SceneInterface
+}
+
+// SceneInterface has methods to work with Scene resources.
+type SceneInterface interface {
+	Create(*v1alpha1.Scene) (*v1alpha1.Scene, error)
+	Update(*v1alpha1.Scene) (*v1alpha1.Scene, error)
+	UpdateStatus(*v1alpha1.Scene) (*v1alpha1.Scene, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Scene, error)
+	List(opts v1.ListOptions) (*v1alpha1.SceneList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Scene, err error)
+	SceneExpansion
+}
+
+// scenes implements SceneInterface
+type scenes struct {
+	client rest.Interface
+	ns     string
+}
+
+// newScenes returns a Scenes
+func newScenes(c *ServingV1alpha1Client, namespace string) *scenes {
+	return &scenes{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the scene, and returns the corresponding scene object, and an error if there is any.
+func (c *scenes) Get(name string, options v1.GetOptions) (result *v1alpha1.Scene, err error) {
+	result = &v1alpha1.Scene{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scenes").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Scenes that match those selectors.
+func (c *scenes) List(opts v1.ListOptions) (result *v1alpha1.SceneList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.SceneList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("scenes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested scenes.
+func (c *scenes) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("scenes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a scene and creates it.  Returns the server's representation of the scene, and an error, if there is any.
+func (c *scenes) Create(scene *v1alpha1.Scene) (result *v1alpha1.Scene, err error) {
+	result = &v1alpha1.Scene{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("scenes").
+		Body(scene).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a scene and updates it. Returns the server's representation of the scene, and an error, if there is any.
+func (c *scenes) Update(scene *v1alpha1.Scene) (result *v1alpha1.Scene, err error) {
+	result = &v1alpha1.Scene{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scenes").
+		Name(scene.Name).
+		Body(scene).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *scenes) UpdateStatus(scene *v1alpha1.Scene) (result *v1alpha1.Scene, err error) {
+	result = &v1alpha1.Scene{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("scenes").
+		Name(scene.Name).
+		SubResource("status").
+		Body(scene).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the scene and deletes it. Returns an error if one occurs.
+func (c *scenes) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scenes").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *scenes) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("scenes").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched scene.
+func (c *scenes) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Scene, err error) {
+	result = &v1alpha1.Scene{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("scenes").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/serving/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ServingsGetter has a method to return a ServingInterface.
+// A group's client should implement this interface.
+type ServingsGetter interface {
+	Servings(namespace string) ServingInterface
+}
+
+// ServingInterface has methods to work with Serving resources.
+type ServingInterface interface {
+	Create(*v1alpha1.Serving) (*v1alpha1.Serving, error)
+	Update(*v1alpha1.Serving) (*v1alpha1.Serving, error)
+	UpdateStatus(*v1alpha1.Serving) (*v1alpha1.Serving, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Serving, error)
+	List(opts v1.ListOptions) (*v1alpha1.ServingList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Serving, err error)
+	ServingExpansion
+}
+
+// servings implements ServingInterface
+type servings struct {
+	client rest.Interface
+	ns     string
+}
+
+// newServings returns a Servings
+func newServings(c *ServingV1alpha1Client, namespace string) *servings {
+	return &servings{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the serving, and returns the corresponding serving object, and an error if there is any.
+func (c *servings) Get(name string, options v1.GetOptions) (result *v1alpha1.Serving, err error) {
+	result = &v1alpha1.Serving{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("servings").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Servings that match those selectors.
+func (c *servings) List(opts v1.ListOptions) (result *v1alpha1.ServingList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ServingList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("servings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested servings.
+func (c *servings) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("servings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a serving and creates it.  Returns the server's representation of the serving, and an error, if there is any.
+func (c *servings) Create(serving *v1alpha1.Serving) (result *v1alpha1.Serving, err error) {
+	result = &v1alpha1.Serving{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("servings").
+		Body(serving).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a serving and updates it. Returns the server's representation of the serving, and an error, if there is any.
+func (c *servings) Update(serving *v1alpha1.Serving) (result *v1alpha1.Serving, err error) {
+	result = &v1alpha1.Serving{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("servings").
+		Name(serving.Name).
+		Body(serving).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *servings) UpdateStatus(serving *v1alpha1.Serving) (result *v1alpha1.Serving, err error) {
+	result = &v1alpha1.Serving{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("servings").
+		Name(serving.Name).
+		SubResource("status").
+		Body(serving).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the serving and deletes it. Returns an error if one occurs.
+func (c *servings) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("servings").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *servings) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("servings").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched serving.
+func (c *servings) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Serving, err error) {
+	result = &v1alpha1.Serving{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("servings").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	scheme "github.com/caicloud/clientset/customclient/scheme"
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/tenant/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterQuotasGetter has a method to return a ClusterQuotaInterface.
+// A group's client should implement this interface.
+type ClusterQuotasGetter interface {
+	ClusterQuotas() ClusterQuotaInterface
+}
+
+// ClusterQuotaInterface has methods to work with ClusterQuota resources.
+type ClusterQuotaInterface interface {
+	Create(*v1alpha1.ClusterQuota) (*v1alpha1.ClusterQuota, error)
+	Update(*v1alpha1.ClusterQuota) (*v1alpha1.ClusterQuota, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.ClusterQuota, error)
+	List(opts v1.ListOptions) (*v1alpha1.ClusterQuotaList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterQuota, err error)
+	ClusterQuotaExpansion
+}
+
+// clusterQuotas implements ClusterQuotaInterface
+type clusterQuotas struct {
+	client rest.Interface
+}
+
+// newClusterQuotas returns a ClusterQuotas
+func newClusterQuotas(c *TenantV1alpha1Client) *clusterQuotas {
+	return &clusterQuotas{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterQuota, and returns the corresponding clusterQuota object, and an error if there is any.
+func (c *clusterQuotas) Get(name string, options v1.GetOptions) (result *v1alpha1.ClusterQuota, err error) {
+	result = &v1alpha1.ClusterQuota{}
+	err = c.client.Get().
+		Resource("clusterquotas").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterQuotas that match those selectors.
+func (c *clusterQuotas) List(opts v1.ListOptions) (result *v1alpha1.ClusterQuotaList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterQuotaList{}
+	err = c.client.Get().
+		Resource("clusterquotas").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterQuotas.
+func (c *clusterQuotas) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clusterquotas").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a clusterQuota and creates it.  Returns the server's representation of the clusterQuota, and an error, if there is any.
+func (c *clusterQuotas) Create(clusterQuota *v1alpha1.ClusterQuota) (result *v1alpha1.ClusterQuota, err error) {
+	result = &v1alpha1.ClusterQuota{}
+	err = c.client.Post().
+		Resource("clusterquotas").
+		Body(clusterQuota).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterQuota and updates it. Returns the server's representation of the clusterQuota, and an error, if there is any.
+func (c *clusterQuotas) Update(clusterQuota *v1alpha1.ClusterQuota) (result *v1alpha1.ClusterQuota, err error) {
+	result = &v1alpha1.ClusterQuota{}
+	err = c.client.Put().
+		Resource("clusterquotas").
+		Name(clusterQuota.Name).
+		Body(clusterQuota).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterQuota and deletes it. Returns an error if one occurs.
+func (c *clusterQuotas) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clusterquotas").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterQuotas) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clusterquotas").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterQuota.
+func (c *clusterQuotas) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ClusterQuota, err error) {
+	result = &v1alpha1.ClusterQuota{}
+	err = c.client.Patch(pt).
+		Resource("clusterquotas").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
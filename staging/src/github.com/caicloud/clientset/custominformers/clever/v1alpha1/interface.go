@@ -0,0 +1,47 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// Flavors returns a FlavorInformer.
+	Flavors() FlavorInformer
+	// Projects returns a ProjectInformer.
+	Projects() ProjectInformer
+	// Templates returns a TemplateInformer.
+	Templates() TemplateInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// Flavors returns a FlavorInformer.
+func (v *version) Flavors() FlavorInformer {
+	return &flavorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Projects returns a ProjectInformer.
+func (v *version) Projects() ProjectInformer {
+	return &projectInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Templates returns a TemplateInformer.
+func (v *version) Templates() TemplateInformer {
+	return &templateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha1 "github.com/caicloud/clientset/listers/clever/v1alpha1"
+	cleverv1alpha1 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// TemplateInformer provides access to a shared informer and lister for
+// Templates.
+type TemplateInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.TemplateLister
+}
+
+type templateInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewTemplateInformer constructs a new informer for Template type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewTemplateInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredTemplateInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredTemplateInformer constructs a new informer for Template type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredTemplateInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CleverV1alpha1().Templates().List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CleverV1alpha1().Templates().Watch(options)
+			},
+		},
+		&cleverv1alpha1.Template{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *templateInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredTemplateInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *templateInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&cleverv1alpha1.Template{}, f.defaultInformer)
+}
+
+func (f *templateInformer) Lister() v1alpha1.TemplateLister {
+	return v1alpha1.NewTemplateLister(f.Informer().GetIndexer())
+}
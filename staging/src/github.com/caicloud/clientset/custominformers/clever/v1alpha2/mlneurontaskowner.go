@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha2 "github.com/caicloud/clientset/listers/clever/v1alpha2"
+	cleverv1alpha2 "github.com/caicloud/clientset/pkg/apis/clever/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MLNeuronTaskOwnerInformer provides access to a shared informer and lister for
+// MLNeuronTaskOwners.
+type MLNeuronTaskOwnerInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha2.MLNeuronTaskOwnerLister
+}
+
+type mLNeuronTaskOwnerInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewMLNeuronTaskOwnerInformer constructs a new informer for MLNeuronTaskOwner type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewMLNeuronTaskOwnerInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredMLNeuronTaskOwnerInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredMLNeuronTaskOwnerInformer constructs a new informer for MLNeuronTaskOwner type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredMLNeuronTaskOwnerInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CleverV1alpha2().MLNeuronTaskOwners(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CleverV1alpha2().MLNeuronTaskOwners(namespace).Watch(options)
+			},
+		},
+		&cleverv1alpha2.MLNeuronTaskOwner{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *mLNeuronTaskOwnerInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredMLNeuronTaskOwnerInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *mLNeuronTaskOwnerInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&cleverv1alpha2.MLNeuronTaskOwner{}, f.defaultInformer)
+}
+
+func (f *mLNeuronTaskOwnerInformer) Lister() v1alpha2.MLNeuronTaskOwnerLister {
+	return v1alpha2.NewMLNeuronTaskOwnerLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// ConfigClaims returns a ConfigClaimInformer.
+	ConfigClaims() ConfigClaimInformer
+	// ConfigReferences returns a ConfigReferenceInformer.
+	ConfigReferences() ConfigReferenceInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// ConfigClaims returns a ConfigClaimInformer.
+func (v *version) ConfigClaims() ConfigClaimInformer {
+	return &configClaimInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ConfigReferences returns a ConfigReferenceInformer.
+func (v *version) ConfigReferences() ConfigReferenceInformer {
+	return &configReferenceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1 "github.com/caicloud/clientset/listers/devops/v1"
+	devopsv1 "github.com/caicloud/clientset/pkg/apis/devops/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// CargoInformer provides access to a shared informer and lister for
+// Cargos.
+type CargoInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.CargoLister
+}
+
+type cargoInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewCargoInformer constructs a new informer for Cargo type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewCargoInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredCargoInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredCargoInformer constructs a new informer for Cargo type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredCargoInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DevopsV1().Cargos().List(options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.DevopsV1().Cargos().Watch(options)
+			},
+		},
+		&devopsv1.Cargo{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *cargoInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredCargoInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *cargoInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&devopsv1.Cargo{}, f.defaultInformer)
+}
+
+func (f *cargoInformer) Lister() v1.CargoLister {
+	return v1.NewCargoLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha1 "github.com/caicloud/clientset/listers/evaluation/v1alpha1"
+	evaluationv1alpha1 "github.com/caicloud/clientset/pkg/apis/evaluation/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EvalJobInformer provides access to a shared informer and lister for
+// EvalJobs.
+type EvalJobInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.EvalJobLister
+}
+
+type evalJobInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewEvalJobInformer constructs a new informer for EvalJob type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewEvalJobInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredEvalJobInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredEvalJobInformer constructs a new informer for EvalJob type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredEvalJobInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.EvaluationV1alpha1().EvalJobs(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.EvaluationV1alpha1().EvalJobs(namespace).Watch(options)
+			},
+		},
+		&evaluationv1alpha1.EvalJob{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *evalJobInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredEvalJobInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *evalJobInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&evaluationv1alpha1.EvalJob{}, f.defaultInformer)
+}
+
+func (f *evalJobInformer) Lister() v1alpha1.EvalJobLister {
+	return v1alpha1.NewEvalJobLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha2 "github.com/caicloud/clientset/listers/loadbalance/v1alpha2"
+	loadbalancev1alpha2 "github.com/caicloud/clientset/pkg/apis/loadbalance/v1alpha2"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// LoadBalancerInformer provides access to a shared informer and lister for
+// LoadBalancers.
+type LoadBalancerInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha2.LoadBalancerLister
+}
+
+type loadBalancerInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewLoadBalancerInformer constructs a new informer for LoadBalancer type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewLoadBalancerInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredLoadBalancerInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredLoadBalancerInformer constructs a new informer for LoadBalancer type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredLoadBalancerInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.LoadbalanceV1alpha2().LoadBalancers(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.LoadbalanceV1alpha2().LoadBalancers(namespace).Watch(options)
+			},
+		},
+		&loadbalancev1alpha2.LoadBalancer{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *loadBalancerInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredLoadBalancerInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *loadBalancerInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&loadbalancev1alpha2.LoadBalancer{}, f.defaultInformer)
+}
+
+func (f *loadBalancerInformer) Lister() v1alpha2.LoadBalancerLister {
+	return v1alpha2.NewLoadBalancerLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha1 "github.com/caicloud/clientset/listers/microservice/v1alpha1"
+	microservicev1alpha1 "github.com/caicloud/clientset/pkg/apis/microservice/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// SpringcloudInformer provides access to a shared informer and lister for
+// Springclouds.
+type SpringcloudInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.SpringcloudLister
+}
+
+type springcloudInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewSpringcloudInformer constructs a new informer for Springcloud type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewSpringcloudInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredSpringcloudInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredSpringcloudInformer constructs a new informer for Springcloud type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredSpringcloudInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.MicroserviceV1alpha1().Springclouds(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.MicroserviceV1alpha1().Springclouds(namespace).Watch(options)
+			},
+		},
+		&microservicev1alpha1.Springcloud{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *springcloudInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredSpringcloudInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *springcloudInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&microservicev1alpha1.Springcloud{}, f.defaultInformer)
+}
+
+func (f *springcloudInformer) Lister() v1alpha1.SpringcloudLister {
+	return v1alpha1.NewSpringcloudLister(f.Informer().GetIndexer())
+}
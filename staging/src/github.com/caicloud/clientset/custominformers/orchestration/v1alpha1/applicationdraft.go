@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha1 "github.com/caicloud/clientset/listers/orchestration/v1alpha1"
+	orchestrationv1alpha1 "github.com/caicloud/clientset/pkg/apis/orchestration/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ApplicationDraftInformer provides access to a shared informer and lister for
+// ApplicationDrafts.
+type ApplicationDraftInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ApplicationDraftLister
+}
+
+type applicationDraftInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewApplicationDraftInformer constructs a new informer for ApplicationDraft type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewApplicationDraftInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredApplicationDraftInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredApplicationDraftInformer constructs a new informer for ApplicationDraft type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredApplicationDraftInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OrchestrationV1alpha1().ApplicationDrafts(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.OrchestrationV1alpha1().ApplicationDrafts(namespace).Watch(options)
+			},
+		},
+		&orchestrationv1alpha1.ApplicationDraft{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *applicationDraftInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredApplicationDraftInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *applicationDraftInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&orchestrationv1alpha1.ApplicationDraft{}, f.defaultInformer)
+}
+
+func (f *applicationDraftInformer) Lister() v1alpha1.ApplicationDraftLister {
+	return v1alpha1.NewApplicationDraftLister(f.Informer().GetIndexer())
+}
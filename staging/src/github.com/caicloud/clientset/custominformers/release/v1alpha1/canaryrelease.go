@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha1 "github.com/caicloud/clientset/listers/release/v1alpha1"
+	releasev1alpha1 "github.com/caicloud/clientset/pkg/apis/release/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// CanaryReleaseInformer provides access to a shared informer and lister for
+// CanaryReleases.
+type CanaryReleaseInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.CanaryReleaseLister
+}
+
+type canaryReleaseInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewCanaryReleaseInformer constructs a new informer for CanaryRelease type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewCanaryReleaseInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredCanaryReleaseInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredCanaryReleaseInformer constructs a new informer for CanaryRelease type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredCanaryReleaseInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ReleaseV1alpha1().CanaryReleases(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ReleaseV1alpha1().CanaryReleases(namespace).Watch(options)
+			},
+		},
+		&releasev1alpha1.CanaryRelease{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *canaryReleaseInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredCanaryReleaseInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *canaryReleaseInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&releasev1alpha1.CanaryRelease{}, f.defaultInformer)
+}
+
+func (f *canaryReleaseInformer) Lister() v1alpha1.CanaryReleaseLister {
+	return v1alpha1.NewCanaryReleaseLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// StorageServices returns a StorageServiceInformer.
+	StorageServices() StorageServiceInformer
+	// StorageTypes returns a StorageTypeInformer.
+	StorageTypes() StorageTypeInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// StorageServices returns a StorageServiceInformer.
+func (v *version) StorageServices() StorageServiceInformer {
+	return &storageServiceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// StorageTypes returns a StorageTypeInformer.
+func (v *version) StorageTypes() StorageTypeInformer {
+	return &storageTypeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
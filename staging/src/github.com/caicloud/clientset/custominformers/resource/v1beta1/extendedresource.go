@@ -0,0 +1,76 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1beta1 "github.com/caicloud/clientset/listers/resource/v1beta1"
+	resourcev1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ExtendedResourceInformer provides access to a shared informer and lister for
+// ExtendedResources.
+type ExtendedResourceInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.ExtendedResourceLister
+}
+
+type extendedResourceInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewExtendedResourceInformer constructs a new informer for ExtendedResource type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewExtendedResourceInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredExtendedResourceInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredExtendedResourceInformer constructs a new informer for ExtendedResource type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredExtendedResourceInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ResourceV1beta1().ExtendedResources().List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ResourceV1beta1().ExtendedResources().Watch(options)
+			},
+		},
+		&resourcev1beta1.ExtendedResource{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *extendedResourceInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredExtendedResourceInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *extendedResourceInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&resourcev1beta1.ExtendedResource{}, f.defaultInformer)
+}
+
+func (f *extendedResourceInformer) Lister() v1beta1.ExtendedResourceLister {
+	return v1beta1.NewExtendedResourceLister(f.Informer().GetIndexer())
+}
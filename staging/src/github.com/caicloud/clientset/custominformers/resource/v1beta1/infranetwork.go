@@ -0,0 +1,76 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1beta1 "github.com/caicloud/clientset/listers/resource/v1beta1"
+	resourcev1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// InfraNetworkInformer provides access to a shared informer and lister for
+// InfraNetworks.
+type InfraNetworkInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.InfraNetworkLister
+}
+
+type infraNetworkInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewInfraNetworkInformer constructs a new informer for InfraNetwork type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewInfraNetworkInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredInfraNetworkInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredInfraNetworkInformer constructs a new informer for InfraNetwork type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredInfraNetworkInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ResourceV1beta1().InfraNetworks().List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ResourceV1beta1().InfraNetworks().Watch(options)
+			},
+		},
+		&resourcev1beta1.InfraNetwork{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *infraNetworkInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredInfraNetworkInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *infraNetworkInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&resourcev1beta1.InfraNetwork{}, f.defaultInformer)
+}
+
+func (f *infraNetworkInformer) Lister() v1beta1.InfraNetworkLister {
+	return v1beta1.NewInfraNetworkLister(f.Informer().GetIndexer())
+}
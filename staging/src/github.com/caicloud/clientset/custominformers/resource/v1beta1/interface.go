@@ -0,0 +1,138 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// Clusters returns a ClusterInformer.
+	Clusters() ClusterInformer
+	// Configs returns a ConfigInformer.
+	Configs() ConfigInformer
+	// ExtendedResources returns a ExtendedResourceInformer.
+	ExtendedResources() ExtendedResourceInformer
+	// InfraNetworks returns a InfraNetworkInformer.
+	InfraNetworks() InfraNetworkInformer
+	// Machines returns a MachineInformer.
+	Machines() MachineInformer
+	// MachineAutoScalingGroups returns a MachineAutoScalingGroupInformer.
+	MachineAutoScalingGroups() MachineAutoScalingGroupInformer
+	// Networks returns a NetworkInformer.
+	Networks() NetworkInformer
+	// NodeClaims returns a NodeClaimInformer.
+	NodeClaims() NodeClaimInformer
+	// NodeLocalStorages returns a NodeLocalStorageInformer.
+	NodeLocalStorages() NodeLocalStorageInformer
+	// RequirementGaps returns a RequirementGapInformer.
+	RequirementGaps() RequirementGapInformer
+	// ResourceClasses returns a ResourceClassInformer.
+	ResourceClasses() ResourceClassInformer
+	// Snapshots returns a SnapshotInformer.
+	Snapshots() SnapshotInformer
+	// StorageServices returns a StorageServiceInformer.
+	StorageServices() StorageServiceInformer
+	// StorageTypes returns a StorageTypeInformer.
+	StorageTypes() StorageTypeInformer
+	// Tags returns a TagInformer.
+	Tags() TagInformer
+	// WorkloadNetworks returns a WorkloadNetworkInformer.
+	WorkloadNetworks() WorkloadNetworkInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// Clusters returns a ClusterInformer.
+func (v *version) Clusters() ClusterInformer {
+	return &clusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Configs returns a ConfigInformer.
+func (v *version) Configs() ConfigInformer {
+	return &configInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ExtendedResources returns a ExtendedResourceInformer.
+func (v *version) ExtendedResources() ExtendedResourceInformer {
+	return &extendedResourceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// InfraNetworks returns a InfraNetworkInformer.
+func (v *version) InfraNetworks() InfraNetworkInformer {
+	return &infraNetworkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Machines returns a MachineInformer.
+func (v *version) Machines() MachineInformer {
+	return &machineInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// MachineAutoScalingGroups returns a MachineAutoScalingGroupInformer.
+func (v *version) MachineAutoScalingGroups() MachineAutoScalingGroupInformer {
+	return &machineAutoScalingGroupInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Networks returns a NetworkInformer.
+func (v *version) Networks() NetworkInformer {
+	return &networkInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// NodeClaims returns a NodeClaimInformer.
+func (v *version) NodeClaims() NodeClaimInformer {
+	return &nodeClaimInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// NodeLocalStorages returns a NodeLocalStorageInformer.
+func (v *version) NodeLocalStorages() NodeLocalStorageInformer {
+	return &nodeLocalStorageInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// RequirementGaps returns a RequirementGapInformer.
+func (v *version) RequirementGaps() RequirementGapInformer {
+	return &requirementGapInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ResourceClasses returns a ResourceClassInformer.
+func (v *version) ResourceClasses() ResourceClassInformer {
+	return &resourceClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Snapshots returns a SnapshotInformer.
+func (v *version) Snapshots() SnapshotInformer {
+	return &snapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// StorageServices returns a StorageServiceInformer.
+func (v *version) StorageServices() StorageServiceInformer {
+	return &storageServiceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// StorageTypes returns a StorageTypeInformer.
+func (v *version) StorageTypes() StorageTypeInformer {
+	return &storageTypeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Tags returns a TagInformer.
+func (v *version) Tags() TagInformer {
+	return &tagInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// WorkloadNetworks returns a WorkloadNetworkInformer.
+func (v *version) WorkloadNetworks() WorkloadNetworkInformer {
+	return &workloadNetworkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
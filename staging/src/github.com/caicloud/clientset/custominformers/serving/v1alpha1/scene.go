@@ -0,0 +1,77 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha1 "github.com/caicloud/clientset/listers/serving/v1alpha1"
+	servingv1alpha1 "github.com/caicloud/clientset/pkg/apis/serving/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// SceneInformer provides access to a shared informer and lister for
+// Scenes.
+type SceneInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.SceneLister
+}
+
+type sceneInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewSceneInformer constructs a new informer for Scene type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewSceneInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredSceneInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredSceneInformer constructs a new informer for Scene type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredSceneInformer(client customclient.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ServingV1alpha1().Scenes(namespace).List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ServingV1alpha1().Scenes(namespace).Watch(options)
+			},
+		},
+		&servingv1alpha1.Scene{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *sceneInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredSceneInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *sceneInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&servingv1alpha1.Scene{}, f.defaultInformer)
+}
+
+func (f *sceneInformer) Lister() v1alpha1.SceneLister {
+	return v1alpha1.NewSceneLister(f.Informer().GetIndexer())
+}
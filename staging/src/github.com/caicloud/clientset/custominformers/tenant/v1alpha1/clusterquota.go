@@ -0,0 +1,76 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	time "time"
+
+	customclient "github.com/caicloud/clientset/customclient"
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+	v1alpha1 "github.com/caicloud/clientset/listers/tenant/v1alpha1"
+	tenantv1alpha1 "github.com/caicloud/clientset/pkg/apis/tenant/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ClusterQuotaInformer provides access to a shared informer and lister for
+// ClusterQuotas.
+type ClusterQuotaInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.ClusterQuotaLister
+}
+
+type clusterQuotaInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewClusterQuotaInformer constructs a new informer for ClusterQuota type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewClusterQuotaInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredClusterQuotaInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredClusterQuotaInformer constructs a new informer for ClusterQuota type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredClusterQuotaInformer(client customclient.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.TenantV1alpha1().ClusterQuotas().List(options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.TenantV1alpha1().ClusterQuotas().Watch(options)
+			},
+		},
+		&tenantv1alpha1.ClusterQuota{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *clusterQuotaInformer) defaultInformer(client customclient.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredClusterQuotaInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *clusterQuotaInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&tenantv1alpha1.ClusterQuota{}, f.defaultInformer)
+}
+
+func (f *clusterQuotaInformer) Lister() v1alpha1.ClusterQuotaLister {
+	return v1alpha1.NewClusterQuotaLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	internalinterfaces "github.com/caicloud/clientset/custominformers/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// ClusterQuotas returns a ClusterQuotaInformer.
+	ClusterQuotas() ClusterQuotaInformer
+	// Partitions returns a PartitionInformer.
+	Partitions() PartitionInformer
+	// Tenants returns a TenantInformer.
+	Tenants() TenantInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// ClusterQuotas returns a ClusterQuotaInformer.
+func (v *version) ClusterQuotas() ClusterQuotaInformer {
+	return &clusterQuotaInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Partitions returns a PartitionInformer.
+func (v *version) Partitions() PartitionInformer {
+	return &partitionInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Tenants returns a TenantInformer.
+func (v *version) Tenants() TenantInformer {
+	return &tenantInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,11 @@
+module github.com/caicloud/clientset
+
+go 1.13
+
+require (
+	k8s.io/api v0.17.5
+	k8s.io/apiextensions-apiserver v0.17.5
+	k8s.io/apimachinery v0.17.5
+	k8s.io/client-go v0.17.5
+	k8s.io/klog v1.0.0
+)
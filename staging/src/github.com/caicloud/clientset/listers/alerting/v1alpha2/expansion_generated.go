@@ -0,0 +1,15 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// AlertingRuleListerExpansion allows custom methods to be added to
+// AlertingRuleLister.
+type AlertingRuleListerExpansion interface{}
+
+// AlertingSubRuleListerExpansion allows custom methods to be added to
+// AlertingSubRuleLister.
+type AlertingSubRuleListerExpansion interface{}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "github.com/caicloud/clientset/pkg/apis/apiregistration/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// APIServiceLister helps list APIServices.
+type APIServiceLister interface {
+	// List lists all APIServices in the indexer.
+	List(selector labels.Selector) (ret []*v1.APIService, err error)
+	// Get retrieves the APIService from the index for a given name.
+	Get(name string) (*v1.APIService, error)
+	APIServiceListerExpansion
+}
+
+// aPIServiceLister implements the APIServiceLister interface.
+type aPIServiceLister struct {
+	indexer cache.Indexer
+}
+
+// NewAPIServiceLister returns a new APIServiceLister.
+func NewAPIServiceLister(indexer cache.Indexer) APIServiceLister {
+	return &aPIServiceLister{indexer: indexer}
+}
+
+// List lists all APIServices in the indexer.
+func (s *aPIServiceLister) List(selector labels.Selector) (ret []*v1.APIService, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.APIService))
+	})
+	return ret, err
+}
+
+// Get retrieves the APIService from the index for a given name.
+func (s *aPIServiceLister) Get(name string) (*v1.APIService, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("apiservice"), name)
+	}
+	return obj.(*v1.APIService), nil
+}
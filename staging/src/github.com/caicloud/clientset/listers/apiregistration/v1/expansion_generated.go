@@ -0,0 +1,11 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+// APIServiceListerExpansion allows custom methods to be added to
+// APIServiceLister.
+type APIServiceListerExpansion interface{}
@@ -0,0 +1,23 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// FlavorListerExpansion allows custom methods to be added to
+// FlavorLister.
+type FlavorListerExpansion interface{}
+
+// ProjectListerExpansion allows custom methods to be added to
+// ProjectLister.
+type ProjectListerExpansion interface{}
+
+// ProjectNamespaceListerExpansion allows custom methods to be added to
+// ProjectNamespaceLister.
+type ProjectNamespaceListerExpansion interface{}
+
+// TemplateListerExpansion allows custom methods to be added to
+// TemplateLister.
+type TemplateListerExpansion interface{}
@@ -0,0 +1,35 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// FlavorListerExpansion allows custom methods to be added to
+// FlavorLister.
+type FlavorListerExpansion interface{}
+
+// MLNeuronListerExpansion allows custom methods to be added to
+// MLNeuronLister.
+type MLNeuronListerExpansion interface{}
+
+// MLNeuronNamespaceListerExpansion allows custom methods to be added to
+// MLNeuronNamespaceLister.
+type MLNeuronNamespaceListerExpansion interface{}
+
+// MLNeuronTaskOwnerListerExpansion allows custom methods to be added to
+// MLNeuronTaskOwnerLister.
+type MLNeuronTaskOwnerListerExpansion interface{}
+
+// MLNeuronTaskOwnerNamespaceListerExpansion allows custom methods to be added to
+// MLNeuronTaskOwnerNamespaceLister.
+type MLNeuronTaskOwnerNamespaceListerExpansion interface{}
+
+// ProjectListerExpansion allows custom methods to be added to
+// ProjectLister.
+type ProjectListerExpansion interface{}
+
+// ProjectNamespaceListerExpansion allows custom methods to be added to
+// ProjectNamespaceLister.
+type ProjectNamespaceListerExpansion interface{}
@@ -0,0 +1,35 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha3
+
+// FlavorListerExpansion allows custom methods to be added to
+// FlavorLister.
+type FlavorListerExpansion interface{}
+
+// MLNeuronListerExpansion allows custom methods to be added to
+// MLNeuronLister.
+type MLNeuronListerExpansion interface{}
+
+// MLNeuronNamespaceListerExpansion allows custom methods to be added to
+// MLNeuronNamespaceLister.
+type MLNeuronNamespaceListerExpansion interface{}
+
+// MLProjectListerExpansion allows custom methods to be added to
+// MLProjectLister.
+type MLProjectListerExpansion interface{}
+
+// MLProjectNamespaceListerExpansion allows custom methods to be added to
+// MLProjectNamespaceLister.
+type MLProjectNamespaceListerExpansion interface{}
+
+// MLTaskListerExpansion allows custom methods to be added to
+// MLTaskLister.
+type MLTaskListerExpansion interface{}
+
+// MLTaskNamespaceListerExpansion allows custom methods to be added to
+// MLTaskNamespaceLister.
+type MLTaskNamespaceListerExpansion interface{}
@@ -0,0 +1,23 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ConfigClaimListerExpansion allows custom methods to be added to
+// ConfigClaimLister.
+type ConfigClaimListerExpansion interface{}
+
+// ConfigClaimNamespaceListerExpansion allows custom methods to be added to
+// ConfigClaimNamespaceLister.
+type ConfigClaimNamespaceListerExpansion interface{}
+
+// ConfigReferenceListerExpansion allows custom methods to be added to
+// ConfigReferenceLister.
+type ConfigReferenceListerExpansion interface{}
+
+// ConfigReferenceNamespaceListerExpansion allows custom methods to be added to
+// ConfigReferenceNamespaceLister.
+type ConfigReferenceNamespaceListerExpansion interface{}
@@ -0,0 +1,11 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// DatasetListerExpansion allows custom methods to be added to
+// DatasetLister.
+type DatasetListerExpansion interface{}
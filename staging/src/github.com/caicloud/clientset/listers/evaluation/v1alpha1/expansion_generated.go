@@ -0,0 +1,15 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// EvalJobListerExpansion allows custom methods to be added to
+// EvalJobLister.
+type EvalJobListerExpansion interface{}
+
+// EvalJobNamespaceListerExpansion allows custom methods to be added to
+// EvalJobNamespaceLister.
+type EvalJobNamespaceListerExpansion interface{}
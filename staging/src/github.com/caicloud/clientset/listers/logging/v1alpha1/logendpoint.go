@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/logging/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// LogEndpointLister helps list LogEndpoints.
+type LogEndpointLister interface {
+	// List lists all LogEndpoints in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.LogEndpoint, err error)
+	// Get retrieves the LogEndpoint from the index for a given name.
+	Get(name string) (*v1alpha1.LogEndpoint, error)
+	LogEndpointListerExpansion
+}
+
+// logEndpointLister implements the LogEndpointLister interface.
+type logEndpointLister struct {
+	indexer cache.Indexer
+}
+
+// NewLogEndpointLister returns a new LogEndpointLister.
+func NewLogEndpointLister(indexer cache.Indexer) LogEndpointLister {
+	return &logEndpointLister{indexer: indexer}
+}
+
+// List lists all LogEndpoints in the indexer.
+func (s *logEndpointLister) List(selector labels.Selector) (ret []*v1alpha1.LogEndpoint, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.LogEndpoint))
+	})
+	return ret, err
+}
+
+// Get retrieves the LogEndpoint from the index for a given name.
+func (s *logEndpointLister) Get(name string) (*v1alpha1.LogEndpoint, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("logendpoint"), name)
+	}
+	return obj.(*v1alpha1.LogEndpoint), nil
+}
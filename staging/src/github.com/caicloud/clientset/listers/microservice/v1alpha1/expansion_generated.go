@@ -0,0 +1,15 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// SpringcloudListerExpansion allows custom methods to be added to
+// SpringcloudLister.
+type SpringcloudListerExpansion interface{}
+
+// SpringcloudNamespaceListerExpansion allows custom methods to be added to
+// SpringcloudNamespaceLister.
+type SpringcloudNamespaceListerExpansion interface{}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/model/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ModelLister helps list Models.
+type ModelLister interface {
+	// List lists all Models in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.Model, err error)
+	// Get retrieves the Model from the index for a given name.
+	Get(name string) (*v1alpha1.Model, error)
+	ModelListerExpansion
+}
+
+// modelLister implements the ModelLister interface.
+type modelLister struct {
+	indexer cache.Indexer
+}
+
+// NewModelLister returns a new ModelLister.
+func NewModelLister(indexer cache.Indexer) ModelLister {
+	return &modelLister{indexer: indexer}
+}
+
+// List lists all Models in the indexer.
+func (s *modelLister) List(selector labels.Selector) (ret []*v1alpha1.Model, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Model))
+	})
+	return ret, err
+}
+
+// Get retrieves the Model from the index for a given name.
+func (s *modelLister) Get(name string) (*v1alpha1.Model, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("model"), name)
+	}
+	return obj.(*v1alpha1.Model), nil
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ApplicationListerExpansion allows custom methods to be added to
+// ApplicationLister.
+type ApplicationListerExpansion interface{}
+
+// ApplicationNamespaceListerExpansion allows custom methods to be added to
+// ApplicationNamespaceLister.
+type ApplicationNamespaceListerExpansion interface{}
+
+// ApplicationDraftListerExpansion allows custom methods to be added to
+// ApplicationDraftLister.
+type ApplicationDraftListerExpansion interface{}
+
+// ApplicationDraftNamespaceListerExpansion allows custom methods to be added to
+// ApplicationDraftNamespaceLister.
+type ApplicationDraftNamespaceListerExpansion interface{}
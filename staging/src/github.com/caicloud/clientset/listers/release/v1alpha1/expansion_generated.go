@@ -0,0 +1,31 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// CanaryReleaseListerExpansion allows custom methods to be added to
+// CanaryReleaseLister.
+type CanaryReleaseListerExpansion interface{}
+
+// CanaryReleaseNamespaceListerExpansion allows custom methods to be added to
+// CanaryReleaseNamespaceLister.
+type CanaryReleaseNamespaceListerExpansion interface{}
+
+// ReleaseListerExpansion allows custom methods to be added to
+// ReleaseLister.
+type ReleaseListerExpansion interface{}
+
+// ReleaseNamespaceListerExpansion allows custom methods to be added to
+// ReleaseNamespaceLister.
+type ReleaseNamespaceListerExpansion interface{}
+
+// ReleaseHistoryListerExpansion allows custom methods to be added to
+// ReleaseHistoryLister.
+type ReleaseHistoryListerExpansion interface{}
+
+// ReleaseHistoryNamespaceListerExpansion allows custom methods to be added to
+// ReleaseHistoryNamespaceLister.
+type ReleaseHistoryNamespaceListerExpansion interface{}
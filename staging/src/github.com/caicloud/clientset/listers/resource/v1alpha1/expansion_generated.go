@@ -0,0 +1,15 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// StorageServiceListerExpansion allows custom methods to be added to
+// StorageServiceLister.
+type StorageServiceListerExpansion interface{}
+
+// StorageTypeListerExpansion allows custom methods to be added to
+// StorageTypeLister.
+type StorageTypeListerExpansion interface{}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ConfigLister helps list Configs.
+type ConfigLister interface {
+	// List lists all Configs in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.Config, err error)
+	// Get retrieves the Config from the index for a given name.
+	Get(name string) (*v1beta1.Config, error)
+	ConfigListerExpansion
+}
+
+// configLister implements the ConfigLister interface.
+type configLister struct {
+	indexer cache.Indexer
+}
+
+// NewConfigLister returns a new ConfigLister.
+func NewConfigLister(indexer cache.Indexer) ConfigLister {
+	return &configLister{indexer: indexer}
+}
+
+// List lists all Configs in the indexer.
+func (s *configLister) List(selector labels.Selector) (ret []*v1beta1.Config, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Config))
+	})
+	return ret, err
+}
+
+// Get retrieves the Config from the index for a given name.
+func (s *configLister) Get(name string) (*v1beta1.Config, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("config"), name)
+	}
+	return obj.(*v1beta1.Config), nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ClusterListerExpansion allows custom methods to be added to
+// ClusterLister.
+type ClusterListerExpansion interface{}
+
+// ConfigListerExpansion allows custom methods to be added to
+// ConfigLister.
+type ConfigListerExpansion interface{}
+
+// ExtendedResourceListerExpansion allows custom methods to be added to
+// ExtendedResourceLister.
+type ExtendedResourceListerExpansion interface{}
+
+// InfraNetworkListerExpansion allows custom methods to be added to
+// InfraNetworkLister.
+type InfraNetworkListerExpansion interface{}
+
+// MachineListerExpansion allows custom methods to be added to
+// MachineLister.
+type MachineListerExpansion interface{}
+
+// MachineAutoScalingGroupListerExpansion allows custom methods to be added to
+// MachineAutoScalingGroupLister.
+type MachineAutoScalingGroupListerExpansion interface{}
+
+// NetworkListerExpansion allows custom methods to be added to
+// NetworkLister.
+type NetworkListerExpansion interface{}
+
+// NodeClaimListerExpansion allows custom methods to be added to
+// NodeClaimLister.
+type NodeClaimListerExpansion interface{}
+
+// NodeLocalStorageListerExpansion allows custom methods to be added to
+// NodeLocalStorageLister.
+type NodeLocalStorageListerExpansion interface{}
+
+// RequirementGapListerExpansion allows custom methods to be added to
+// RequirementGapLister.
+type RequirementGapListerExpansion interface{}
+
+// ResourceClassListerExpansion allows custom methods to be added to
+// ResourceClassLister.
+type ResourceClassListerExpansion interface{}
+
+// SnapshotListerExpansion allows custom methods to be added to
+// SnapshotLister.
+type SnapshotListerExpansion interface{}
+
+// SnapshotNamespaceListerExpansion allows custom methods to be added to
+// SnapshotNamespaceLister.
+type SnapshotNamespaceListerExpansion interface{}
+
+// StorageServiceListerExpansion allows custom methods to be added to
+// StorageServiceLister.
+type StorageServiceListerExpansion interface{}
+
+// StorageTypeListerExpansion allows custom methods to be added to
+// StorageTypeLister.
+type StorageTypeListerExpansion interface{}
+
+// TagListerExpansion allows custom methods to be added to
+// TagLister.
+type TagListerExpansion interface{}
+
+// WorkloadNetworkListerExpansion allows custom methods to be added to
+// WorkloadNetworkLister.
+type WorkloadNetworkListerExpansion interface{}
+
+// WorkloadNetworkNamespaceListerExpansion allows custom methods to be added to
+// WorkloadNetworkNamespaceLister.
+type WorkloadNetworkNamespaceListerExpansion interface{}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// InfraNetworkLister helps list InfraNetworks.
+type InfraNetworkLister interface {
+	// List lists all InfraNetworks in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.InfraNetwork, err error)
+	// Get retrieves the InfraNetwork from the index for a given name.
+	Get(name string) (*v1beta1.InfraNetwork, error)
+	InfraNetworkListerExpansion
+}
+
+// infraNetworkLister implements the InfraNetworkLister interface.
+type infraNetworkLister struct {
+	indexer cache.Indexer
+}
+
+// NewInfraNetworkLister returns a new InfraNetworkLister.
+func NewInfraNetworkLister(indexer cache.Indexer) InfraNetworkLister {
+	return &infraNetworkLister{indexer: indexer}
+}
+
+// List lists all InfraNetworks in the indexer.
+func (s *infraNetworkLister) List(selector labels.Selector) (ret []*v1beta1.InfraNetwork, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.InfraNetwork))
+	})
+	return ret, err
+}
+
+// Get retrieves the InfraNetwork from the index for a given name.
+func (s *infraNetworkLister) Get(name string) (*v1beta1.InfraNetwork, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("infranetwork"), name)
+	}
+	return obj.(*v1beta1.InfraNetwork), nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MachineLister helps list Machines.
+type MachineLister interface {
+	// List lists all Machines in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.Machine, err error)
+	// Get retrieves the Machine from the index for a given name.
+	Get(name string) (*v1beta1.Machine, error)
+	MachineListerExpansion
+}
+
+// machineLister implements the MachineLister interface.
+type machineLister struct {
+	indexer cache.Indexer
+}
+
+// NewMachineLister returns a new MachineLister.
+func NewMachineLister(indexer cache.Indexer) MachineLister {
+	return &machineLister{indexer: indexer}
+}
+
+// List lists all Machines in the indexer.
+func (s *machineLister) List(selector labels.Selector) (ret []*v1beta1.Machine, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Machine))
+	})
+	return ret, err
+}
+
+// Get retrieves the Machine from the index for a given name.
+func (s *machineLister) Get(name string) (*v1beta1.Machine, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("machine"), name)
+	}
+	return obj.(*v1beta1.Machine), nil
+}
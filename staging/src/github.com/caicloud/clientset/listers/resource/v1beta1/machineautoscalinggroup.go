@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MachineAutoScalingGroupLister helps list MachineAutoScalingGroups.
+type MachineAutoScalingGroupLister interface {
+	// List lists all MachineAutoScalingGroups in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.MachineAutoScalingGroup, err error)
+	// Get retrieves the MachineAutoScalingGroup from the index for a given name.
+	Get(name string) (*v1beta1.MachineAutoScalingGroup, error)
+	MachineAutoScalingGroupListerExpansion
+}
+
+// machineAutoScalingGroupLister implements the MachineAutoScalingGroupLister interface.
+type machineAutoScalingGroupLister struct {
+	indexer cache.Indexer
+}
+
+// NewMachineAutoScalingGroupLister returns a new MachineAutoScalingGroupLister.
+func NewMachineAutoScalingGroupLister(indexer cache.Indexer) MachineAutoScalingGroupLister {
+	return &machineAutoScalingGroupLister{indexer: indexer}
+}
+
+// List lists all MachineAutoScalingGroups in the indexer.
+func (s *machineAutoScalingGroupLister) List(selector labels.Selector) (ret []*v1beta1.MachineAutoScalingGroup, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.MachineAutoScalingGroup))
+	})
+	return ret, err
+}
+
+// Get retrieves the MachineAutoScalingGroup from the index for a given name.
+func (s *machineAutoScalingGroupLister) Get(name string) (*v1beta1.MachineAutoScalingGroup, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("machineautoscalinggroup"), name)
+	}
+	return obj.(*v1beta1.MachineAutoScalingGroup), nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NodeClaimLister helps list NodeClaims.
+type NodeClaimLister interface {
+	// List lists all NodeClaims in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.NodeClaim, err error)
+	// Get retrieves the NodeClaim from the index for a given name.
+	Get(name string) (*v1beta1.NodeClaim, error)
+	NodeClaimListerExpansion
+}
+
+// nodeClaimLister implements the NodeClaimLister interface.
+type nodeClaimLister struct {
+	indexer cache.Indexer
+}
+
+// NewNodeClaimLister returns a new NodeClaimLister.
+func NewNodeClaimLister(indexer cache.Indexer) NodeClaimLister {
+	return &nodeClaimLister{indexer: indexer}
+}
+
+// List lists all NodeClaims in the indexer.
+func (s *nodeClaimLister) List(selector labels.Selector) (ret []*v1beta1.NodeClaim, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.NodeClaim))
+	})
+	return ret, err
+}
+
+// Get retrieves the NodeClaim from the index for a given name.
+func (s *nodeClaimLister) Get(name string) (*v1beta1.NodeClaim, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("nodeclaim"), name)
+	}
+	return obj.(*v1beta1.NodeClaim), nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RequirementGapLister helps list RequirementGaps.
+type RequirementGapLister interface {
+	// List lists all RequirementGaps in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.RequirementGap, err error)
+	// Get retrieves the RequirementGap from the index for a given name.
+	Get(name string) (*v1beta1.RequirementGap, error)
+	RequirementGapListerExpansion
+}
+
+// requirementGapLister implements the RequirementGapLister interface.
+type requirementGapLister struct {
+	indexer cache.Indexer
+}
+
+// NewRequirementGapLister returns a new RequirementGapLister.
+func NewRequirementGapLister(indexer cache.Indexer) RequirementGapLister {
+	return &requirementGapLister{indexer: indexer}
+}
+
+// List lists all RequirementGaps in the indexer.
+func (s *requirementGapLister) List(selector labels.Selector) (ret []*v1beta1.RequirementGap, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.RequirementGap))
+	})
+	return ret, err
+}
+
+// Get retrieves the RequirementGap from the index for a given name.
+func (s *requirementGapLister) Get(name string) (*v1beta1.RequirementGap, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("requirementgap"), name)
+	}
+	return obj.(*v1beta1.RequirementGap), nil
+}
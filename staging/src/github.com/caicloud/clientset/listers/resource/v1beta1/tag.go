@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/caicloud/clientset/pkg/apis/resource/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TagLister helps list Tags.
+type TagLister interface {
+	// List lists all Tags in the indexer.
+	List(selector labels.Selector) (ret []*v1beta1.Tag, err error)
+	// Get retrieves the Tag from the index for a given name.
+	Get(name string) (*v1beta1.Tag, error)
+	TagListerExpansion
+}
+
+// tagLister implements the TagLister interface.
+type tagLister struct {
+	indexer cache.Indexer
+}
+
+// NewTagLister returns a new TagLister.
+func NewTagLister(indexer cache.Indexer) TagLister {
+	return &tagLister{indexer: indexer}
+}
+
+// List lists all Tags in the indexer.
+func (s *tagLister) List(selector labels.Selector) (ret []*v1beta1.Tag, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Tag))
+	})
+	return ret, err
+}
+
+// Get retrieves the Tag from the index for a given name.
+func (s *tagLister) Get(name string) (*v1beta1.Tag, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("tag"), name)
+	}
+	return obj.(*v1beta1.Tag), nil
+}
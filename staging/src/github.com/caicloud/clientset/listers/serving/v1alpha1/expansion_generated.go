@@ -0,0 +1,23 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// SceneListerExpansion allows custom methods to be added to
+// SceneLister.
+type SceneListerExpansion interface{}
+
+// SceneNamespaceListerExpansion allows custom methods to be added to
+// SceneNamespaceLister.
+type SceneNamespaceListerExpansion interface{}
+
+// ServingListerExpansion allows custom methods to be added to
+// ServingLister.
+type ServingListerExpansion interface{}
+
+// ServingNamespaceListerExpansion allows custom methods to be added to
+// ServingNamespaceLister.
+type ServingNamespaceListerExpansion interface{}
@@ -0,0 +1,19 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ClusterQuotaListerExpansion allows custom methods to be added to
+// ClusterQuotaLister.
+type ClusterQuotaListerExpansion interface{}
+
+// PartitionListerExpansion allows custom methods to be added to
+// PartitionLister.
+type PartitionListerExpansion interface{}
+
+// TenantListerExpansion allows custom methods to be added to
+// TenantLister.
+type TenantListerExpansion interface{}
@@ -0,0 +1,53 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/caicloud/clientset/pkg/apis/tenant/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TenantLister helps list Tenants.
+type TenantLister interface {
+	// List lists all Tenants in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.Tenant, err error)
+	// Get retrieves the Tenant from the index for a given name.
+	Get(name string) (*v1alpha1.Tenant, error)
+	TenantListerExpansion
+}
+
+// tenantLister implements the TenantLister interface.
+type tenantLister struct {
+	indexer cache.Indexer
+}
+
+// NewTenantLister returns a new TenantLister.
+func NewTenantLister(indexer cache.Indexer) TenantLister {
+	return &tenantLister{indexer: indexer}
+}
+
+// List lists all Tenants in the indexer.
+func (s *tenantLister) List(selector labels.Selector) (ret []*v1alpha1.Tenant, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Tenant))
+	})
+	return ret, err
+}
+
+// Get retrieves the Tenant from the index for a given name.
+func (s *tenantLister) Get(name string) (*v1alpha1.Tenant, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("tenant"), name)
+	}
+	return obj.(*v1alpha1.Tenant), nil
+}
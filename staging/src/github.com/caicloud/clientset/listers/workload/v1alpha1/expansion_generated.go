@@ -0,0 +1,23 @@
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// WorkloadListerExpansion allows custom methods to be added to
+// WorkloadLister.
+type WorkloadListerExpansion interface{}
+
+// WorkloadNamespaceListerExpansion allows custom methods to be added to
+// WorkloadNamespaceLister.
+type WorkloadNamespaceListerExpansion interface{}
+
+// WorkloadRevisionListerExpansion allows custom methods to be added to
+// WorkloadRevisionLister.
+type WorkloadRevisionListerExpansion interface{}
+
+// WorkloadRevisionNamespaceListerExpansion allows custom methods to be added to
+// WorkloadRevisionNamespaceLister.
+type WorkloadRevisionNamespaceListerExpansion interface{}
@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=alerting.caicloud.io
+
+package v1beta1
@@ -0,0 +1,883 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSource) DeepCopyInto(out *DataSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSource.
+func (in *DataSource) DeepCopy() *DataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dependencies) DeepCopyInto(out *Dependencies) {
+	*out = *in
+	if in.Jars != nil {
+		in, out := &in.Jars, &out.Jars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PyFiles != nil {
+		in, out := &in.PyFiles, &out.PyFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dependencies.
+func (in *Dependencies) DeepCopy() *Dependencies {
+	if in == nil {
+		return nil
+	}
+	out := new(Dependencies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Flavor) DeepCopyInto(out *Flavor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flavor.
+func (in *Flavor) DeepCopy() *Flavor {
+	if in == nil {
+		return nil
+	}
+	out := new(Flavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Flavor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorList) DeepCopyInto(out *FlavorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Flavor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorList.
+func (in *FlavorList) DeepCopy() *FlavorList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlavorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorSpec) DeepCopyInto(out *FlavorSpec) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageFlavor, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceList != nil {
+		in, out := &in.ResourceList, &out.ResourceList
+		*out = make([]ResourceConfig, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorSpec.
+func (in *FlavorSpec) DeepCopy() *FlavorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFlavor) DeepCopyInto(out *ImageFlavor) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFlavor.
+func (in *ImageFlavor) DeepCopy() *ImageFlavor {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFlavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JupyterConfig) DeepCopyInto(out *JupyterConfig) {
+	*out = *in
+	if in.LastActiveTime != nil {
+		in, out := &in.LastActiveTime, &out.LastActiveTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JupyterConfig.
+func (in *JupyterConfig) DeepCopy() *JupyterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JupyterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuron) DeepCopyInto(out *MLNeuron) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuron.
+func (in *MLNeuron) DeepCopy() *MLNeuron {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuron)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLNeuron) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronConfig) DeepCopyInto(out *MLNeuronConfig) {
+	*out = *in
+	if in.TensorFlowConf != nil {
+		in, out := &in.TensorFlowConf, &out.TensorFlowConf
+		*out = new(TensorFlowConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PyTorchConf != nil {
+		in, out := &in.PyTorchConf, &out.PyTorchConf
+		*out = new(PyTorchConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SparkConf != nil {
+		in, out := &in.SparkConf, &out.SparkConf
+		*out = new(SparkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JupyterConf != nil {
+		in, out := &in.JupyterConf, &out.JupyterConf
+		*out = new(JupyterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TensorBoardConf != nil {
+		in, out := &in.TensorBoardConf, &out.TensorBoardConf
+		*out = new(TensorBoardConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronConfig.
+func (in *MLNeuronConfig) DeepCopy() *MLNeuronConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronList) DeepCopyInto(out *MLNeuronList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLNeuron, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronList.
+func (in *MLNeuronList) DeepCopy() *MLNeuronList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLNeuronList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronRef) DeepCopyInto(out *MLNeuronRef) {
+	*out = *in
+	if in.AdditionTime != nil {
+		in, out := &in.AdditionTime, &out.AdditionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronRef.
+func (in *MLNeuronRef) DeepCopy() *MLNeuronRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronSpec) DeepCopyInto(out *MLNeuronSpec) {
+	*out = *in
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]NeuronReplica, len(*in))
+		copy(*out, *in)
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(RepositoryConfig)
+		**out = **in
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]DataSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]DataSource, len(*in))
+		copy(*out, *in)
+	}
+	out.Image = in.Image
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Task != nil {
+		in, out := &in.Task, &out.Task
+		*out = new(MLNeuronTask)
+		(*in).DeepCopyInto(*out)
+	}
+	in.NeuronConf.DeepCopyInto(&out.NeuronConf)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronSpec.
+func (in *MLNeuronSpec) DeepCopy() *MLNeuronSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronStatus) DeepCopyInto(out *MLNeuronStatus) {
+	*out = *in
+	if in.Histories != nil {
+		in, out := &in.Histories, &out.Histories
+		*out = make([]MLNeuronTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronStatus.
+func (in *MLNeuronStatus) DeepCopy() *MLNeuronStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronTask) DeepCopyInto(out *MLNeuronTask) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]MLNeuronTaskJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronTask.
+func (in *MLNeuronTask) DeepCopy() *MLNeuronTask {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronTaskJob) DeepCopyInto(out *MLNeuronTaskJob) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(MLNeuronTaskJobData)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronTaskJob.
+func (in *MLNeuronTaskJob) DeepCopy() *MLNeuronTaskJob {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronTaskJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronTaskJobData) DeepCopyInto(out *MLNeuronTaskJobData) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]DataSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]DataSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(RepositoryConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronTaskJobData.
+func (in *MLNeuronTaskJobData) DeepCopy() *MLNeuronTaskJobData {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronTaskJobData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronTaskOwner) DeepCopyInto(out *MLNeuronTaskOwner) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronTaskOwner.
+func (in *MLNeuronTaskOwner) DeepCopy() *MLNeuronTaskOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronTaskOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLNeuronTaskOwner) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronTaskOwnerList) DeepCopyInto(out *MLNeuronTaskOwnerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLNeuronTaskOwner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronTaskOwnerList.
+func (in *MLNeuronTaskOwnerList) DeepCopy() *MLNeuronTaskOwnerList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronTaskOwnerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLNeuronTaskOwnerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NeuronReplica) DeepCopyInto(out *NeuronReplica) {
+	*out = *in
+	out.ResourceConf = in.ResourceConf
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NeuronReplica.
+func (in *NeuronReplica) DeepCopy() *NeuronReplica {
+	if in == nil {
+		return nil
+	}
+	out := new(NeuronReplica)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Project) DeepCopyInto(out *Project) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Project.
+func (in *Project) DeepCopy() *Project {
+	if in == nil {
+		return nil
+	}
+	out := new(Project)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Project) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectList) DeepCopyInto(out *ProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Project, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectList.
+func (in *ProjectList) DeepCopy() *ProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	out.Resources = in.Resources
+	if in.Repository != nil {
+		in, out := &in.Repository, &out.Repository
+		*out = new(RepositoryConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
+func (in *ProjectSpec) DeepCopy() *ProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
+func (in *ProjectStatus) DeepCopy() *ProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PyTorchConfig) DeepCopyInto(out *PyTorchConfig) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PyTorchConfig.
+func (in *PyTorchConfig) DeepCopy() *PyTorchConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PyTorchConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryConfig) DeepCopyInto(out *RepositoryConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryConfig.
+func (in *RepositoryConfig) DeepCopy() *RepositoryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceConfig) DeepCopyInto(out *ResourceConfig) {
+	*out = *in
+	out.Requests = in.Requests
+	out.Limits = in.Limits
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceConfig.
+func (in *ResourceConfig) DeepCopy() *ResourceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceFlavor) DeepCopyInto(out *ResourceFlavor) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceFlavor.
+func (in *ResourceFlavor) DeepCopy() *ResourceFlavor {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceFlavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SparkConfig) DeepCopyInto(out *SparkConfig) {
+	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SparkConf != nil {
+		in, out := &in.SparkConf, &out.SparkConf
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HadoopConf != nil {
+		in, out := &in.HadoopConf, &out.HadoopConf
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Deps.DeepCopyInto(&out.Deps)
+	out.Schedule = in.Schedule
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SparkConfig.
+func (in *SparkConfig) DeepCopy() *SparkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SparkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SparkSchedule) DeepCopyInto(out *SparkSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SparkSchedule.
+func (in *SparkSchedule) DeepCopy() *SparkSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SparkSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Step) DeepCopyInto(out *Step) {
+	*out = *in
+	if in.MLNeuronRefs != nil {
+		in, out := &in.MLNeuronRefs, &out.MLNeuronRefs
+		*out = make([]MLNeuronRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
+func (in *Step) DeepCopy() *Step {
+	if in == nil {
+		return nil
+	}
+	out := new(Step)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageConfig) DeepCopyInto(out *StorageConfig) {
+	*out = *in
+	if in.PersistentVolumeClaimName != nil {
+		in, out := &in.PersistentVolumeClaimName, &out.PersistentVolumeClaimName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageConfig.
+func (in *StorageConfig) DeepCopy() *StorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TensorBoardConfig) DeepCopyInto(out *TensorBoardConfig) {
+	*out = *in
+	out.ResourceConf = in.ResourceConf
+	out.Image = in.Image
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TensorBoardConfig.
+func (in *TensorBoardConfig) DeepCopy() *TensorBoardConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TensorBoardConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TensorFlowConfig) DeepCopyInto(out *TensorFlowConfig) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TensorFlowConfig.
+func (in *TensorFlowConfig) DeepCopy() *TensorFlowConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TensorFlowConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,1273 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1alpha3
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlgorithmSetting) DeepCopyInto(out *AlgorithmSetting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlgorithmSetting.
+func (in *AlgorithmSetting) DeepCopy() *AlgorithmSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(AlgorithmSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlgorithmSpec) DeepCopyInto(out *AlgorithmSpec) {
+	*out = *in
+	if in.AlgorithmSettings != nil {
+		in, out := &in.AlgorithmSettings, &out.AlgorithmSettings
+		*out = make([]AlgorithmSetting, len(*in))
+		copy(*out, *in)
+	}
+	if in.EarlyStopping != nil {
+		in, out := &in.EarlyStopping, &out.EarlyStopping
+		*out = new(EarlyStoppingSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlgorithmSpec.
+func (in *AlgorithmSpec) DeepCopy() *AlgorithmSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AlgorithmSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoMLConfig) DeepCopyInto(out *AutoMLConfig) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]ParameterSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Objective != nil {
+		in, out := &in.Objective, &out.Objective
+		*out = new(ObjectiveSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(AlgorithmSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ParallelTrialCount != nil {
+		in, out := &in.ParallelTrialCount, &out.ParallelTrialCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxTrialCount != nil {
+		in, out := &in.MaxTrialCount, &out.MaxTrialCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxFailedTrialCount != nil {
+		in, out := &in.MaxFailedTrialCount, &out.MaxFailedTrialCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MetricsCollectorSpec != nil {
+		in, out := &in.MetricsCollectorSpec, &out.MetricsCollectorSpec
+		*out = new(MetricsCollectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoMLConfig.
+func (in *AutoMLConfig) DeepCopy() *AutoMLConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoMLConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CodeDataSource) DeepCopyInto(out *CodeDataSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CodeDataSource.
+func (in *CodeDataSource) DeepCopy() *CodeDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CodeDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorSpec) DeepCopyInto(out *CollectorSpec) {
+	*out = *in
+	if in.CustomCollector != nil {
+		in, out := &in.CustomCollector, &out.CustomCollector
+		*out = new(v1.Container)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorSpec.
+func (in *CollectorSpec) DeepCopy() *CollectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Data) DeepCopyInto(out *Data) {
+	*out = *in
+	in.DataSource.DeepCopyInto(&out.DataSource)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Data.
+func (in *Data) DeepCopy() *Data {
+	if in == nil {
+		return nil
+	}
+	out := new(Data)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSource) DeepCopyInto(out *DataSource) {
+	*out = *in
+	if in.Model != nil {
+		in, out := &in.Model, &out.Model
+		*out = new(ModelDataSource)
+		**out = **in
+	}
+	if in.Dataset != nil {
+		in, out := &in.Dataset, &out.Dataset
+		*out = new(DatasetDataSource)
+		**out = **in
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(CodeDataSource)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSource.
+func (in *DataSource) DeepCopy() *DataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataVolume) DeepCopyInto(out *DataVolume) {
+	*out = *in
+	in.Volume.DeepCopyInto(&out.Volume)
+	in.VolumeMount.DeepCopyInto(&out.VolumeMount)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataVolume.
+func (in *DataVolume) DeepCopy() *DataVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(DataVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetDataSource) DeepCopyInto(out *DatasetDataSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetDataSource.
+func (in *DatasetDataSource) DeepCopy() *DatasetDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dependencies) DeepCopyInto(out *Dependencies) {
+	*out = *in
+	if in.Jars != nil {
+		in, out := &in.Jars, &out.Jars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PyFiles != nil {
+		in, out := &in.PyFiles, &out.PyFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dependencies.
+func (in *Dependencies) DeepCopy() *Dependencies {
+	if in == nil {
+		return nil
+	}
+	out := new(Dependencies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EarlyStoppingSpec) DeepCopyInto(out *EarlyStoppingSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EarlyStoppingSpec.
+func (in *EarlyStoppingSpec) DeepCopy() *EarlyStoppingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EarlyStoppingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeasibleSpace) DeepCopyInto(out *FeasibleSpace) {
+	*out = *in
+	if in.List != nil {
+		in, out := &in.List, &out.List
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeasibleSpace.
+func (in *FeasibleSpace) DeepCopy() *FeasibleSpace {
+	if in == nil {
+		return nil
+	}
+	out := new(FeasibleSpace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSystemPath) DeepCopyInto(out *FileSystemPath) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSystemPath.
+func (in *FileSystemPath) DeepCopy() *FileSystemPath {
+	if in == nil {
+		return nil
+	}
+	out := new(FileSystemPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
+	*out = *in
+	if in.MetricsFormat != nil {
+		in, out := &in.MetricsFormat, &out.MetricsFormat
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilterSpec.
+func (in *FilterSpec) DeepCopy() *FilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Flavor) DeepCopyInto(out *Flavor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flavor.
+func (in *Flavor) DeepCopy() *Flavor {
+	if in == nil {
+		return nil
+	}
+	out := new(Flavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Flavor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorList) DeepCopyInto(out *FlavorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Flavor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorList.
+func (in *FlavorList) DeepCopy() *FlavorList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlavorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorSpec) DeepCopyInto(out *FlavorSpec) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageFlavor, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceList != nil {
+		in, out := &in.ResourceList, &out.ResourceList
+		*out = make([]ResourceConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorSpec.
+func (in *FlavorSpec) DeepCopy() *FlavorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorovodConfig) DeepCopyInto(out *HorovodConfig) {
+	*out = *in
+	if in.SlotsPerWorker != nil {
+		in, out := &in.SlotsPerWorker, &out.SlotsPerWorker
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorovodConfig.
+func (in *HorovodConfig) DeepCopy() *HorovodConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HorovodConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFlavor) DeepCopyInto(out *ImageFlavor) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFlavor.
+func (in *ImageFlavor) DeepCopy() *ImageFlavor {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFlavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JupyterConfig) DeepCopyInto(out *JupyterConfig) {
+	*out = *in
+	if in.LastActiveTime != nil {
+		in, out := &in.LastActiveTime, &out.LastActiveTime
+		*out = (*in).DeepCopy()
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JupyterConfig.
+func (in *JupyterConfig) DeepCopy() *JupyterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JupyterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLCommon) DeepCopyInto(out *MLCommon) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make([]Data, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]Data, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataVolumes != nil {
+		in, out := &in.DataVolumes, &out.DataVolumes
+		*out = make([]DataVolume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make(map[string]*ReplicaSpec, len(*in))
+		for key, val := range *in {
+			var outVal *ReplicaSpec
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(ReplicaSpec)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	in.MLConf.DeepCopyInto(&out.MLConf)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLCommon.
+func (in *MLCommon) DeepCopy() *MLCommon {
+	if in == nil {
+		return nil
+	}
+	out := new(MLCommon)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLConfig) DeepCopyInto(out *MLConfig) {
+	*out = *in
+	if in.TensorFlowConf != nil {
+		in, out := &in.TensorFlowConf, &out.TensorFlowConf
+		*out = new(TensorFlowConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PyTorchConf != nil {
+		in, out := &in.PyTorchConf, &out.PyTorchConf
+		*out = new(PyTorchConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SparkConf != nil {
+		in, out := &in.SparkConf, &out.SparkConf
+		*out = new(SparkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JupyterConf != nil {
+		in, out := &in.JupyterConf, &out.JupyterConf
+		*out = new(JupyterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoMLConf != nil {
+		in, out := &in.AutoMLConf, &out.AutoMLConf
+		*out = new(AutoMLConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Horovod != nil {
+		in, out := &in.Horovod, &out.Horovod
+		*out = new(HorovodConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLConfig.
+func (in *MLConfig) DeepCopy() *MLConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MLConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLJob) DeepCopyInto(out *MLJob) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]ReplicaPod, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLJob.
+func (in *MLJob) DeepCopy() *MLJob {
+	if in == nil {
+		return nil
+	}
+	out := new(MLJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuron) DeepCopyInto(out *MLNeuron) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuron.
+func (in *MLNeuron) DeepCopy() *MLNeuron {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuron)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLNeuron) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronList) DeepCopyInto(out *MLNeuronList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLNeuron, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronList.
+func (in *MLNeuronList) DeepCopy() *MLNeuronList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLNeuronList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronRef) DeepCopyInto(out *MLNeuronRef) {
+	*out = *in
+	if in.AdditionTime != nil {
+		in, out := &in.AdditionTime, &out.AdditionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronRef.
+func (in *MLNeuronRef) DeepCopy() *MLNeuronRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronSpec) DeepCopyInto(out *MLNeuronSpec) {
+	*out = *in
+	in.TaskTemplate.DeepCopyInto(&out.TaskTemplate)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronSpec.
+func (in *MLNeuronSpec) DeepCopy() *MLNeuronSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLNeuronStatus) DeepCopyInto(out *MLNeuronStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLNeuronStatus.
+func (in *MLNeuronStatus) DeepCopy() *MLNeuronStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLNeuronStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLProject) DeepCopyInto(out *MLProject) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLProject.
+func (in *MLProject) DeepCopy() *MLProject {
+	if in == nil {
+		return nil
+	}
+	out := new(MLProject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLProject) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLProjectList) DeepCopyInto(out *MLProjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLProject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLProjectList.
+func (in *MLProjectList) DeepCopy() *MLProjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLProjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLProjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLProjectSpec) DeepCopyInto(out *MLProjectSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]Step, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Storage.DeepCopyInto(&out.Storage)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLProjectSpec.
+func (in *MLProjectSpec) DeepCopy() *MLProjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLProjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLProjectStatus) DeepCopyInto(out *MLProjectStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLProjectStatus.
+func (in *MLProjectStatus) DeepCopy() *MLProjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLProjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLTask) DeepCopyInto(out *MLTask) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLTask.
+func (in *MLTask) DeepCopy() *MLTask {
+	if in == nil {
+		return nil
+	}
+	out := new(MLTask)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLTask) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLTaskList) DeepCopyInto(out *MLTaskList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MLTask, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLTaskList.
+func (in *MLTaskList) DeepCopy() *MLTaskList {
+	if in == nil {
+		return nil
+	}
+	out := new(MLTaskList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MLTaskList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLTaskSpec) DeepCopyInto(out *MLTaskSpec) {
+	*out = *in
+	out.MLNeuronRef = in.MLNeuronRef
+	in.MLCommon.DeepCopyInto(&out.MLCommon)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLTaskSpec.
+func (in *MLTaskSpec) DeepCopy() *MLTaskSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MLTaskSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MLTaskStatus) DeepCopyInto(out *MLTaskStatus) {
+	*out = *in
+	if in.Jobs != nil {
+		in, out := &in.Jobs, &out.Jobs
+		*out = make([]MLJob, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MLTaskStatus.
+func (in *MLTaskStatus) DeepCopy() *MLTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MLTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsCollectorSpec) DeepCopyInto(out *MetricsCollectorSpec) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(SourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Collector != nil {
+		in, out := &in.Collector, &out.Collector
+		*out = new(CollectorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsCollectorSpec.
+func (in *MetricsCollectorSpec) DeepCopy() *MetricsCollectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsCollectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelDataSource) DeepCopyInto(out *ModelDataSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelDataSource.
+func (in *ModelDataSource) DeepCopy() *ModelDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectiveSpec) DeepCopyInto(out *ObjectiveSpec) {
+	*out = *in
+	if in.Goal != nil {
+		in, out := &in.Goal, &out.Goal
+		*out = new(float64)
+		**out = **in
+	}
+	if in.AdditionalMetricNames != nil {
+		in, out := &in.AdditionalMetricNames, &out.AdditionalMetricNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectiveSpec.
+func (in *ObjectiveSpec) DeepCopy() *ObjectiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectiveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParameterSpec) DeepCopyInto(out *ParameterSpec) {
+	*out = *in
+	in.FeasibleSpace.DeepCopyInto(&out.FeasibleSpace)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParameterSpec.
+func (in *ParameterSpec) DeepCopy() *ParameterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ParameterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PyTorchConfig) DeepCopyInto(out *PyTorchConfig) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PyTorchConfig.
+func (in *PyTorchConfig) DeepCopy() *PyTorchConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PyTorchConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaPod) DeepCopyInto(out *ReplicaPod) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaPod.
+func (in *ReplicaPod) DeepCopy() *ReplicaPod {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaSpec) DeepCopyInto(out *ReplicaSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaSpec.
+func (in *ReplicaSpec) DeepCopy() *ReplicaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceConfig) DeepCopyInto(out *ResourceConfig) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceConfig.
+func (in *ResourceConfig) DeepCopy() *ResourceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceSpec) DeepCopyInto(out *SourceSpec) {
+	*out = *in
+	if in.HttpGet != nil {
+		in, out := &in.HttpGet, &out.HttpGet
+		*out = new(v1.HTTPGetAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileSystemPath != nil {
+		in, out := &in.FileSystemPath, &out.FileSystemPath
+		*out = new(FileSystemPath)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(FilterSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceSpec.
+func (in *SourceSpec) DeepCopy() *SourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SparkConfig) DeepCopyInto(out *SparkConfig) {
+	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SparkConf != nil {
+		in, out := &in.SparkConf, &out.SparkConf
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.HadoopConf != nil {
+		in, out := &in.HadoopConf, &out.HadoopConf
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Deps.DeepCopyInto(&out.Deps)
+	out.Schedule = in.Schedule
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SparkConfig.
+func (in *SparkConfig) DeepCopy() *SparkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SparkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SparkSchedule) DeepCopyInto(out *SparkSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SparkSchedule.
+func (in *SparkSchedule) DeepCopy() *SparkSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(SparkSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Step) DeepCopyInto(out *Step) {
+	*out = *in
+	if in.MLNeuronRefs != nil {
+		in, out := &in.MLNeuronRefs, &out.MLNeuronRefs
+		*out = make([]MLNeuronRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Step.
+func (in *Step) DeepCopy() *Step {
+	if in == nil {
+		return nil
+	}
+	out := new(Step)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageConfig) DeepCopyInto(out *StorageConfig) {
+	*out = *in
+	if in.PersistentVolumeClaimName != nil {
+		in, out := &in.PersistentVolumeClaimName, &out.PersistentVolumeClaimName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageConfig.
+func (in *StorageConfig) DeepCopy() *StorageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TensorFlowConfig) DeepCopyInto(out *TensorFlowConfig) {
+	*out = *in
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TensorFlowConfig.
+func (in *TensorFlowConfig) DeepCopy() *TensorFlowConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TensorFlowConfig)
+	in.DeepCopyInto(out)
+	return out
+}
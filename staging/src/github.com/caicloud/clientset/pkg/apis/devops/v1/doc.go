@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=devops.caicloud.io
+
+package v1
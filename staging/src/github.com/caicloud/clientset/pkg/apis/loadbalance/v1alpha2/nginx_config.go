@@ -0,0 +1,345 @@
+/*
+Copyright 2017 caicloud authors. All rights reserved.
+*/
+
+package v1alpha2
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// configValidateFunc validates the value of a key
+type configValidateFunc func(value string) error
+
+var (
+	configSizeRegexp = regexp.MustCompile(`^[0-9]+[kKmMgG]?$`)
+	configTimeRegexp = regexp.MustCompile(`^([0-9]+(ms|s|m|h|d|w|M|y)?)+$`)
+)
+
+func configBool(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return fmt.Errorf("%q is not a boolean", value)
+	}
+	return nil
+}
+
+func configInt(value string) error {
+	if i, err := strconv.Atoi(value); err != nil || i < 0 {
+		return fmt.Errorf("%q is not a non-negative integer", value)
+	}
+	return nil
+}
+
+func configFloat(value string) error {
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return fmt.Errorf("%q is not a number", value)
+	}
+	return nil
+}
+
+// configSize is nginx size, such as 1024, 8k, 5G
+func configSize(value string) error {
+	if !configSizeRegexp.MatchString(value) {
+		return fmt.Errorf("%q is not a size, such as 8k, 1m", value)
+	}
+	return nil
+}
+
+// configDuration is nginx time, such as 30s, 1h30m
+func configDuration(value string) error {
+	if !configTimeRegexp.MatchString(value) {
+		return fmt.Errorf("%q is not a duration, such as 30s, 1h30m", value)
+	}
+	return nil
+}
+
+func configPort(value string) error {
+	if p, err := strconv.Atoi(value); err != nil || p <= 0 || p > 65535 {
+		return fmt.Errorf("%q is not a valid port", value)
+	}
+	return nil
+}
+
+// configList splits comma separated values and trims spaces
+func configList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// configCIDRs is a comma separated list of CIDRs or IPs
+func configCIDRs(value string) error {
+	for _, item := range configList(value) {
+		if net.ParseIP(item) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(item); err != nil {
+			return fmt.Errorf("%q is not a valid CIDR or IP", item)
+		}
+	}
+	return nil
+}
+
+// configIPs is a comma separated list of IPs
+func configIPs(value string) error {
+	for _, item := range configList(value) {
+		if net.ParseIP(item) == nil {
+			return fmt.Errorf("%q is not a valid IP", item)
+		}
+	}
+	return nil
+}
+
+// configInts is a comma separated list of integers
+func configInts(value string) error {
+	for _, item := range configList(value) {
+		if err := configInt(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func configEnum(values ...string) configValidateFunc {
+	return func(value string) error {
+		for _, v := range values {
+			if v == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", value, strings.Join(values, ", "))
+	}
+}
+
+func configSSLProtocols(value string) error {
+	valid := configEnum("SSLv2", "SSLv3", "TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3")
+	for _, item := range strings.Fields(value) {
+		if err := valid(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configWorkerProcesses is auto or a number
+func configWorkerProcesses(value string) error {
+	if value == "auto" {
+		return nil
+	}
+	return configInt(value)
+}
+
+// configLargeClientHeaderBuffers is number and size, such as "4 8k"
+func configLargeClientHeaderBuffers(value string) error {
+	fields := strings.Fields(value)
+	if len(fields) != 2 || configInt(fields[0]) != nil || configSize(fields[1]) != nil {
+		return fmt.Errorf("%q is not number and size, such as \"4 8k\"", value)
+	}
+	return nil
+}
+
+// configAny accepts all values
+func configAny(value string) error {
+	return nil
+}
+
+// knownNginxConfigKeys is the schema of ingress-nginx ConfigMap
+var knownNginxConfigKeys = map[string]configValidateFunc{
+	"access-log-path":                       configAny,
+	"add-headers":                           configAny,
+	"allow-backend-server-header":           configBool,
+	"bind-address":                          configIPs,
+	"block-cidrs":                           configCIDRs,
+	"block-referers":                        configAny,
+	"block-user-agents":                     configAny,
+	"brotli-level":                          configInt,
+	"brotli-types":                          configAny,
+	"client-body-buffer-size":               configSize,
+	"client-body-timeout":                   configInt,
+	"client-header-buffer-size":             configSize,
+	"client-header-timeout":                 configInt,
+	"compute-full-forwarded-for":            configBool,
+	"custom-http-errors":                    configInts,
+	"disable-access-log":                    configBool,
+	"disable-ipv6":                          configBool,
+	"disable-ipv6-dns":                      configBool,
+	"enable-access-log-for-default-backend": configBool,
+	"enable-brotli":                         configBool,
+	"enable-dynamic-tls-records":            configBool,
+	"enable-modsecurity":                    configBool,
+	"enable-multi-accept":                   configBool,
+	"enable-opentracing":                    configBool,
+	"enable-owasp-modsecurity-crs":          configBool,
+	"enable-sticky-sessions":                configBool,
+	"enable-underscores-in-headers":         configBool,
+	"enable-vts-status":                     configBool,
+	"error-log-level":                       configEnum("debug", "info", "notice", "warn", "error", "crit", "alert", "emerg"),
+	"error-log-path":                        configAny,
+	"force-ssl-redirect":                    configBool,
+	"forwarded-for-header":                  configAny,
+	"generate-request-id":                   configBool,
+	"global-auth-url":                       configAny,
+	"global-auth-method":                    configAny,
+	"global-auth-signin":                    configAny,
+	"global-auth-response-headers":          configAny,
+	"global-auth-request-redirect":          configAny,
+	"global-auth-snippet":                   configAny,
+	"gzip-level":                            configInt,
+	"gzip-types":                            configAny,
+	"hsts":                                  configBool,
+	"hsts-include-subdomains":               configBool,
+	"hsts-max-age":                          configInt,
+	"hsts-preload":                          configBool,
+	"http-redirect-code":                    configEnum("301", "302", "307", "308"),
+	"http-snippet":                          configAny,
+	"http2-max-field-size":                  configSize,
+	"http2-max-header-size":                 configSize,
+	"http2-max-requests":                    configInt,
+	"ignore-invalid-headers":                configBool,
+	"jaeger-collector-host":                 configAny,
+	"jaeger-collector-port":                 configPort,
+	"jaeger-service-name":                   configAny,
+	"jaeger-sampler-type":                   configEnum("const", "probabilistic", "ratelimiting", "remote"),
+	"jaeger-sampler-param":                  configFloat,
+	"keep-alive":                            configInt,
+	"keep-alive-requests":                   configInt,
+	"large-client-header-buffers":           configLargeClientHeaderBuffers,
+	"limit-conn-status-code":                configInt,
+	"limit-conn-zone-variable":              configAny,
+	"limit-rate":                            configInt,
+	"limit-rate-after":                      configInt,
+	"limit-req-status-code":                 configInt,
+	"load-balance":                          configEnum("round_robin", "least_conn", "ip_hash", "ewma"),
+	"location-snippet":                      configAny,
+	"log-format-escape-json":                configBool,
+	"log-format-stream":                     configAny,
+	"log-format-upstream":                   configAny,
+	"main-snippet":                          configAny,
+	"map-hash-bucket-size":                  configInt,
+	"max-worker-connections":                configInt,
+	"max-worker-open-files":                 configInt,
+	"nginx-status-ipv4-whitelist":           configCIDRs,
+	"nginx-status-ipv6-whitelist":           configCIDRs,
+	"no-auth-locations":                     configAny,
+	"no-tls-redirect-locations":             configAny,
+	"proxy-add-original-uri-header":         configBool,
+	"proxy-body-size":                       configSize,
+	"proxy-buffer-size":                     configSize,
+	"proxy-buffering":                       configEnum("on", "off"),
+	"proxy-buffers-number":                  configInt,
+	"proxy-connect-timeout":                 configInt,
+	"proxy-cookie-domain":                   configAny,
+	"proxy-cookie-path":                     configAny,
+	"proxy-headers-hash-bucket-size":        configInt,
+	"proxy-headers-hash-max-size":           configInt,
+	"proxy-max-temp-file-size":              configSize,
+	"proxy-next-upstream":                   configAny,
+	"proxy-next-upstream-tries":             configInt,
+	"proxy-protocol-header-timeout":         configDuration,
+	"proxy-read-timeout":                    configInt,
+	"proxy-real-ip-cidr":                    configCIDRs,
+	"proxy-redirect-from":                   configAny,
+	"proxy-redirect-to":                     configAny,
+	"proxy-request-buffering":               configEnum("on", "off"),
+	"proxy-send-timeout":                    configInt,
+	"proxy-set-headers":                     configAny,
+	"proxy-stream-responses":                configInt,
+	"proxy-stream-timeout":                  configDuration,
+	"retry-non-idempotent":                  configBool,
+	"reuse-port":                            configBool,
+	"server-name-hash-bucket-size":          configInt,
+	"server-name-hash-max-size":             configInt,
+	"server-snippet":                        configAny,
+	"server-tokens":                         configBool,
+	"skip-access-log-urls":                  configAny,
+	"ssl-buffer-size":                       configSize,
+	"ssl-ciphers":                           configAny,
+	"ssl-dh-param":                          configAny,
+	"ssl-ecdh-curve":                        configAny,
+	"ssl-protocols":                         configSSLProtocols,
+	"ssl-redirect":                          configBool,
+	"ssl-session-cache":                     configBool,
+	"ssl-session-cache-size":                configSize,
+	"ssl-session-ticket-key":                configAny,
+	"ssl-session-tickets":                   configBool,
+	"ssl-session-timeout":                   configDuration,
+	"upstream-keepalive-connections":        configInt,
+	"upstream-keepalive-requests":           configInt,
+	"upstream-keepalive-timeout":            configInt,
+	"use-forwarded-headers":                 configBool,
+	"use-geoip":                             configBool,
+	"use-geoip2":                            configBool,
+	"use-gzip":                              configBool,
+	"use-http2":                             configBool,
+	"use-proxy-protocol":                    configBool,
+	"variables-hash-bucket-size":            configInt,
+	"variables-hash-max-size":               configInt,
+	"vts-default-filter-key":                configAny,
+	"vts-status-zone-size":                  configSize,
+	"vts-sum-key":                           configAny,
+	"whitelist-source-range":                configCIDRs,
+	"worker-cpu-affinity":                   configAny,
+	"worker-processes":                      configWorkerProcesses,
+	"worker-shutdown-timeout":               configDuration,
+	"zipkin-collector-host":                 configAny,
+	"zipkin-collector-port":                 configPort,
+	"zipkin-sample-rate":                    configFloat,
+	"zipkin-service-name":                   configAny,
+}
+
+// IsKnownNginxConfigKey returns true if the key is a known ingress-nginx config key
+func IsKnownNginxConfigKey(key string) bool {
+	_, ok := knownNginxConfigKeys[key]
+	return ok
+}
+
+// ValidateNginxConfigKey validates a config key and its value. Unknown keys get a warning
+// and invalid values get an error. Empty value is always valid, it means
+// using the default value of ingress-nginx.
+func ValidateNginxConfigKey(key, value string) *ConfigIssue {
+	validate, ok := knownNginxConfigKeys[key]
+	if !ok {
+		return &ConfigIssue{
+			Key:      key,
+			Value:    value,
+			Severity: ConfigIssueWarning,
+			Reason:   "unknown key",
+		}
+	}
+	if value == "" {
+		return nil
+	}
+	if err := validate(value); err != nil {
+		return &ConfigIssue{
+			Key:      key,
+			Value:    value,
+			Severity: ConfigIssueError,
+			Reason:   err.Error(),
+		}
+	}
+	return nil
+}
+
+// ValidateNginxConfig validates all keys in nginx config, the issues are sorted by key
+func ValidateNginxConfig(config map[string]string) []ConfigIssue {
+	issues := make([]ConfigIssue, 0)
+	for key, value := range config {
+		if issue := ValidateNginxConfigKey(key, value); issue != nil {
+			issues = append(issues, *issue)
+		}
+	}
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].Key < issues[j].Key
+	})
+	return issues
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2017 caicloud authors. All rights reserved.
+*/
+
+package v1alpha2
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateNginxConfigKey(t *testing.T) {
+	tests := []struct {
+		key      string
+		value    string
+		severity ConfigIssueSeverity
+	}{
+		{"proxy-body-size", "5G", ""},
+		{"proxy-body-size", "1024", ""},
+		{"proxy-body-size", "5GB", ConfigIssueError},
+		{"proxy-read-timeout", "60", ""},
+		{"proxy-read-timeout", "60s", ConfigIssueError},
+		{"ssl-session-timeout", "1h30m", ""},
+		{"ssl-session-timeout", "ten", ConfigIssueError},
+		{"ssl-redirect", "false", ""},
+		{"ssl-redirect", "no", ConfigIssueError},
+		{"whitelist-source-range", "10.0.0.0/8, 192.168.1.1,fd00::/8", ""},
+		{"whitelist-source-range", "10.0.0.0/33", ConfigIssueError},
+		{"ssl-protocols", "TLSv1.2 TLSv1.3", ""},
+		{"ssl-protocols", "TLSv2", ConfigIssueError},
+		{"load-balance", "ewma", ""},
+		{"load-balance", "random", ConfigIssueError},
+		{"large-client-header-buffers", "4 8k", ""},
+		{"large-client-header-buffers", "4", ConfigIssueError},
+		{"worker-processes", "auto", ""},
+		{"proxy-buffer-size", "", ""},
+		{"no-such-key", "1", ConfigIssueWarning},
+	}
+	for _, tt := range tests {
+		var got ConfigIssueSeverity
+		if issue := ValidateNginxConfigKey(tt.key, tt.value); issue != nil {
+			got = issue.Severity
+		}
+		if got != tt.severity {
+			t.Errorf("ValidateNginxConfigKey(%v, %q) severity = %q, want %q", tt.key, tt.value, got, tt.severity)
+		}
+	}
+}
+
+func TestValidateProxyNginxConfig(t *testing.T) {
+	spec := ProxySpec{Type: ProxyTypeNginx, Config: map[string]string{"proxy-body-size": "5GG", "no-such-key": "1"}}
+	if err := ValidateProxy(spec); err == nil || !strings.Contains(err.Error(), "proxy-body-size") {
+		t.Errorf("got error %v, want invalid proxy-body-size", err)
+	}
+	spec.Config["proxy-body-size"] = "5G"
+	if err := ValidateProxy(spec); err != nil {
+		t.Errorf("got error %v with unknown key", err)
+	}
+}
@@ -0,0 +1,1137 @@
+/*
+Copyright 2017 caicloud authors. All rights reserved.
+*/
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LoadBalancerList is a collection of LoadBalancer
+type LoadBalancerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []LoadBalancer `json:"items"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LoadBalancer describes a LoadBalancer which provides Load Balancing for applications
+// LoadBalancer contains a proxy and multiple providers to load balance
+// either internal or external traffic.
+//
+// A proxy is an ingress controller watching ingress resource to provide access that
+// allow inbound connections to reach the cluster services
+//
+// A provider is the entrance of the cluster providing high availability for connections
+// to proxy (ingress controller)
+type LoadBalancer struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the desired behavior of the LoadBalancer
+	Spec LoadBalancerSpec `json:"spec,omitempty"`
+	// Most recently observed status of the loadbalancer.
+	// This data may not be up to date.
+	// Populated by the system.
+	// Read-only.
+	// +optional
+	Status LoadBalancerStatus `json:"status,omitempty"`
+}
+
+// LoadBalancerSpec is a description of a LoadBalancer
+type LoadBalancerSpec struct {
+	// Specification of the desired behavior of the nodes
+	Nodes NodesSpec `json:"nodes"`
+	// Specification of the desired behavior of the proxy
+	Proxy ProxySpec `json:"proxy"`
+	// Specification of the desired behavior of the providers
+	Providers ProvidersSpec `json:"providers"`
+}
+
+// NodesSpec is a description of nodes
+type NodesSpec struct {
+	// Replica is only used when Provider's type is service now
+	// you can not use replica and names at the same time
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Names is a name list of nodes selected to run proxy
+	// It MUST be filled in when loadbalancer's type is external
+	// +optional
+	Names []string `json:"names,omitempty"`
+	// +optional
+	Effect *v1.TaintEffect `json:"taintEffect,omitempty"`
+}
+
+// ProxySpec is a description of a proxy
+type ProxySpec struct {
+	Type ProxyType `json:"type"`
+	// Config contains the optional config of proxy
+	Config map[string]string `json:"config,omitempty"`
+	// Compute Resources required by this container.
+	// Cannot be updated.
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// HTTPPort is the port that LoadBalancer listen http protocol
+	// default is 80
+	HTTPPort int `json:"httpPort,omitempty"`
+	// HTTPSPort is the port that LoadBalancer listen https protocol
+	// default is 443
+	HTTPSPort int `json:"httpsPort,omitempty"`
+	// PortRanges define a list of port-ranges the proxy can use
+	// default is [{20000,29999}]
+	PortRanges []PortRange `json:"portRanges,omitempty"`
+	// Streams define the L4 TCP/UDP services exposed by the proxy,
+	// every port must be in PortRanges
+	// +optional
+	Streams []StreamSpec `json:"streams,omitempty"`
+	// DefaultIngressClass marks the IngressClass of the proxy as the default
+	// class of the cluster, Ingresses without class will be served by the proxy
+	// +optional
+	DefaultIngressClass bool `json:"defaultIngressClass,omitempty"`
+	// IngressDeletionPolicy decides what happens to the Ingresses of the proxy
+	// when the LoadBalancer is deleted, default is Orphan. Ingresses are owned by
+	// application teams, so deletion is opt-in.
+	// +optional
+	IngressDeletionPolicy IngressDeletionPolicy `json:"ingressDeletionPolicy,omitempty"`
+	// IngressFallback is the LoadBalancer which Ingresses are reassigned to,
+	// it is required when IngressDeletionPolicy is Reassign
+	// +optional
+	IngressFallback *LoadBalancerReference `json:"ingressFallback,omitempty"`
+	// Disruption configures the PodDisruptionBudget of proxy pods
+	// +optional
+	Disruption *DisruptionSpec `json:"disruption,omitempty"`
+	// Autoscaling scales the proxy horizontally with a HorizontalPodAutoscaler,
+	// it only works when proxy does not run in host network (Nodes.Names is empty),
+	// and Nodes.Replicas is ignored
+	// +optional
+	Autoscaling *AutoscalingSpec `json:"autoscaling,omitempty"`
+	// PodTemplate is strategic-merge-patched onto the generated pod template of proxy,
+	// controller-owned fields can't be overridden
+	// +optional
+	PodTemplate *v1.PodTemplateSpec `json:"podTemplate,omitempty"`
+	// Image overrides the image of proxy set by controller, it must be
+	// permitted by the allowed images of controller
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Version overrides the tag of the image of proxy set by controller,
+	// it can't be used with Image
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Workload is the kind of workload which runs the pods, default is Deployment.
+	// DaemonSet runs one pod on every node in Nodes.Names, so it requires Nodes.Names
+	// +optional
+	Workload WorkloadKind `json:"workload,omitempty"`
+	// TLS configures the default certificate of proxy, it overrides the
+	// default certificate set by controller
+	// +optional
+	TLS *ProxyTLSSpec `json:"tls,omitempty"`
+}
+
+// ProxyTLSSpec describes the default certificate of proxy. The certificate is
+// read from Secret SecretName, or requested from IssuerRef by a cert-manager
+// Certificate which stores it in the Secret.
+type ProxyTLSSpec struct {
+	// SecretName is the kubernetes.io/tls Secret in namespace of LoadBalancer,
+	// it defaults to <name>-proxy-tls when IssuerRef is set
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// IssuerRef references the cert-manager Issuer or ClusterIssuer which signs
+	// the certificate
+	// +optional
+	IssuerRef *IssuerReference `json:"issuerRef,omitempty"`
+	// DNSNames is the subject alternative names of the requested certificate,
+	// it is required when IssuerRef is set
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+}
+
+// IssuerReference references a cert-manager issuer
+type IssuerReference struct {
+	Name string `json:"name"`
+	// Kind is Issuer or ClusterIssuer, default is Issuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// WorkloadKind is the kind of workload running proxy or provider pods
+type WorkloadKind string
+
+const (
+	// WorkloadDeployment runs pods with a Deployment
+	WorkloadDeployment WorkloadKind = "Deployment"
+	// WorkloadDaemonSet runs pods with a DaemonSet on the selected nodes
+	WorkloadDaemonSet WorkloadKind = "DaemonSet"
+)
+
+// AutoscalingSpec describes the HorizontalPodAutoscaler of proxy
+type AutoscalingSpec struct {
+	// MinReplicas is the lower limit of replicas, default is 1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit of replicas
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the target average CPU utilization
+	// over all the pods, default is 80 if no metric is specified
+	// +optional
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+	// Metrics are custom pods metrics such as active connections read from
+	// the nginx VTS status port, they must be served by the custom metrics API
+	// +optional
+	Metrics []AutoscalingMetric `json:"metrics,omitempty"`
+}
+
+// AutoscalingMetric is a custom pods metric used by autoscaling
+type AutoscalingMetric struct {
+	// Name is the name of the metric, such as nginx_vts_main_connections
+	Name string `json:"name"`
+	// TargetAverageValue is the target value of the metric averaged over all the pods
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// DisruptionSpec describes how many pods can be disrupted voluntarily
+type DisruptionSpec struct {
+	// MaxUnavailable is the number or percentage of pods which can be unavailable
+	// during voluntary disruption such as node drain, default is 1
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// IngressDeletionPolicy describes how to handle Ingresses when the LoadBalancer is deleted
+type IngressDeletionPolicy string
+
+const (
+	// IngressDeletionPolicyDelete deletes the Ingresses created by the LoadBalancer
+	IngressDeletionPolicyDelete IngressDeletionPolicy = "Delete"
+	// IngressDeletionPolicyOrphan leaves the Ingresses untouched
+	IngressDeletionPolicyOrphan IngressDeletionPolicy = "Orphan"
+	// IngressDeletionPolicyReassign moves the Ingresses to the class of IngressFallback
+	IngressDeletionPolicyReassign IngressDeletionPolicy = "Reassign"
+)
+
+// LoadBalancerReference refers to a LoadBalancer
+type LoadBalancerReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// PortRange describe a port range in {start, end}
+type PortRange struct {
+	Start int32 `json:"start"`
+	End   int32 `json:"end"`
+}
+
+const (
+	// DefaultHTTPPort is the default port that LoadBalancer listen http protocol
+	DefaultHTTPPort = 80
+	// DefaultHTTPSPort is the default port that LoadBalancer listen https protocol
+	DefaultHTTPSPort = 443
+)
+
+var (
+	// DefaultPortRanges is used when PortRanges is not specified
+	DefaultPortRanges = []PortRange{{Start: 20000, End: 29999}}
+)
+
+// StreamSpec is a description of a L4 service exposed by the proxy
+type StreamSpec struct {
+	// Port is the port that proxy listens on
+	Port int32 `json:"port"`
+	// Protocol is TCP or UDP, default is TCP
+	// +optional
+	Protocol v1.Protocol `json:"protocol,omitempty"`
+	// Service is the backend service which the stream is forwarded to
+	Service StreamServiceReference `json:"service"`
+	// ProxyProtocol controls the PROXY protocol of the stream
+	// +optional
+	ProxyProtocol *StreamProxyProtocol `json:"proxyProtocol,omitempty"`
+}
+
+// StreamServiceReference refers to a port of a service
+type StreamServiceReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Port      int32  `json:"port"`
+}
+
+// StreamProxyProtocol describes how the proxy handles PROXY protocol
+type StreamProxyProtocol struct {
+	// Decode accepts the PROXY protocol from the downstream
+	Decode bool `json:"decode,omitempty"`
+	// Encode sends the PROXY protocol to the upstream service
+	Encode bool `json:"encode,omitempty"`
+}
+
+// ProxyType ...
+type ProxyType string
+
+const (
+	// ProxyTypeNginx for nginx
+	ProxyTypeNginx ProxyType = "nginx"
+	// ProxyTypeHaproxy for haproxy
+	ProxyTypeHaproxy ProxyType = "haproxy"
+	// ProxyTypeTraefik for traefik
+	ProxyTypeTraefik ProxyType = "traefik"
+)
+
+// ProvidersSpec is a description of prividers
+type ProvidersSpec struct {
+	// external provider
+	External *ExternalProvider `json:"external,omitempty"`
+	// ipvs dr
+	Ipvsdr *IpvsdrProvider `json:"ipvsdr,omitempty"`
+	// aliyun slb
+	Aliyun *AliyunProvider `json:"aliyun,omitempty"`
+	// azure
+	Azure *AzureProvider `json:"azure,omitempty"`
+	// bgp
+	BGP *BGPProvider `json:"bgp,omitempty"`
+	// aws nlb
+	AWS *AWSProvider `json:"aws,omitempty"`
+	// openstack octavia
+	OpenStack *OpenStackProvider `json:"openstack,omitempty"`
+	// f5 big-ip
+	F5 *F5Provider `json:"f5,omitempty"`
+}
+
+// BGPProvider announces vips to upstream routers by BGP speakers running on
+// the nodes of LoadBalancer
+type BGPProvider struct {
+	VIPs []string `json:"vips"`
+	// ASN is the local autonomous system number of speakers
+	ASN uint32 `json:"asn"`
+	// Peers are the upstream routers
+	Peers []BGPPeer `json:"peers"`
+	// Communities are attached to the announced routes, in the format of AA:NN
+	// +optional
+	Communities []string `json:"communities,omitempty"`
+	// PodTemplate is strategic-merge-patched onto the generated pod template of speakers,
+	// controller-owned fields can't be overridden
+	// +optional
+	PodTemplate *v1.PodTemplateSpec `json:"podTemplate,omitempty"`
+	// Image overrides the image of speakers set by controller, it must be
+	// allowed by the controller
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Version overrides the tag of the image of speakers set by controller
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// BGPPeer is an upstream router peering with speakers
+type BGPPeer struct {
+	Address string `json:"address"`
+	ASN     uint32 `json:"asn"`
+	// Port defaults to 179
+	// +optional
+	Port int32 `json:"port,omitempty"`
+	// NodeSelector selects the nodes peering with the router, such as the nodes
+	// in the rack of a ToR switch. Empty means all nodes of LoadBalancer
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// ExternalProvider is a provider docking for external loadbalancer
+type ExternalProvider struct {
+	VIP  string   `json:"vip,omitempty"`
+	VIPs []string `json:"vips,omitempty"`
+	// PoolRef references the VIPPool which allocates a vip to VIPs automatically
+	// when VIP and VIPs are empty
+	// +optional
+	PoolRef *VIPPoolReference `json:"poolRef,omitempty"`
+}
+
+// VIPPoolReference references a cluster-scoped VIPPool
+type VIPPoolReference struct {
+	Name string `json:"name"`
+}
+
+// KeepalivedBind is vip binding information
+type KeepalivedBind struct {
+	// bind to interface
+	Iface string `json:"iface,omitempty"`
+	// bind to interface which in subnet
+	//CIDR string `json:"cidr,omitempty"`
+	// bind to ip from node annotation
+	NodeIPAnnotation string `json:"nodeIPAnnotation,omitempty"`
+	// bind to iface from node annotation
+	//NodeIfaceAnnotation string `json:"nodeIfaceAnnotation,omitempty"`
+}
+
+// KeepalivedProvider is a keepalived provider
+type KeepalivedProvider struct {
+	// Virtual IP Address
+	VIP string `json:"vip,omitempty"`
+	// Virtual IP Addresses
+	VIPs []string `json:"vips,omitempty"`
+	// virtual server shceduler algorithm type, it is required by ipvsdr and
+	// slaves default to the scheduler of ipvsdr
+	Scheduler IpvsScheduler `json:"scheduler"`
+	// ActiveActive or ActivePassive
+	HAMode HAMode `json:"haMode,omitempty"`
+	// vip bound to
+	Bind *KeepalivedBind `json:"bind,omitempty"`
+	// VRRPVersion is the VRRP version of ipv4 vips, 2 or 3, defaults to 2.
+	// ipv6 vips always use VRRPv3
+	// +optional
+	VRRPVersion int `json:"vrrpVersion,omitempty"`
+}
+
+// IPFamily is the family of ip addresses
+type IPFamily string
+
+const (
+	// IPv4Family ...
+	IPv4Family IPFamily = "IPv4"
+	// IPv6Family ...
+	IPv6Family IPFamily = "IPv6"
+)
+
+// FamilyVIPs are the vips of an ip family
+type FamilyVIPs struct {
+	Family IPFamily `json:"family"`
+	VIPs   []string `json:"vips"`
+}
+
+// HAMode ...
+type HAMode string
+
+const (
+	// ActiveActiveHA ...
+	ActiveActiveHA HAMode = "ActiveActive"
+	// ActivePassiveHA ...
+	ActivePassiveHA HAMode = "ActivePassive"
+)
+
+// IpvsdrProvider is a ipvs dr provider
+type IpvsdrProvider struct {
+	KeepalivedProvider
+	Slaves []KeepalivedProvider `json:"slaves,omitempty"`
+	// Vrid is the VRRP router id of keepalived, it is allocated by controller if
+	// it is not set. Set it when the network segment is shared with keepalived
+	// instances outside of kubernetes, the id must be unique in the segment
+	// +optional
+	Vrid *int `json:"vrid,omitempty"`
+	// PoolRef references the VIPPool which allocates a vip to VIPs automatically
+	// when VIP and VIPs are empty
+	// +optional
+	PoolRef *VIPPoolReference `json:"poolRef,omitempty"`
+	// PodTemplate is strategic-merge-patched onto the generated pod template of provider,
+	// controller-owned fields can't be overridden
+	// +optional
+	PodTemplate *v1.PodTemplateSpec `json:"podTemplate,omitempty"`
+	// Image overrides the image of provider set by controller, it must be
+	// permitted by the allowed images of controller
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Version overrides the tag of the image of provider set by controller,
+	// it can't be used with Image
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Workload is the kind of workload which runs the pods, default is Deployment.
+	// DaemonSet runs one pod on every node in Nodes.Names, so it requires Nodes.Names
+	// +optional
+	Workload WorkloadKind `json:"workload,omitempty"`
+}
+
+// IpvsScheduler is ipvs shceduler algorithm type
+type IpvsScheduler string
+
+const (
+	// IpvsSchedulerRR - Round Robin
+	IpvsSchedulerRR IpvsScheduler = "rr"
+	// IpvsSchedulerWRR - Weighted Round Robin
+	IpvsSchedulerWRR IpvsScheduler = "wrr"
+	// IpvsSchedulerLC - Round Robin
+	IpvsSchedulerLC IpvsScheduler = "lc"
+	// IpvsSchedulerWLC - Weighted Least Connections
+	IpvsSchedulerWLC IpvsScheduler = "wlc"
+	// IpvsSchedulerLBLC - Locality-Based Least Connections
+	IpvsSchedulerLBLC IpvsScheduler = "lblc"
+	// IpvsSchedulerDH - Destination Hashing
+	IpvsSchedulerDH IpvsScheduler = "dh"
+	// IpvsSchedulerSH - Source Hashing
+	IpvsSchedulerSH IpvsScheduler = "sh"
+)
+
+// AliyunProvider is a description of an aliyun SLB in front of the proxy
+type AliyunProvider struct {
+	// Name is the name of SLB created by provider,
+	// default is the namespace and name of LoadBalancer joined by a dash
+	// +optional
+	Name string `json:"name,omitempty"`
+	// RegionID is the region of SLB, such as cn-hangzhou
+	RegionID string `json:"regionID"`
+	// CredentialsSecret is the name of Secret in the namespace of LoadBalancer,
+	// it holds the AccessKey of aliyun in keys accessKeyID and accessKeySecret
+	CredentialsSecret string `json:"credentialsSecret"`
+	// LoadBalancerID is an existing SLB used by provider, provider creates
+	// a new SLB if it is empty. An existing SLB is never deleted by provider
+	// +optional
+	LoadBalancerID string `json:"loadBalancerID,omitempty"`
+	// AddressType is the network type of SLB address, default is internet
+	// +optional
+	AddressType AliyunAddressType `json:"addressType,omitempty"`
+	// VSwitchID is the vswitch which intranet SLB is allocated in,
+	// it is required when AddressType is intranet
+	// +optional
+	VSwitchID string `json:"vSwitchID,omitempty"`
+	// Spec is the instance spec of SLB, such as slb.s1.small,
+	// empty means the shared-performance instance
+	// +optional
+	Spec string `json:"spec,omitempty"`
+	// PodTemplate is strategic-merge-patched onto the generated pod template of provider,
+	// controller-owned fields can't be overridden
+	// +optional
+	PodTemplate *v1.PodTemplateSpec `json:"podTemplate,omitempty"`
+	// Image overrides the image of provider set by controller, it must be
+	// permitted by the allowed images of controller
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Version overrides the tag of the image of provider set by controller,
+	// it can't be used with Image
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// AliyunAddressType is the network type of SLB address
+type AliyunAddressType string
+
+const (
+	// AliyunInternetAddressType allocates a public address
+	AliyunInternetAddressType AliyunAddressType = "internet"
+	// AliyunIntranetAddressType allocates an address in vpc
+	AliyunIntranetAddressType AliyunAddressType = "intranet"
+)
+
+const (
+	// AliyunAccessKeyIDKey is the key of AccessKey ID in credentials Secret
+	AliyunAccessKeyIDKey = "accessKeyID"
+	// AliyunAccessKeySecretKey is the key of AccessKey secret in credentials Secret
+	AliyunAccessKeySecretKey = "accessKeySecret"
+)
+
+// AWSProvider is a description of an aws network load balancer in front of the proxy
+type AWSProvider struct {
+	// Name is the name of NLB, default is generated from the namespace and
+	// name of LoadBalancer. It must be unique in the region
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Region is the region of NLB, such as us-east-1
+	Region string `json:"region"`
+	// CredentialsSecret is the name of Secret in the namespace of LoadBalancer,
+	// it holds the access key of aws in keys accessKeyID and secretAccessKey
+	CredentialsSecret string `json:"credentialsSecret"`
+	// Subnets are the subnets which NLB is attached to, one per availability zone
+	Subnets []string `json:"subnets"`
+	// Scheme is internal or internet-facing, default is internet-facing
+	// +optional
+	Scheme AWSLoadBalancerScheme `json:"scheme,omitempty"`
+	// EIPAllocations are the elastic ip allocations of subnets in the same order,
+	// they are only allowed for internet-facing NLB
+	// +optional
+	EIPAllocations []string `json:"eipAllocations,omitempty"`
+	// CrossZone enables cross-zone load balancing
+	// +optional
+	CrossZone bool `json:"crossZone,omitempty"`
+}
+
+// AWSLoadBalancerScheme is the scheme of NLB
+type AWSLoadBalancerScheme string
+
+const (
+	// AWSInternetFacingScheme routes requests from the internet
+	AWSInternetFacingScheme AWSLoadBalancerScheme = "internet-facing"
+	// AWSInternalScheme routes requests from the vpc
+	AWSInternalScheme AWSLoadBalancerScheme = "internal"
+)
+
+const (
+	// AWSAccessKeyIDKey is the key of access key id in credentials Secret
+	AWSAccessKeyIDKey = "accessKeyID"
+	// AWSSecretAccessKeyKey is the key of secret access key in credentials Secret
+	AWSSecretAccessKeyKey = "secretAccessKey"
+)
+
+// OpenStackProvider is a description of an openstack octavia load balancer in front of the proxy
+type OpenStackProvider struct {
+	// Name is the name of octavia load balancer, default is the namespace and
+	// name of LoadBalancer joined by a dash
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Region is the region of endpoints in keystone catalog,
+	// empty means the first endpoint of octavia and neutron
+	// +optional
+	Region string `json:"region,omitempty"`
+	// CredentialsSecret is the name of Secret in the namespace of LoadBalancer, it holds
+	// the keystone credentials in keys authURL, username, password, projectID and domainName
+	CredentialsSecret string `json:"credentialsSecret"`
+	// SubnetID is the subnet which vip of load balancer is allocated in
+	SubnetID string `json:"subnetID"`
+	// MemberSubnetID is the subnet of nodes, default is SubnetID
+	// +optional
+	MemberSubnetID string `json:"memberSubnetID,omitempty"`
+	// VIPAddress is the fixed vip of load balancer, it is allocated by neutron if empty
+	// +optional
+	VIPAddress string `json:"vipAddress,omitempty"`
+	// FlavorID is the flavor of load balancer
+	// +optional
+	FlavorID string `json:"flavorID,omitempty"`
+	// FloatingNetworkID is the external network which floating ip of vip is
+	// allocated from, no floating ip is associated if it is empty
+	// +optional
+	FloatingNetworkID string `json:"floatingNetworkID,omitempty"`
+}
+
+const (
+	// OpenStackAuthURLKey is the key of keystone v3 url in credentials Secret
+	OpenStackAuthURLKey = "authURL"
+	// OpenStackUsernameKey is the key of user name in credentials Secret
+	OpenStackUsernameKey = "username"
+	// OpenStackPasswordKey is the key of password in credentials Secret
+	OpenStackPasswordKey = "password"
+	// OpenStackProjectIDKey is the key of project id in credentials Secret
+	OpenStackProjectIDKey = "projectID"
+	// OpenStackDomainNameKey is the key of user domain name in credentials Secret,
+	// default is Default
+	OpenStackDomainNameKey = "domainName"
+)
+
+// F5Provider is a description of virtual servers on a F5 BIG-IP in front of the proxy
+type F5Provider struct {
+	// Host is the address of iControl REST API of BIG-IP, such as bigip.example.com:8443,
+	// https is used if scheme is absent
+	Host string `json:"host"`
+	// Partition is the administrative partition holding virtual servers and pools,
+	// default is Common
+	// +optional
+	Partition string `json:"partition,omitempty"`
+	// CredentialsSecret is the name of Secret in the namespace of LoadBalancer, it holds
+	// the user in keys username and password, and optionally the CA of BIG-IP in key ca.crt
+	CredentialsSecret string `json:"credentialsSecret"`
+	// InsecureSkipVerify skips verifying the certificate of BIG-IP
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Name is the prefix of virtual servers and pools, default is the namespace and
+	// name of LoadBalancer joined by a dash
+	// +optional
+	Name string `json:"name,omitempty"`
+	// VIPAddress is the destination address of virtual servers
+	VIPAddress string `json:"vipAddress"`
+}
+
+const (
+	// F5UsernameKey is the key of user name in credentials Secret
+	F5UsernameKey = "username"
+	// F5PasswordKey is the key of password in credentials Secret
+	F5PasswordKey = "password"
+	// F5CAKey is the key of PEM encoded CA certificates of BIG-IP in credentials Secret
+	F5CAKey = "ca.crt"
+)
+
+// AzureProvider ...
+type AzureProvider struct {
+	// Name azure loadbalancer name
+	Name string `json:"name,omitempty"`
+	// ResourceGroupName Azure resource group name
+	ResourceGroupName string `json:"resourceGroupName"`
+	// Location - Resource location of china.
+	Location string `json:"location"`
+	// SKU The load balancer SKU.
+	// explanation https://docs.microsoft.com/en-us/azure/load-balancer/load-balancer-overview
+	SKU AzureSKUKind `json:"sku"`
+	// ClusterID cluster id
+	ClusterID string `json:"clusterID"`
+	// ReserveAzure This flag tells the controller to reserve azure loadbalancer when
+	// deleting compass loadbalancer
+	ReserveAzure *bool `json:"reserveAzure,omitempty"`
+	// IPAddress azure loadbalancer IP address properties
+	IPAddressProperties AzureIPAddressProperties `json:"ipAddressProperties"`
+	// PodTemplate is strategic-merge-patched onto the generated pod template of provider,
+	// controller-owned fields can't be overridden
+	// +optional
+	PodTemplate *v1.PodTemplateSpec `json:"podTemplate,omitempty"`
+	// Image overrides the image of provider set by controller, it must be
+	// permitted by the allowed images of controller
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Version overrides the tag of the image of provider set by controller,
+	// it can't be used with Image
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// AzureIPAddressProperties azure loadbalancer IP address properties
+type AzureIPAddressProperties struct {
+	// Private private IP address properties
+	Private *AzurePrivateIPAddressProperties `json:"private,omitempty"`
+	// Public public IP address properties
+	Public *AzurePublicIPAddressProperties `json:"public,omitempty"`
+}
+
+// AzurePrivateIPAddressProperties  azure loadbalancer private IP address properties
+type AzurePrivateIPAddressProperties struct {
+	// IPAllocationMethod - The Private IP allocation method.
+	IPAllocationMethod AzureIPAllocationMethodKind `json:"ipAllocationMethod"`
+	// VPC virtual private cloud id
+	VPCID string `json:"vpcID"`
+	// SubnetID - The reference of the subnet resource id.
+	SubnetID string `json:"subnetID"`
+	// PrivateIPAddress - The private IP address of the IP configuration.
+	PrivateIPAddress *string `json:"privateIPAddress,omitempty"`
+}
+
+// AzurePublicIPAddressProperties azure loadbalancer public IP address properties
+type AzurePublicIPAddressProperties struct {
+	// IPAllocationMethod  the public IP allocation method.
+	IPAllocationMethod AzureIPAllocationMethodKind `json:"ipAllocationMethod"`
+	// PublicIPAddressID - The reference of the Public IP resource ID.
+	PublicIPAddressID *string `json:"publicIPAddressID,omitempty"`
+}
+
+// AzureIPAddressType loadbalancer based on network type
+type AzureIPAddressType string
+
+const (
+	// AzurePrivateIPAddressType private network
+	AzurePrivateIPAddressType AzureIPAddressType = "private"
+	// AzurePublicIPAddressType public network
+	AzurePublicIPAddressType AzureIPAddressType = "public"
+)
+
+// AzureSKUKind The load balancer SKU.
+type AzureSKUKind string
+
+const (
+	// AzureStandardSKU Standard sku
+	AzureStandardSKU AzureSKUKind = "Standard"
+	// AzureBasicSKU Basic sku
+	AzureBasicSKU AzureSKUKind = "Basic"
+)
+
+// AzureIPAllocationMethodKind enumerates the values for ip allocation method.
+type AzureIPAllocationMethodKind string
+
+const (
+	// AzureStaticIPAllocationMethod static ip allocation method
+	AzureStaticIPAllocationMethod AzureIPAllocationMethodKind = "Static"
+	// AzureDynamicIPAllocationMethod Dynamic ip allocation method
+	AzureDynamicIPAllocationMethod AzureIPAllocationMethodKind = "Dynamic"
+)
+
+// LoadBalancerStatus represents the current status of a LoadBalancer
+type LoadBalancerStatus struct {
+	// Accessible specify if the loadbalancer is ready for access
+	Accessible bool `json:"accessible,omitempty"`
+	// AccessIPs specify the entrance ip of loadbalancer
+	AccessIPs []string `json:"accessIPs,omitempty"`
+	// NodeIPs specify the entrance node ip of loadbalancer
+	NodeIPs []string `json:"nodeIPs,omitempty"`
+	// +optional
+	ProxyStatus ProxyStatus `json:"proxyStatus"`
+	// +optional
+	ProvidersStatuses ProvidersStatuses `json:"providersStatuses"`
+	// +optional
+	NodeStatuses NodeStatuses `json:"nodeStatuses"`
+}
+
+// InterfaceNet represents the current status of an interface
+type InterfaceNet struct {
+	Name string   `json:"name"`
+	Mac  string   `json:"mac"`
+	IPs  []string `json:"ips,omitempty"`
+}
+
+// NodeStatus represents the current status of a node
+type NodeStatus struct {
+	Name         string          `json:"name"`
+	IfaceNetList []*InterfaceNet `json:"ifaces,omitempty"`
+}
+
+// NodeStatuses represents the current status of nodes
+type NodeStatuses struct {
+	Nodes []NodeStatus `json:"nodes,omitempty"`
+}
+
+// ProxyStatus represents the current status of a Proxy
+type ProxyStatus struct {
+	PodStatuses  `json:",inline"`
+	Deployment   string `json:"deployment,omitempty"`
+	DaemonSet    string `json:"daemonSet,omitempty"`
+	IngressClass string `json:"ingressClass,omitempty"`
+	ConfigMap    string `json:"configMap,omitempty"`
+	TCPConfigMap string `json:"tcpConfigMap,omitempty"`
+	UDPConfigMap string `json:"udpConfigMap,omitempty"`
+	// Streams represents the current status of streams in spec
+	Streams []StreamStatus `json:"streams,omitempty"`
+	// PortViolations represents the stream entries quarantined
+	// because their ports are not allowed
+	PortViolations []PortViolation `json:"portViolations,omitempty"`
+	// ConfigIssues represents the unknown or invalid keys in proxy config
+	ConfigIssues []ConfigIssue `json:"configIssues,omitempty"`
+	// ConfigProvenance summarizes which layers the effective proxy config comes from
+	ConfigProvenance *ConfigProvenance `json:"configProvenance,omitempty"`
+	// Autoscaling represents the status of HorizontalPodAutoscaler of proxy
+	Autoscaling *AutoscalingStatus `json:"autoscaling,omitempty"`
+	// RunningImages are the images of proxy container in pods,
+	// there are more than one during rolling update
+	RunningImages []string `json:"runningImages,omitempty"`
+	// TLS represents the status of default certificate of proxy
+	TLS *ProxyTLSStatus `json:"tls,omitempty"`
+}
+
+// ProxyTLSStatus is the status of default certificate of proxy
+type ProxyTLSStatus struct {
+	// SecretName is the Secret which the certificate is read from
+	SecretName string `json:"secretName"`
+	// Certificate is the cert-manager Certificate requesting the certificate
+	Certificate string `json:"certificate,omitempty"`
+	// NotAfter is the expiration time of the certificate
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+	// Expiring means the certificate expires soon or has expired
+	Expiring bool `json:"expiring,omitempty"`
+	// Message is the reason why the certificate is not available
+	Message string `json:"message,omitempty"`
+}
+
+// AutoscalingStatus is the status of HorizontalPodAutoscaler of proxy
+type AutoscalingStatus struct {
+	HorizontalPodAutoscaler string `json:"horizontalPodAutoscaler"`
+	// CurrentReplicas is the number of replicas last seen by the autoscaler
+	CurrentReplicas int32 `json:"currentReplicas"`
+	// DesiredReplicas is the number of replicas last calculated by the autoscaler
+	DesiredReplicas int32        `json:"desiredReplicas"`
+	LastScaleTime   *metav1.Time `json:"lastScaleTime,omitempty"`
+}
+
+// ConfigIssueSeverity is the severity of a config issue
+type ConfigIssueSeverity string
+
+const (
+	// ConfigIssueError means the key is rejected and not applied
+	ConfigIssueError ConfigIssueSeverity = "Error"
+	// ConfigIssueWarning means the key is applied but may not work
+	ConfigIssueWarning ConfigIssueSeverity = "Warning"
+)
+
+// ConfigIssue represents an unknown or invalid key in proxy config
+type ConfigIssue struct {
+	// Layer is the source layer of the key, such as spec, cluster, instance-override
+	Layer    string              `json:"layer"`
+	Key      string              `json:"key"`
+	Value    string              `json:"value,omitempty"`
+	Severity ConfigIssueSeverity `json:"severity"`
+	Reason   string              `json:"reason"`
+}
+
+// ConfigProvenance is the summary of effective proxy config provenance,
+// the full report of each key is in the Report annotation of proxy ConfigMap
+type ConfigProvenance struct {
+	// Layers is the number of effective keys coming from each layer,
+	// such as platform, cluster, instance, default, spec, cluster-override
+	Layers map[string]int `json:"layers,omitempty"`
+	// ShadowedSpecKeys are the keys in spec.proxy.config shadowed by override layers
+	ShadowedSpecKeys []string `json:"shadowedSpecKeys,omitempty"`
+	// Report is the annotation key of the full provenance report in proxy ConfigMap
+	Report string `json:"report,omitempty"`
+}
+
+// PortViolation represents a stream entry whose port is not allowed by PortRanges
+type PortViolation struct {
+	Port     string      `json:"port"`
+	Protocol v1.Protocol `json:"protocol"`
+	Backend  string      `json:"backend"`
+	Reason   string      `json:"reason"`
+}
+
+// StreamStatus represents the current status of a stream
+type StreamStatus struct {
+	Port     int32       `json:"port"`
+	Protocol v1.Protocol `json:"protocol"`
+	// Service is the backend in namespace/name:port format
+	Service string `json:"service"`
+	// Resolved specify if the backend service and port are found
+	Resolved bool   `json:"resolved"`
+	Message  string `json:"message,omitempty"`
+}
+
+// ProvidersStatuses represents the current status of Providers
+type ProvidersStatuses struct {
+	// external loadbalancer provider
+	External *ExpternalProviderStatus `json:"external,omitempty"`
+	// ipvs dr
+	Ipvsdr *IpvsdrProviderStatus `json:"ipvsdr,omitempty"`
+	// aliyun slb
+	Aliyun *AliyunProviderStatus `json:"aliyun,omitempty"`
+	// azure
+	Azure *AzureProviderStatus `json:"azure,omitempty"`
+	// bgp
+	BGP *BGPProviderStatus `json:"bgp,omitempty"`
+	// aws nlb
+	AWS *AWSProviderStatus `json:"aws,omitempty"`
+	// openstack octavia
+	OpenStack *OpenStackProviderStatus `json:"openstack,omitempty"`
+	// f5 big-ip
+	F5 *F5ProviderStatus `json:"f5,omitempty"`
+}
+
+// BGPProviderStatus represents the current status of the bgp provider
+type BGPProviderStatus struct {
+	PodStatuses `json:",inline"`
+	DaemonSet   string   `json:"daemonSet,omitempty"`
+	ConfigMap   string   `json:"configMap,omitempty"`
+	VIPs        []string `json:"vips,omitempty"`
+	// AnnouncingNodes are the nodes announcing vips, which have ready proxy pods
+	AnnouncingNodes []string `json:"announcingNodes,omitempty"`
+	// Peers are the sessions between speakers and peers
+	Peers []BGPPeerStatus `json:"peers,omitempty"`
+}
+
+// BGPSessionState is the state of a BGP session
+type BGPSessionState string
+
+const (
+	// BGPSessionIdle ...
+	BGPSessionIdle BGPSessionState = "Idle"
+	// BGPSessionConnect ...
+	BGPSessionConnect BGPSessionState = "Connect"
+	// BGPSessionActive ...
+	BGPSessionActive BGPSessionState = "Active"
+	// BGPSessionOpenSent ...
+	BGPSessionOpenSent BGPSessionState = "OpenSent"
+	// BGPSessionOpenConfirm ...
+	BGPSessionOpenConfirm BGPSessionState = "OpenConfirm"
+	// BGPSessionEstablished ...
+	BGPSessionEstablished BGPSessionState = "Established"
+	// BGPSessionUnknown means the state can't be retrieved from speaker
+	BGPSessionUnknown BGPSessionState = "Unknown"
+)
+
+// BGPPeerStatus is the state of the session between a speaker and a peer
+type BGPPeerStatus struct {
+	Node    string          `json:"node"`
+	Address string          `json:"address"`
+	ASN     uint32          `json:"asn"`
+	State   BGPSessionState `json:"state"`
+	Message string          `json:"message,omitempty"`
+}
+
+// ExpternalProviderStatus represents the current status of the external provider
+type ExpternalProviderStatus struct {
+	VIP  string   `json:"vip,omitempty"`
+	VIPs []string `json:"vips,omitempty"`
+	// Families are the vips grouped by ip family
+	Families []FamilyVIPs `json:"families,omitempty"`
+}
+
+// IpvsdrProviderStatus represents the current status of the ipvsdr provider
+type IpvsdrProviderStatus struct {
+	PodStatuses `json:",inline"`
+	Deployment  string   `json:"deployment,omitempty"`
+	DaemonSet   string   `json:"daemonSet,omitempty"`
+	VIP         string   `json:"vip,omitempty"`
+	VIPs        []string `json:"vips,omitempty"`
+	Vrid        *int     `json:"vrid,omitempty"`
+	// Fwmark is allocated by controller and unique in cluster, the firewall mark
+	// of the ith vip of master group is Fwmark<<16|(i+1)
+	Fwmark *int `json:"fwmark,omitempty"`
+	// Families are the vips of master group grouped by ip family
+	Families []FamilyVIPs `json:"families,omitempty"`
+	// RunningImages are the images of provider container in pods,
+	// there are more than one during rolling update
+	RunningImages []string `json:"runningImages,omitempty"`
+	// Slaves represents the status of slave keepalived groups in spec
+	Slaves []KeepalivedStatus `json:"slaves,omitempty"`
+	// ConfigMap is the generated keepalived configuration of provider
+	ConfigMap string `json:"configMap,omitempty"`
+}
+
+// KeepalivedStatus represents the status of a keepalived group
+type KeepalivedStatus struct {
+	VIP  string   `json:"vip,omitempty"`
+	VIPs []string `json:"vips,omitempty"`
+	Vrid *int     `json:"vrid,omitempty"`
+	// Fwmark is allocated by controller and unique in cluster, the firewall mark
+	// of the ith vip of the group is Fwmark<<16|(i+1)
+	Fwmark *int `json:"fwmark,omitempty"`
+	// Families are the vips grouped by ip family
+	Families []FamilyVIPs `json:"families,omitempty"`
+}
+
+// AliyunProviderStatus represents the current status of the aliyun provider
+type AliyunProviderStatus struct {
+	// Phase aliyun SLB phase
+	Phase AliyunProviderPhase `json:"phase"`
+	// Message is the reason why SLB is not running
+	Message string `json:"message,omitempty"`
+	// LoadBalancerID is the id of SLB
+	LoadBalancerID string `json:"loadBalancerID,omitempty"`
+	// Address is the address of SLB
+	Address string `json:"address,omitempty"`
+	// BackendServers are the ECS instances of nodes added to SLB
+	BackendServers []string `json:"backendServers,omitempty"`
+	// RunningImages are the images of provider container in pods,
+	// there are more than one during rolling update
+	RunningImages []string `json:"runningImages,omitempty"`
+}
+
+// AliyunProviderPhase aliyun SLB phase
+type AliyunProviderPhase string
+
+const (
+	// AliyunProgressingPhase means SLB is being created or is not active
+	AliyunProgressingPhase AliyunProviderPhase = "Progressing"
+	// AliyunRunningPhase means SLB is active and in sync
+	AliyunRunningPhase AliyunProviderPhase = "Running"
+	// AliyunErrorPhase means SLB or provider pods fail
+	AliyunErrorPhase AliyunProviderPhase = "Error"
+)
+
+// AWSProviderStatus represents the current status of the aws provider
+type AWSProviderStatus struct {
+	// Phase aws NLB phase
+	Phase AWSProviderPhase `json:"phase"`
+	// Message is the reason why NLB is not running
+	Message string `json:"message,omitempty"`
+	// LoadBalancerARN is the arn of NLB
+	LoadBalancerARN string `json:"loadBalancerARN,omitempty"`
+	// DNSName is the dns name of NLB
+	DNSName string `json:"dnsName,omitempty"`
+	// Addresses are the ip addresses of NLB in availability zones,
+	// internet-facing NLB only has them if EIPAllocations is set
+	Addresses []string `json:"addresses,omitempty"`
+	// TargetGroups are the arns of target groups by listener, such as TCP/80
+	TargetGroups map[string]string `json:"targetGroups,omitempty"`
+	// Targets are the EC2 instances of nodes registered to target groups
+	Targets []string `json:"targets,omitempty"`
+}
+
+// AWSProviderPhase aws NLB phase
+type AWSProviderPhase string
+
+const (
+	// AWSProgressingPhase means NLB is being provisioned
+	AWSProgressingPhase AWSProviderPhase = "Progressing"
+	// AWSRunningPhase means NLB is active and in sync
+	AWSRunningPhase AWSProviderPhase = "Running"
+	// AWSErrorPhase means NLB fails to sync
+	AWSErrorPhase AWSProviderPhase = "Error"
+)
+
+// OpenStackProviderStatus represents the current status of the openstack provider
+type OpenStackProviderStatus struct {
+	// Phase octavia load balancer phase
+	Phase OpenStackProviderPhase `json:"phase"`
+	// Message is the reason why load balancer is not running
+	Message string `json:"message,omitempty"`
+	// ProvisioningStatus is the provisioning status of octavia load balancer,
+	// such as ACTIVE, PENDING_UPDATE and ERROR
+	ProvisioningStatus string `json:"provisioningStatus,omitempty"`
+	// OperatingStatus is the operating status of octavia load balancer,
+	// such as ONLINE, DEGRADED and ERROR
+	OperatingStatus string `json:"operatingStatus,omitempty"`
+	// LoadBalancerID is the id of octavia load balancer
+	LoadBalancerID string `json:"loadBalancerID,omitempty"`
+	// VIPAddress is the vip of load balancer
+	VIPAddress string `json:"vipAddress,omitempty"`
+	// FloatingIP is the floating ip associated with vip
+	FloatingIP string `json:"floatingIP,omitempty"`
+	// FloatingIPID is the id of floating ip allocated by provider
+	FloatingIPID string `json:"floatingIPID,omitempty"`
+	// Members are the addresses of nodes in pools
+	Members []string `json:"members,omitempty"`
+}
+
+// OpenStackProviderPhase octavia load balancer phase
+type OpenStackProviderPhase string
+
+const (
+	// OpenStackProgressingPhase means load balancer is being provisioned
+	OpenStackProgressingPhase OpenStackProviderPhase = "Progressing"
+	// OpenStackRunningPhase means load balancer is active and in sync
+	OpenStackRunningPhase OpenStackProviderPhase = "Running"
+	// OpenStackErrorPhase means load balancer fails to sync
+	OpenStackErrorPhase OpenStackProviderPhase = "Error"
+)
+
+// F5ProviderStatus represents the current status of the f5 provider
+type F5ProviderStatus struct {
+	// Phase f5 virtual servers phase
+	Phase F5ProviderPhase `json:"phase"`
+	// Message is the reason why virtual servers are not running
+	Message string `json:"message,omitempty"`
+	// VirtualServers are the full paths of virtual servers
+	VirtualServers []string `json:"virtualServers,omitempty"`
+	// Members are the addresses of nodes in pools
+	Members []string `json:"members,omitempty"`
+}
+
+// F5ProviderPhase f5 virtual servers phase
+type F5ProviderPhase string
+
+const (
+	// F5RunningPhase means virtual servers are in sync
+	F5RunningPhase F5ProviderPhase = "Running"
+	// F5ErrorPhase means virtual servers fail to sync
+	F5ErrorPhase F5ProviderPhase = "Error"
+)
+
+// AzureProviderStatus represents the current status of the azure lb provider
+type AzureProviderStatus struct {
+	// Phase azure loadbalancer phase
+	Phase AzureProviderPhase `json:"phase"`
+	// Reason azure loadbalancer error reason
+	Reason string `json:"reason,omitempty"`
+	// Message azure lb create or update failed message
+	Message string `json:"message,omitempty"`
+	// ProvisioningState azure lb state
+	ProvisioningState string `json:"provisioningState,omitempty"`
+	// PublicIPAddress - The reference of the Public IP address.
+	PublicIPAddress *string `json:"publicIPAddress,omitempty"`
+	// RunningImages are the images of provider container in pods,
+	// there are more than one during rolling update
+	RunningImages []string `json:"runningImages,omitempty"`
+}
+
+// AzureProviderPhase azure loadbalancer phase
+type AzureProviderPhase string
+
+const (
+	// AzureProgressingPhase progressing phase
+	AzureProgressingPhase AzureProviderPhase = "Progressing"
+	// AzureRunningPhase running phase
+	AzureRunningPhase AzureProviderPhase = "Running"
+	// AzureErrorPhase error phase
+	AzureErrorPhase AzureProviderPhase = "Error"
+	// AzureUpdatingPhase update phase
+	AzureUpdatingPhase AzureProviderPhase = "Updating"
+)
+
+// PodStatuses represents the current statuses of a list of pods
+type PodStatuses struct {
+	Replicas      int32       `json:"replicas"`
+	TotalReplicas int32       `json:"totalReplicas"`
+	ReadyReplicas int32       `json:"readyReplicas"`
+	Statuses      []PodStatus `json:"podStatuses"`
+}
+
+// PodStatus represents the current status of pods
+type PodStatus struct {
+	Name            string `json:"name"`
+	Ready           bool   `json:"ready"`
+	RestartCount    int32  `json:"restartCount"`
+	ReadyContainers int32  `json:"readyContainers"`
+	TotalContainers int32  `json:"totalContainers"`
+	NodeName        string `json:"nodeName"`
+	Phase           string `json:"phase"`
+	Reason          string `json:"reason"`
+	Message         string `json:"message"`
+}
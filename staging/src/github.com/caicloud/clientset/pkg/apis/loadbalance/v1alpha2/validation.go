@@ -0,0 +1,673 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// VIPChecker checks whether a vip can be used by the LoadBalancer, such as
+// the vip is not allocated to another LoadBalancer by a VIPPool
+type VIPChecker func(vip string) error
+
+// ValidateLoadBalancer validate loadbalancer
+func ValidateLoadBalancer(lb *LoadBalancer, checkers ...VIPChecker) error {
+
+	// validate ipvsdr
+	err := ValidateProviders(lb.Spec.Providers, checkers...)
+	if err != nil {
+		return err
+	}
+	// validate proxy
+	err = ValidateProxy(lb.Spec.Proxy)
+	if err != nil {
+		return err
+	}
+	if lb.Spec.Proxy.Autoscaling != nil && len(lb.Spec.Nodes.Names) != 0 {
+		return fmt.Errorf("autoscaling can't be used with nodes names")
+	}
+	if lb.Spec.Proxy.Workload == WorkloadDaemonSet {
+		if len(lb.Spec.Nodes.Names) == 0 {
+			return fmt.Errorf("workload %v requires nodes names", WorkloadDaemonSet)
+		}
+		if lb.Spec.Proxy.Autoscaling != nil {
+			return fmt.Errorf("autoscaling can't be used with workload %v", WorkloadDaemonSet)
+		}
+	}
+	if lb.Spec.Providers.Ipvsdr != nil && lb.Spec.Providers.Ipvsdr.Workload == WorkloadDaemonSet && len(lb.Spec.Nodes.Names) == 0 {
+		return fmt.Errorf("ipvsdr: workload %v requires nodes names", WorkloadDaemonSet)
+	}
+	return nil
+}
+
+// ValidateKeepalived validate vips, scheduler, ha mode and bind of a keepalived group
+func ValidateKeepalived(spec KeepalivedProvider) error {
+	if spec.VIP != "" {
+		if err := ValidateVIP(spec.VIP); err != nil {
+			return fmt.Errorf("vip %v", err)
+		}
+	}
+	for i, vip := range spec.VIPs {
+		if err := ValidateVIP(vip); err != nil {
+			return fmt.Errorf("vips[%d] %v", i, err)
+		}
+	}
+	switch spec.VRRPVersion {
+	case 0, 3:
+	case 2:
+		for _, family := range VIPFamilies(KeepalivedVIPs(spec)) {
+			if family.Family == IPv6Family {
+				return fmt.Errorf("vrrpVersion 2 doesn't support ipv6 vips")
+			}
+		}
+	default:
+		return fmt.Errorf("vrrpVersion %v is invalid", spec.VRRPVersion)
+	}
+	switch spec.Scheduler {
+	case "":
+		// defaults to the scheduler of ipvsdr, which is required
+	case IpvsSchedulerRR:
+	case IpvsSchedulerWRR:
+	case IpvsSchedulerLC:
+	case IpvsSchedulerWLC:
+	case IpvsSchedulerLBLC:
+	case IpvsSchedulerDH:
+	case IpvsSchedulerSH:
+	default:
+		return fmt.Errorf("scheduler %v is invalid", spec.Scheduler)
+	}
+	switch spec.HAMode {
+	case "", ActiveActiveHA, ActivePassiveHA:
+	default:
+		return fmt.Errorf("haMode %v is invalid", spec.HAMode)
+	}
+	if spec.Bind != nil {
+		if spec.Bind.Iface != "" && spec.Bind.NodeIPAnnotation != "" {
+			return fmt.Errorf("bind iface and nodeIPAnnotation can't be set at the same time")
+		}
+		if strings.ContainsAny(spec.Bind.Iface, " /") || len(spec.Bind.Iface) > 15 {
+			return fmt.Errorf("bind iface %v is invalid", spec.Bind.Iface)
+		}
+	}
+	return nil
+}
+
+// ValidateVIP validates a vip of ipv4 or ipv6, link-local, loopback, multicast
+// and unspecified addresses can't be used as vips
+func ValidateVIP(vip string) error {
+	ip := net.ParseIP(vip)
+	switch {
+	case ip == nil:
+		return fmt.Errorf("%s is invalid", vip)
+	case ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast():
+		return fmt.Errorf("%s is a link-local address", vip)
+	case ip.IsLoopback() || ip.IsMulticast() || ip.IsUnspecified():
+		return fmt.Errorf("%s is not a unicast address", vip)
+	}
+	return nil
+}
+
+// IPFamilyOf returns the ip family of ip, empty if ip is invalid
+func IPFamilyOf(ip string) IPFamily {
+	parsed := net.ParseIP(ip)
+	switch {
+	case parsed == nil:
+		return ""
+	case parsed.To4() != nil:
+		return IPv4Family
+	}
+	return IPv6Family
+}
+
+// VIPFamilies groups vips by ip family, ipv4 is the first one, families
+// without vips are omitted
+func VIPFamilies(vips []string) []FamilyVIPs {
+	families := make([]FamilyVIPs, 0, 2)
+	for _, family := range []IPFamily{IPv4Family, IPv6Family} {
+		f := FamilyVIPs{Family: family}
+		for _, vip := range vips {
+			if IPFamilyOf(vip) == family {
+				f.VIPs = append(f.VIPs, vip)
+			}
+		}
+		if len(f.VIPs) > 0 {
+			families = append(families, f)
+		}
+	}
+	return families
+}
+
+// KeepalivedVIPs returns the distinct vips of a keepalived group, VIP is
+// kept for compatibility and it is the first one
+func KeepalivedVIPs(spec KeepalivedProvider) []string {
+	vips := make([]string, 0, len(spec.VIPs)+1)
+	seen := make(map[string]bool)
+	for _, vip := range append([]string{spec.VIP}, spec.VIPs...) {
+		if vip == "" || seen[vip] {
+			continue
+		}
+		seen[vip] = true
+		vips = append(vips, vip)
+	}
+	return vips
+}
+
+// ValidateAliyun validates the spec of aliyun provider
+func ValidateAliyun(spec AliyunProvider) error {
+	if spec.RegionID == "" {
+		return fmt.Errorf("region id can't be empty")
+	}
+	if spec.CredentialsSecret == "" {
+		return fmt.Errorf("credentials secret can't be empty")
+	}
+	switch spec.AddressType {
+	case "", AliyunInternetAddressType:
+	case AliyunIntranetAddressType:
+		if spec.VSwitchID == "" && spec.LoadBalancerID == "" {
+			return fmt.Errorf("vswitch id can't be empty when address type is intranet")
+		}
+	default:
+		return fmt.Errorf("address type %v is invalid", spec.AddressType)
+	}
+	if spec.Image != "" && spec.Version != "" {
+		return fmt.Errorf("image and version can't be set at the same time")
+	}
+	return ValidatePodTemplate(spec.PodTemplate)
+}
+
+// ValidateAWS validates the spec of aws provider
+func ValidateAWS(spec AWSProvider) error {
+	if spec.Region == "" {
+		return fmt.Errorf("region can't be empty")
+	}
+	if spec.CredentialsSecret == "" {
+		return fmt.Errorf("credentials secret can't be empty")
+	}
+	if len(spec.Name) > 32 {
+		return fmt.Errorf("name %v is longer than 32 characters", spec.Name)
+	}
+	if len(spec.Subnets) == 0 {
+		return fmt.Errorf("subnets is empty")
+	}
+	switch spec.Scheme {
+	case "", AWSInternetFacingScheme:
+	case AWSInternalScheme:
+		if len(spec.EIPAllocations) > 0 {
+			return fmt.Errorf("eip allocations can't be used by internal scheme")
+		}
+	default:
+		return fmt.Errorf("scheme %v is invalid", spec.Scheme)
+	}
+	if len(spec.EIPAllocations) > 0 && len(spec.EIPAllocations) != len(spec.Subnets) {
+		return fmt.Errorf("eip allocations must be one per subnet")
+	}
+	return nil
+}
+
+// ValidateOpenStack validates the spec of openstack provider
+func ValidateOpenStack(spec OpenStackProvider) error {
+	if spec.CredentialsSecret == "" {
+		return fmt.Errorf("credentials secret can't be empty")
+	}
+	if spec.SubnetID == "" {
+		return fmt.Errorf("subnet id can't be empty")
+	}
+	if spec.VIPAddress != "" && net.ParseIP(spec.VIPAddress) == nil {
+		return fmt.Errorf("vip address %v is invalid", spec.VIPAddress)
+	}
+	return nil
+}
+
+// ValidateF5 validates the spec of f5 provider
+func ValidateF5(spec F5Provider) error {
+	if spec.Host == "" {
+		return fmt.Errorf("host can't be empty")
+	}
+	if spec.CredentialsSecret == "" {
+		return fmt.Errorf("credentials secret can't be empty")
+	}
+	if net.ParseIP(spec.VIPAddress) == nil {
+		return fmt.Errorf("vip address %q is invalid", spec.VIPAddress)
+	}
+	if strings.Contains(spec.Partition, "/") {
+		return fmt.Errorf("partition %v can't contain /", spec.Partition)
+	}
+	return nil
+}
+
+// ValidateBGP validate vips, asn, peers and communities of bgp provider
+func ValidateBGP(spec BGPProvider, checkers ...VIPChecker) error {
+	if len(spec.VIPs) == 0 {
+		return fmt.Errorf("vips is empty")
+	}
+	for i, vip := range spec.VIPs {
+		if err := ValidateVIP(vip); err != nil {
+			return fmt.Errorf("vips[%d] %v", i, err)
+		}
+	}
+	if err := checkVIPs("", spec.VIPs, checkers); err != nil {
+		return err
+	}
+	if spec.ASN == 0 {
+		return fmt.Errorf("asn can't be 0")
+	}
+	if len(spec.Peers) == 0 {
+		return fmt.Errorf("peers is empty")
+	}
+	for i, peer := range spec.Peers {
+		if net.ParseIP(peer.Address) == nil {
+			return fmt.Errorf("peers[%d] address %v is invalid", i, peer.Address)
+		}
+		if peer.ASN == 0 {
+			return fmt.Errorf("peers[%d] asn can't be 0", i)
+		}
+		if peer.Port < 0 || peer.Port > 65535 {
+			return fmt.Errorf("peers[%d] port %v is invalid", i, peer.Port)
+		}
+	}
+	for i, community := range spec.Communities {
+		parts := strings.Split(community, ":")
+		if len(parts) != 2 {
+			return fmt.Errorf("communities[%d] %v is not in the format of AA:NN", i, community)
+		}
+		for _, part := range parts {
+			if n, err := strconv.ParseUint(part, 10, 16); err != nil || n > 65535 {
+				return fmt.Errorf("communities[%d] %v is not in the format of AA:NN", i, community)
+			}
+		}
+	}
+	if spec.Image != "" && spec.Version != "" {
+		return fmt.Errorf("image and version can't be set at the same time")
+	}
+	return ValidatePodTemplate(spec.PodTemplate)
+}
+
+// ValidateWorkload validate kind of workload
+func ValidateWorkload(kind WorkloadKind) error {
+	switch kind {
+	case "", WorkloadDeployment, WorkloadDaemonSet:
+		return nil
+	}
+	return fmt.Errorf("unknown workload %v", kind)
+}
+
+// ValidateProviders validate providers spec in loadbalancer, vips are checked
+// by checkers
+func ValidateProviders(spec ProvidersSpec, checkers ...VIPChecker) error {
+	if spec.Ipvsdr != nil {
+		ipvsdr := spec.Ipvsdr
+		if err := ValidateKeepalived(ipvsdr.KeepalivedProvider); err != nil {
+			return fmt.Errorf("ipvsdr: %v", err)
+		}
+		if ipvsdr.VIP == "" && len(ipvsdr.VIPs) == 0 && ipvsdr.PoolRef == nil {
+			return fmt.Errorf("ipvsdr: vips is empty")
+		}
+		if ipvsdr.PoolRef != nil && ipvsdr.PoolRef.Name == "" {
+			return fmt.Errorf("ipvsdr: poolRef name is empty")
+		}
+		if err := checkVIPs(ipvsdr.VIP, ipvsdr.VIPs, checkers); err != nil {
+			return fmt.Errorf("ipvsdr: %v", err)
+		}
+		if ipvsdr.Scheduler == "" {
+			return fmt.Errorf("ipvsdr: scheduler is empty")
+		}
+		vips := make(map[string]bool)
+		for _, vip := range KeepalivedVIPs(ipvsdr.KeepalivedProvider) {
+			vips[vip] = true
+		}
+		for i, slave := range ipvsdr.Slaves {
+			if err := ValidateKeepalived(slave); err != nil {
+				return fmt.Errorf("ipvsdr: slaves[%d] %v", i, err)
+			}
+			if len(KeepalivedVIPs(slave)) == 0 {
+				return fmt.Errorf("ipvsdr: slaves[%d] vips is empty", i)
+			}
+			if err := checkVIPs(slave.VIP, slave.VIPs, checkers); err != nil {
+				return fmt.Errorf("ipvsdr: slaves[%d] %v", i, err)
+			}
+			for _, vip := range KeepalivedVIPs(slave) {
+				if vips[vip] {
+					return fmt.Errorf("ipvsdr: slaves[%d] vip %v is used by another group", i, vip)
+				}
+				vips[vip] = true
+			}
+		}
+		if ipvsdr.Vrid != nil && (*ipvsdr.Vrid < 1 || *ipvsdr.Vrid > 255) {
+			return fmt.Errorf("ipvsdr: vrid %d is not in range [1, 255]", *ipvsdr.Vrid)
+		}
+		if ipvsdr.Image != "" && ipvsdr.Version != "" {
+			return fmt.Errorf("ipvsdr: image and version can't be set at the same time")
+		}
+		if err := ValidatePodTemplate(ipvsdr.PodTemplate); err != nil {
+			return fmt.Errorf("ipvsdr: %v", err)
+		}
+		if err := ValidateWorkload(ipvsdr.Workload); err != nil {
+			return fmt.Errorf("ipvsdr: %v", err)
+		}
+	}
+	if spec.External != nil {
+		external := spec.External
+		if external.VIP != "" {
+			if err := ValidateVIP(external.VIP); err != nil {
+				return fmt.Errorf("external: vip %v", err)
+			}
+		}
+		for i, vip := range external.VIPs {
+			if err := ValidateVIP(vip); err != nil {
+				return fmt.Errorf("external: vips[%d] %v", i, err)
+			}
+		}
+		if external.VIP == "" && len(external.VIPs) == 0 && external.PoolRef == nil {
+			return fmt.Errorf("external: vips is empty")
+		}
+		if external.PoolRef != nil && external.PoolRef.Name == "" {
+			return fmt.Errorf("external: poolRef name is empty")
+		}
+		if err := checkVIPs(external.VIP, external.VIPs, checkers); err != nil {
+			return fmt.Errorf("external: %v", err)
+		}
+	}
+	if spec.BGP != nil {
+		if err := ValidateBGP(*spec.BGP, checkers...); err != nil {
+			return fmt.Errorf("bgp: %v", err)
+		}
+	}
+	if spec.Aliyun != nil {
+		if err := ValidateAliyun(*spec.Aliyun); err != nil {
+			return fmt.Errorf("aliyun: %v", err)
+		}
+	}
+	if spec.AWS != nil {
+		if err := ValidateAWS(*spec.AWS); err != nil {
+			return fmt.Errorf("aws: %v", err)
+		}
+	}
+	if spec.OpenStack != nil {
+		if err := ValidateOpenStack(*spec.OpenStack); err != nil {
+			return fmt.Errorf("openstack: %v", err)
+		}
+	}
+	if spec.F5 != nil {
+		if err := ValidateF5(*spec.F5); err != nil {
+			return fmt.Errorf("f5: %v", err)
+		}
+	}
+	if spec.Azure != nil {
+		azure := spec.Azure
+		if len(azure.Location) == 0 {
+			return fmt.Errorf("azure: location cant't be empty")
+		}
+		if len(azure.ResourceGroupName) == 0 {
+			return fmt.Errorf("azure: group name cant't be empty")
+		}
+		if len(azure.ClusterID) == 0 {
+			return fmt.Errorf("azure: cluster id cant't be empty")
+		}
+		if azure.Image != "" && azure.Version != "" {
+			return fmt.Errorf("azure: image and version can't be set at the same time")
+		}
+		if err := ValidatePodTemplate(azure.PodTemplate); err != nil {
+			return fmt.Errorf("azure: %v", err)
+		}
+		if azure.SKU != AzureStandardSKU && azure.SKU != AzureBasicSKU {
+			return fmt.Errorf("azure: sku %v is invalid", azure.SKU)
+		}
+		if azure.IPAddressProperties.Public == nil && azure.IPAddressProperties.Private == nil {
+			return fmt.Errorf("azure: private and public ip address can't be nil at the same time")
+		}
+		if azure.IPAddressProperties.Public != nil && azure.IPAddressProperties.Private != nil {
+			return fmt.Errorf("azure: private and public ip address can't have value at the same time")
+		}
+		if azure.IPAddressProperties.Private != nil {
+			private := azure.IPAddressProperties.Private
+			if len(private.SubnetID) == 0 {
+				return fmt.Errorf("azure: subnet id cant't be empty when use private network")
+			}
+			switch private.IPAllocationMethod {
+			case AzureStaticIPAllocationMethod:
+				if private.PrivateIPAddress == nil {
+					return fmt.Errorf("azure: private ip address can't be nil when allocation method is static")
+				}
+				if net.ParseIP(*private.PrivateIPAddress) == nil {
+					return fmt.Errorf("azure: private ip is invalid")
+				}
+			//TODO don't support
+			case AzureDynamicIPAllocationMethod:
+			default:
+				return fmt.Errorf("azure: private allocation method %v is invalid", private.IPAllocationMethod)
+			}
+		}
+		if azure.IPAddressProperties.Public != nil {
+			public := azure.IPAddressProperties.Public
+			switch public.IPAllocationMethod {
+			case AzureStaticIPAllocationMethod:
+				if public.PublicIPAddressID == nil {
+					return fmt.Errorf("azure: public ip address can't be nil when allocation method is static")
+				}
+			//TODO don't support
+			case AzureDynamicIPAllocationMethod:
+			default:
+				return fmt.Errorf("azure: public allocation method %v is invalid", public.IPAllocationMethod)
+			}
+		}
+	}
+	return nil
+}
+
+func checkVIPs(vip string, vips []string, checkers []VIPChecker) error {
+	if vip != "" {
+		vips = append([]string{vip}, vips...)
+	}
+	for _, vip := range vips {
+		for _, check := range checkers {
+			if err := check(vip); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateProxy validate proxy spec in loadbalancer
+func ValidateProxy(spec ProxySpec) error {
+	switch spec.Type {
+	case ProxyTypeNginx:
+	case ProxyTypeHaproxy:
+	case ProxyTypeTraefik:
+	default:
+		return fmt.Errorf("unknown proxy type %v", spec.Type)
+	}
+	switch spec.IngressDeletionPolicy {
+	case "", IngressDeletionPolicyDelete, IngressDeletionPolicyOrphan:
+	case IngressDeletionPolicyReassign:
+		if spec.IngressFallback == nil || spec.IngressFallback.Namespace == "" || spec.IngressFallback.Name == "" {
+			return fmt.Errorf("ingressFallback is required when ingressDeletionPolicy is %v", spec.IngressDeletionPolicy)
+		}
+	default:
+		return fmt.Errorf("unknown ingressDeletionPolicy %v", spec.IngressDeletionPolicy)
+	}
+	if spec.Type == ProxyTypeNginx {
+		if err := ValidateNginxConfigValues(spec.Config); err != nil {
+			return err
+		}
+	}
+	if spec.Image != "" && spec.Version != "" {
+		return fmt.Errorf("image and version can't be set at the same time")
+	}
+	if err := ValidatePodTemplate(spec.PodTemplate); err != nil {
+		return err
+	}
+	if err := ValidateWorkload(spec.Workload); err != nil {
+		return err
+	}
+	if spec.TLS != nil {
+		if err := ValidateProxyTLS(*spec.TLS); err != nil {
+			return err
+		}
+	}
+	if spec.Autoscaling != nil {
+		err := ValidateAutoscaling(*spec.Autoscaling)
+		if err != nil {
+			return err
+		}
+	}
+	return ValidateStreams(spec)
+}
+
+// ValidateNginxConfigValues rejects the invalid values of known keys in nginx config,
+// unknown keys are allowed since they may be supported by other ingress-nginx versions
+func ValidateNginxConfigValues(config map[string]string) error {
+	invalid := make([]string, 0)
+	for _, issue := range ValidateNginxConfig(config) {
+		if issue.Severity == ConfigIssueError {
+			invalid = append(invalid, fmt.Sprintf("%v: %v", issue.Key, issue.Reason))
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("invalid config %v", strings.Join(invalid, "; "))
+	}
+	return nil
+}
+
+// ValidateProxyTLS validate tls in proxy spec
+func ValidateProxyTLS(spec ProxyTLSSpec) error {
+	if spec.IssuerRef == nil {
+		if spec.SecretName == "" {
+			return fmt.Errorf("tls: one of secretName and issuerRef is required")
+		}
+		if len(spec.DNSNames) != 0 {
+			return fmt.Errorf("tls: dnsNames can only be used with issuerRef")
+		}
+		return nil
+	}
+	if spec.IssuerRef.Name == "" {
+		return fmt.Errorf("tls: issuerRef name is empty")
+	}
+	switch spec.IssuerRef.Kind {
+	case "", "Issuer", "ClusterIssuer":
+	default:
+		return fmt.Errorf("tls: unknown issuerRef kind %v", spec.IssuerRef.Kind)
+	}
+	if len(spec.DNSNames) == 0 {
+		return fmt.Errorf("tls: dnsNames is required when issuerRef is set")
+	}
+	return nil
+}
+
+// ValidateAutoscaling validate autoscaling in proxy spec
+func ValidateAutoscaling(spec AutoscalingSpec) error {
+	minReplicas := int32(1)
+	if spec.MinReplicas != nil {
+		minReplicas = *spec.MinReplicas
+	}
+	if minReplicas < 1 {
+		return fmt.Errorf("autoscaling: minReplicas %d is invalid", minReplicas)
+	}
+	if spec.MaxReplicas < minReplicas {
+		return fmt.Errorf("autoscaling: maxReplicas %d is less than minReplicas %d", spec.MaxReplicas, minReplicas)
+	}
+	if spec.TargetCPUUtilizationPercentage != nil && *spec.TargetCPUUtilizationPercentage <= 0 {
+		return fmt.Errorf("autoscaling: targetCPUUtilizationPercentage %d is invalid", *spec.TargetCPUUtilizationPercentage)
+	}
+	for i, metric := range spec.Metrics {
+		if metric.Name == "" {
+			return fmt.Errorf("autoscaling: metrics[%d] name can't be empty", i)
+		}
+		if metric.TargetAverageValue.Sign() <= 0 {
+			return fmt.Errorf("autoscaling: metrics[%d] targetAverageValue %s is invalid", i, metric.TargetAverageValue.String())
+		}
+	}
+	return nil
+}
+
+// ValidateStreams validate streams in proxy spec
+func ValidateStreams(spec ProxySpec) error {
+	httpPort, httpsPort := spec.HTTPPort, spec.HTTPSPort
+	if httpPort <= 0 {
+		httpPort = DefaultHTTPPort
+	}
+	if httpsPort <= 0 {
+		httpsPort = DefaultHTTPSPort
+	}
+	portRanges := spec.PortRanges
+	if len(portRanges) == 0 {
+		portRanges = DefaultPortRanges
+	}
+
+	seen := make(map[string]bool)
+	for i, stream := range spec.Streams {
+		protocol := stream.Protocol
+		if protocol == "" {
+			protocol = v1.ProtocolTCP
+		}
+		if protocol != v1.ProtocolTCP && protocol != v1.ProtocolUDP {
+			return fmt.Errorf("streams[%d]: protocol %v is invalid", i, stream.Protocol)
+		}
+		if int(stream.Port) == httpPort || int(stream.Port) == httpsPort {
+			return fmt.Errorf("streams[%d]: port %d conflicts with http or https port", i, stream.Port)
+		}
+		if !PortInRanges(stream.Port, portRanges) {
+			return fmt.Errorf("streams[%d]: port %d is out of port ranges %v", i, stream.Port, portRanges)
+		}
+		key := fmt.Sprintf("%s/%d", protocol, stream.Port)
+		if seen[key] {
+			return fmt.Errorf("streams[%d]: %s port %d is duplicated", i, protocol, stream.Port)
+		}
+		seen[key] = true
+
+		if stream.Service.Namespace == "" || stream.Service.Name == "" {
+			return fmt.Errorf("streams[%d]: service namespace and name can't be empty", i)
+		}
+		if stream.Service.Port <= 0 || stream.Service.Port > 65535 {
+			return fmt.Errorf("streams[%d]: service port %d is invalid", i, stream.Service.Port)
+		}
+	}
+	return nil
+}
+
+// ValidatePodTemplate validate the pod template overlay, fields owned by controller
+// can't be overridden
+func ValidatePodTemplate(template *v1.PodTemplateSpec) error {
+	if template == nil {
+		return nil
+	}
+	meta := template.ObjectMeta
+	if meta.Name != "" || meta.GenerateName != "" || meta.Namespace != "" {
+		return fmt.Errorf("podTemplate: metadata name and namespace can't be set")
+	}
+	for key := range meta.Labels {
+		if strings.HasPrefix(key, GroupName+"/") {
+			return fmt.Errorf("podTemplate: label %s is owned by controller", key)
+		}
+	}
+	spec := template.Spec
+	switch {
+	case spec.HostNetwork:
+		return fmt.Errorf("podTemplate: hostNetwork is owned by controller")
+	case spec.DNSPolicy != "":
+		return fmt.Errorf("podTemplate: dnsPolicy is owned by controller")
+	case spec.Affinity != nil:
+		return fmt.Errorf("podTemplate: affinity is owned by controller")
+	case spec.NodeName != "":
+		return fmt.Errorf("podTemplate: nodeName is owned by controller")
+	}
+	for i, c := range spec.Containers {
+		if c.Name == "" {
+			return fmt.Errorf("podTemplate: containers[%d] name can't be empty", i)
+		}
+	}
+	return nil
+}
+
+// PortInRanges checks if the port is in one of the port ranges
+func PortInRanges(port int32, portRanges []PortRange) bool {
+	for _, r := range portRanges {
+		if port >= r.Start && port <= r.End {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,1479 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/api/core/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSProvider) DeepCopyInto(out *AWSProvider) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EIPAllocations != nil {
+		in, out := &in.EIPAllocations, &out.EIPAllocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSProvider.
+func (in *AWSProvider) DeepCopy() *AWSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSProviderStatus) DeepCopyInto(out *AWSProviderStatus) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetGroups != nil {
+		in, out := &in.TargetGroups, &out.TargetGroups
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSProviderStatus.
+func (in *AWSProviderStatus) DeepCopy() *AWSProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunProvider) DeepCopyInto(out *AliyunProvider) {
+	*out = *in
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunProvider.
+func (in *AliyunProvider) DeepCopy() *AliyunProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunProviderStatus) DeepCopyInto(out *AliyunProviderStatus) {
+	*out = *in
+	if in.BackendServers != nil {
+		in, out := &in.BackendServers, &out.BackendServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunningImages != nil {
+		in, out := &in.RunningImages, &out.RunningImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunProviderStatus.
+func (in *AliyunProviderStatus) DeepCopy() *AliyunProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingMetric) DeepCopyInto(out *AutoscalingMetric) {
+	*out = *in
+	out.TargetAverageValue = in.TargetAverageValue.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingMetric.
+func (in *AutoscalingMetric) DeepCopy() *AutoscalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingSpec) DeepCopyInto(out *AutoscalingSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]AutoscalingMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingSpec.
+func (in *AutoscalingSpec) DeepCopy() *AutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingStatus) DeepCopyInto(out *AutoscalingStatus) {
+	*out = *in
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingStatus.
+func (in *AutoscalingStatus) DeepCopy() *AutoscalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureIPAddressProperties) DeepCopyInto(out *AzureIPAddressProperties) {
+	*out = *in
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = new(AzurePrivateIPAddressProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Public != nil {
+		in, out := &in.Public, &out.Public
+		*out = new(AzurePublicIPAddressProperties)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureIPAddressProperties.
+func (in *AzureIPAddressProperties) DeepCopy() *AzureIPAddressProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureIPAddressProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePrivateIPAddressProperties) DeepCopyInto(out *AzurePrivateIPAddressProperties) {
+	*out = *in
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePrivateIPAddressProperties.
+func (in *AzurePrivateIPAddressProperties) DeepCopy() *AzurePrivateIPAddressProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePrivateIPAddressProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureProvider) DeepCopyInto(out *AzureProvider) {
+	*out = *in
+	if in.ReserveAzure != nil {
+		in, out := &in.ReserveAzure, &out.ReserveAzure
+		*out = new(bool)
+		**out = **in
+	}
+	in.IPAddressProperties.DeepCopyInto(&out.IPAddressProperties)
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureProvider.
+func (in *AzureProvider) DeepCopy() *AzureProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureProviderStatus) DeepCopyInto(out *AzureProviderStatus) {
+	*out = *in
+	if in.PublicIPAddress != nil {
+		in, out := &in.PublicIPAddress, &out.PublicIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.RunningImages != nil {
+		in, out := &in.RunningImages, &out.RunningImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureProviderStatus.
+func (in *AzureProviderStatus) DeepCopy() *AzureProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePublicIPAddressProperties) DeepCopyInto(out *AzurePublicIPAddressProperties) {
+	*out = *in
+	if in.PublicIPAddressID != nil {
+		in, out := &in.PublicIPAddressID, &out.PublicIPAddressID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePublicIPAddressProperties.
+func (in *AzurePublicIPAddressProperties) DeepCopy() *AzurePublicIPAddressProperties {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePublicIPAddressProperties)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeer) DeepCopyInto(out *BGPPeer) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeer.
+func (in *BGPPeer) DeepCopy() *BGPPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerStatus) DeepCopyInto(out *BGPPeerStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerStatus.
+func (in *BGPPeerStatus) DeepCopy() *BGPPeerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPProvider) DeepCopyInto(out *BGPProvider) {
+	*out = *in
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]BGPPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPProvider.
+func (in *BGPProvider) DeepCopy() *BGPProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPProviderStatus) DeepCopyInto(out *BGPProviderStatus) {
+	*out = *in
+	in.PodStatuses.DeepCopyInto(&out.PodStatuses)
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AnnouncingNodes != nil {
+		in, out := &in.AnnouncingNodes, &out.AnnouncingNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]BGPPeerStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPProviderStatus.
+func (in *BGPProviderStatus) DeepCopy() *BGPProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigIssue) DeepCopyInto(out *ConfigIssue) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigIssue.
+func (in *ConfigIssue) DeepCopy() *ConfigIssue {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigIssue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigProvenance) DeepCopyInto(out *ConfigProvenance) {
+	*out = *in
+	if in.Layers != nil {
+		in, out := &in.Layers, &out.Layers
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ShadowedSpecKeys != nil {
+		in, out := &in.ShadowedSpecKeys, &out.ShadowedSpecKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigProvenance.
+func (in *ConfigProvenance) DeepCopy() *ConfigProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisruptionSpec) DeepCopyInto(out *DisruptionSpec) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisruptionSpec.
+func (in *DisruptionSpec) DeepCopy() *DisruptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DisruptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExpternalProviderStatus) DeepCopyInto(out *ExpternalProviderStatus) {
+	*out = *in
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Families != nil {
+		in, out := &in.Families, &out.Families
+		*out = make([]FamilyVIPs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExpternalProviderStatus.
+func (in *ExpternalProviderStatus) DeepCopy() *ExpternalProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExpternalProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalProvider) DeepCopyInto(out *ExternalProvider) {
+	*out = *in
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PoolRef != nil {
+		in, out := &in.PoolRef, &out.PoolRef
+		*out = new(VIPPoolReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalProvider.
+func (in *ExternalProvider) DeepCopy() *ExternalProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *F5Provider) DeepCopyInto(out *F5Provider) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new F5Provider.
+func (in *F5Provider) DeepCopy() *F5Provider {
+	if in == nil {
+		return nil
+	}
+	out := new(F5Provider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *F5ProviderStatus) DeepCopyInto(out *F5ProviderStatus) {
+	*out = *in
+	if in.VirtualServers != nil {
+		in, out := &in.VirtualServers, &out.VirtualServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new F5ProviderStatus.
+func (in *F5ProviderStatus) DeepCopy() *F5ProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(F5ProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FamilyVIPs) DeepCopyInto(out *FamilyVIPs) {
+	*out = *in
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FamilyVIPs.
+func (in *FamilyVIPs) DeepCopy() *FamilyVIPs {
+	if in == nil {
+		return nil
+	}
+	out := new(FamilyVIPs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceNet) DeepCopyInto(out *InterfaceNet) {
+	*out = *in
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceNet.
+func (in *InterfaceNet) DeepCopy() *InterfaceNet {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceNet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IpvsdrProvider) DeepCopyInto(out *IpvsdrProvider) {
+	*out = *in
+	in.KeepalivedProvider.DeepCopyInto(&out.KeepalivedProvider)
+	if in.Slaves != nil {
+		in, out := &in.Slaves, &out.Slaves
+		*out = make([]KeepalivedProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Vrid != nil {
+		in, out := &in.Vrid, &out.Vrid
+		*out = new(int)
+		**out = **in
+	}
+	if in.PoolRef != nil {
+		in, out := &in.PoolRef, &out.PoolRef
+		*out = new(VIPPoolReference)
+		**out = **in
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IpvsdrProvider.
+func (in *IpvsdrProvider) DeepCopy() *IpvsdrProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(IpvsdrProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IpvsdrProviderStatus) DeepCopyInto(out *IpvsdrProviderStatus) {
+	*out = *in
+	in.PodStatuses.DeepCopyInto(&out.PodStatuses)
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Vrid != nil {
+		in, out := &in.Vrid, &out.Vrid
+		*out = new(int)
+		**out = **in
+	}
+	if in.Fwmark != nil {
+		in, out := &in.Fwmark, &out.Fwmark
+		*out = new(int)
+		**out = **in
+	}
+	if in.Families != nil {
+		in, out := &in.Families, &out.Families
+		*out = make([]FamilyVIPs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RunningImages != nil {
+		in, out := &in.RunningImages, &out.RunningImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Slaves != nil {
+		in, out := &in.Slaves, &out.Slaves
+		*out = make([]KeepalivedStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IpvsdrProviderStatus.
+func (in *IpvsdrProviderStatus) DeepCopy() *IpvsdrProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IpvsdrProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerReference) DeepCopyInto(out *IssuerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerReference.
+func (in *IssuerReference) DeepCopy() *IssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeepalivedBind) DeepCopyInto(out *KeepalivedBind) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeepalivedBind.
+func (in *KeepalivedBind) DeepCopy() *KeepalivedBind {
+	if in == nil {
+		return nil
+	}
+	out := new(KeepalivedBind)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeepalivedProvider) DeepCopyInto(out *KeepalivedProvider) {
+	*out = *in
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bind != nil {
+		in, out := &in.Bind, &out.Bind
+		*out = new(KeepalivedBind)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeepalivedProvider.
+func (in *KeepalivedProvider) DeepCopy() *KeepalivedProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(KeepalivedProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeepalivedStatus) DeepCopyInto(out *KeepalivedStatus) {
+	*out = *in
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Vrid != nil {
+		in, out := &in.Vrid, &out.Vrid
+		*out = new(int)
+		**out = **in
+	}
+	if in.Fwmark != nil {
+		in, out := &in.Fwmark, &out.Fwmark
+		*out = new(int)
+		**out = **in
+	}
+	if in.Families != nil {
+		in, out := &in.Families, &out.Families
+		*out = make([]FamilyVIPs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeepalivedStatus.
+func (in *KeepalivedStatus) DeepCopy() *KeepalivedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeepalivedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
+func (in *LoadBalancer) DeepCopy() *LoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerList) DeepCopyInto(out *LoadBalancerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerList.
+func (in *LoadBalancerList) DeepCopy() *LoadBalancerList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerReference) DeepCopyInto(out *LoadBalancerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerReference.
+func (in *LoadBalancerReference) DeepCopy() *LoadBalancerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	in.Nodes.DeepCopyInto(&out.Nodes)
+	in.Proxy.DeepCopyInto(&out.Proxy)
+	in.Providers.DeepCopyInto(&out.Providers)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
+	*out = *in
+	if in.AccessIPs != nil {
+		in, out := &in.AccessIPs, &out.AccessIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeIPs != nil {
+		in, out := &in.NodeIPs, &out.NodeIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ProxyStatus.DeepCopyInto(&out.ProxyStatus)
+	in.ProvidersStatuses.DeepCopyInto(&out.ProvidersStatuses)
+	in.NodeStatuses.DeepCopyInto(&out.NodeStatuses)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStatus.
+func (in *LoadBalancerStatus) DeepCopy() *LoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
+	*out = *in
+	if in.IfaceNetList != nil {
+		in, out := &in.IfaceNetList, &out.IfaceNetList
+		*out = make([]*InterfaceNet, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InterfaceNet)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatus.
+func (in *NodeStatus) DeepCopy() *NodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeStatuses) DeepCopyInto(out *NodeStatuses) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeStatuses.
+func (in *NodeStatuses) DeepCopy() *NodeStatuses {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeStatuses)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodesSpec) DeepCopyInto(out *NodesSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Effect != nil {
+		in, out := &in.Effect, &out.Effect
+		*out = new(v1.TaintEffect)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodesSpec.
+func (in *NodesSpec) DeepCopy() *NodesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackProvider) DeepCopyInto(out *OpenStackProvider) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackProvider.
+func (in *OpenStackProvider) DeepCopy() *OpenStackProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackProviderStatus) DeepCopyInto(out *OpenStackProviderStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackProviderStatus.
+func (in *OpenStackProviderStatus) DeepCopy() *OpenStackProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodStatus) DeepCopyInto(out *PodStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStatus.
+func (in *PodStatus) DeepCopy() *PodStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodStatuses) DeepCopyInto(out *PodStatuses) {
+	*out = *in
+	if in.Statuses != nil {
+		in, out := &in.Statuses, &out.Statuses
+		*out = make([]PodStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodStatuses.
+func (in *PodStatuses) DeepCopy() *PodStatuses {
+	if in == nil {
+		return nil
+	}
+	out := new(PodStatuses)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortRange) DeepCopyInto(out *PortRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortRange.
+func (in *PortRange) DeepCopy() *PortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortViolation) DeepCopyInto(out *PortViolation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortViolation.
+func (in *PortViolation) DeepCopy() *PortViolation {
+	if in == nil {
+		return nil
+	}
+	out := new(PortViolation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvidersSpec) DeepCopyInto(out *ProvidersSpec) {
+	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ipvsdr != nil {
+		in, out := &in.Ipvsdr, &out.Ipvsdr
+		*out = new(IpvsdrProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aliyun != nil {
+		in, out := &in.Aliyun, &out.Aliyun
+		*out = new(AliyunProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(BGPProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenStack != nil {
+		in, out := &in.OpenStack, &out.OpenStack
+		*out = new(OpenStackProvider)
+		**out = **in
+	}
+	if in.F5 != nil {
+		in, out := &in.F5, &out.F5
+		*out = new(F5Provider)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvidersSpec.
+func (in *ProvidersSpec) DeepCopy() *ProvidersSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvidersSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvidersStatuses) DeepCopyInto(out *ProvidersStatuses) {
+	*out = *in
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExpternalProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ipvsdr != nil {
+		in, out := &in.Ipvsdr, &out.Ipvsdr
+		*out = new(IpvsdrProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aliyun != nil {
+		in, out := &in.Aliyun, &out.Aliyun
+		*out = new(AliyunProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BGP != nil {
+		in, out := &in.BGP, &out.BGP
+		*out = new(BGPProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWS != nil {
+		in, out := &in.AWS, &out.AWS
+		*out = new(AWSProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenStack != nil {
+		in, out := &in.OpenStack, &out.OpenStack
+		*out = new(OpenStackProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.F5 != nil {
+		in, out := &in.F5, &out.F5
+		*out = new(F5ProviderStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvidersStatuses.
+func (in *ProvidersStatuses) DeepCopy() *ProvidersStatuses {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvidersStatuses)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxySpec) DeepCopyInto(out *ProxySpec) {
+	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.PortRanges != nil {
+		in, out := &in.PortRanges, &out.PortRanges
+		*out = make([]PortRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]StreamSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IngressFallback != nil {
+		in, out := &in.IngressFallback, &out.IngressFallback
+		*out = new(LoadBalancerReference)
+		**out = **in
+	}
+	if in.Disruption != nil {
+		in, out := &in.Disruption, &out.Disruption
+		*out = new(DisruptionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodTemplate != nil {
+		in, out := &in.PodTemplate, &out.PodTemplate
+		*out = new(v1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ProxyTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxySpec.
+func (in *ProxySpec) DeepCopy() *ProxySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyStatus) DeepCopyInto(out *ProxyStatus) {
+	*out = *in
+	in.PodStatuses.DeepCopyInto(&out.PodStatuses)
+	if in.Streams != nil {
+		in, out := &in.Streams, &out.Streams
+		*out = make([]StreamStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.PortViolations != nil {
+		in, out := &in.PortViolations, &out.PortViolations
+		*out = make([]PortViolation, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigIssues != nil {
+		in, out := &in.ConfigIssues, &out.ConfigIssues
+		*out = make([]ConfigIssue, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigProvenance != nil {
+		in, out := &in.ConfigProvenance, &out.ConfigProvenance
+		*out = new(ConfigProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RunningImages != nil {
+		in, out := &in.RunningImages, &out.RunningImages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(ProxyTLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyStatus.
+func (in *ProxyStatus) DeepCopy() *ProxyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyTLSSpec) DeepCopyInto(out *ProxyTLSSpec) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(IssuerReference)
+		**out = **in
+	}
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyTLSSpec.
+func (in *ProxyTLSSpec) DeepCopy() *ProxyTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyTLSStatus) DeepCopyInto(out *ProxyTLSStatus) {
+	*out = *in
+	if in.NotAfter != nil {
+		in, out := &in.NotAfter, &out.NotAfter
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyTLSStatus.
+func (in *ProxyTLSStatus) DeepCopy() *ProxyTLSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyTLSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamProxyProtocol) DeepCopyInto(out *StreamProxyProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamProxyProtocol.
+func (in *StreamProxyProtocol) DeepCopy() *StreamProxyProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamProxyProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamServiceReference) DeepCopyInto(out *StreamServiceReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamServiceReference.
+func (in *StreamServiceReference) DeepCopy() *StreamServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamSpec) DeepCopyInto(out *StreamSpec) {
+	*out = *in
+	out.Service = in.Service
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(StreamProxyProtocol)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamSpec.
+func (in *StreamSpec) DeepCopy() *StreamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamStatus) DeepCopyInto(out *StreamStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamStatus.
+func (in *StreamStatus) DeepCopy() *StreamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VIPPoolReference) DeepCopyInto(out *VIPPoolReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VIPPoolReference.
+func (in *VIPPoolReference) DeepCopy() *VIPPoolReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VIPPoolReference)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,248 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorSettings) DeepCopyInto(out *CollectorSettings) {
+	*out = *in
+	if in.Filebeat != nil {
+		in, out := &in.Filebeat, &out.Filebeat
+		*out = new(FilebeatSetting)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorSettings.
+func (in *CollectorSettings) DeepCopy() *CollectorSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchEndpoint) DeepCopyInto(out *ElasticsearchEndpoint) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchEndpoint.
+func (in *ElasticsearchEndpoint) DeepCopy() *ElasticsearchEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilebeatMultilineSetting) DeepCopyInto(out *FilebeatMultilineSetting) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilebeatMultilineSetting.
+func (in *FilebeatMultilineSetting) DeepCopy() *FilebeatMultilineSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(FilebeatMultilineSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilebeatSetting) DeepCopyInto(out *FilebeatSetting) {
+	*out = *in
+	if in.Multiline != nil {
+		in, out := &in.Multiline, &out.Multiline
+		*out = new(FilebeatMultilineSetting)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FilebeatSetting.
+func (in *FilebeatSetting) DeepCopy() *FilebeatSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(FilebeatSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaEndpoint) DeepCopyInto(out *KafkaEndpoint) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Consumers != nil {
+		in, out := &in.Consumers, &out.Consumers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaEndpoint.
+func (in *KafkaEndpoint) DeepCopy() *KafkaEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEndpoint) DeepCopyInto(out *LogEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEndpoint.
+func (in *LogEndpoint) DeepCopy() *LogEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEndpointList) DeepCopyInto(out *LogEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEndpointList.
+func (in *LogEndpointList) DeepCopy() *LogEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEndpointSpec) DeepCopyInto(out *LogEndpointSpec) {
+	*out = *in
+	in.Target.DeepCopyInto(&out.Target)
+	if in.CollectedClusters != nil {
+		in, out := &in.CollectedClusters, &out.CollectedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CollectorSettings != nil {
+		in, out := &in.CollectorSettings, &out.CollectorSettings
+		*out = new(CollectorSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEndpointSpec.
+func (in *LogEndpointSpec) DeepCopy() *LogEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogEndpointStatus) DeepCopyInto(out *LogEndpointStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogEndpointStatus.
+func (in *LogEndpointStatus) DeepCopy() *LogEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Target) DeepCopyInto(out *Target) {
+	*out = *in
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Target.
+func (in *Target) DeepCopy() *Target {
+	if in == nil {
+		return nil
+	}
+	out := new(Target)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=resource.caicloud.io
+
+package v1beta1
@@ -0,0 +1,73 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	DefaultCrdResourceGroup = "resource.caicloud.io"
+	DefaultApiVersion       = "v1beta1"
+)
+
+// SchemeGroupVersion is group version used to register these objects
+var SchemeGroupVersion = schema.GroupVersion{Group: DefaultCrdResourceGroup, Version: DefaultApiVersion}
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
+
+var (
+	// We only register manually written functions here. The registration of the
+	// generated functions takes place in the generated files. The separation
+	// makes the code compile even when the generated files are missing.
+	SchemeBuilder = runtime.NewSchemeBuilder(addKnownTypes)
+	AddToScheme   = SchemeBuilder.AddToScheme
+)
+
+// Adds the list of known types to api.Scheme.
+func addKnownTypes(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(SchemeGroupVersion,
+		&StorageType{},
+		&StorageTypeList{},
+		&StorageService{},
+		&StorageServiceList{},
+		&Cluster{},
+		&ClusterList{},
+		&Machine{},
+		&MachineList{},
+		&Tag{},
+		&TagList{},
+		&Config{},
+		&ConfigList{},
+		&MachineAutoScalingGroup{},
+		&MachineAutoScalingGroupList{},
+		&Snapshot{},
+		&SnapshotList{},
+		&RequirementGap{},
+		&RequirementGapList{},
+		&NodeLocalStorage{},
+		&NodeLocalStorageList{},
+		&InfraNetwork{},
+		&InfraNetworkList{},
+		&Network{},
+		&NetworkList{},
+		&WorkloadNetwork{},
+		&WorkloadNetworkList{},
+		&ResourceClass{},
+		&ResourceClassList{},
+		&ExtendedResource{},
+		&ExtendedResourceList{},
+		&NodeClaim{},
+		&NodeClaimList{},
+	)
+
+	// Add common types
+	scheme.AddKnownTypes(SchemeGroupVersion, &metav1.Status{})
+
+	// Add the watch version that applies
+	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
+	return nil
+}
@@ -0,0 +1,3663 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2020 caicloud authors. All rights reserved.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	net "net"
+
+	v1 "k8s.io/api/core/v1"
+	resource "k8s.io/apimachinery/pkg/api/resource"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	api "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunClusterCloudProviderConfig) DeepCopyInto(out *AliyunClusterCloudProviderConfig) {
+	*out = *in
+	out.VPC = in.VPC
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(AliyunLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunClusterCloudProviderConfig.
+func (in *AliyunClusterCloudProviderConfig) DeepCopy() *AliyunClusterCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunClusterCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunDisk) DeepCopyInto(out *AliyunDisk) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunDisk.
+func (in *AliyunDisk) DeepCopy() *AliyunDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunImage) DeepCopyInto(out *AliyunImage) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunImage.
+func (in *AliyunImage) DeepCopy() *AliyunImage {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunInstanceChargeAttr) DeepCopyInto(out *AliyunInstanceChargeAttr) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunInstanceChargeAttr.
+func (in *AliyunInstanceChargeAttr) DeepCopy() *AliyunInstanceChargeAttr {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunInstanceChargeAttr)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunInstanceType) DeepCopyInto(out *AliyunInstanceType) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunInstanceType.
+func (in *AliyunInstanceType) DeepCopy() *AliyunInstanceType {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunInstanceType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunLoadBalancer) DeepCopyInto(out *AliyunLoadBalancer) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	out.VSwitch = in.VSwitch
+	out.VPC = in.VPC
+	if in.BackendServers != nil {
+		in, out := &in.BackendServers, &out.BackendServers
+		*out = make([]AliyunLoadBalancerBackendServer, len(*in))
+		copy(*out, *in)
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]AliyunLoadBalancerListener, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunLoadBalancer.
+func (in *AliyunLoadBalancer) DeepCopy() *AliyunLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunLoadBalancerBackendServer) DeepCopyInto(out *AliyunLoadBalancerBackendServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunLoadBalancerBackendServer.
+func (in *AliyunLoadBalancerBackendServer) DeepCopy() *AliyunLoadBalancerBackendServer {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunLoadBalancerBackendServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunLoadBalancerListener) DeepCopyInto(out *AliyunLoadBalancerListener) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunLoadBalancerListener.
+func (in *AliyunLoadBalancerListener) DeepCopy() *AliyunLoadBalancerListener {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunLoadBalancerListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunLoadBalancerVServerGroup) DeepCopyInto(out *AliyunLoadBalancerVServerGroup) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	if in.BackendServers != nil {
+		in, out := &in.BackendServers, &out.BackendServers
+		*out = make([]AliyunLoadBalancerBackendServer, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunLoadBalancerVServerGroup.
+func (in *AliyunLoadBalancerVServerGroup) DeepCopy() *AliyunLoadBalancerVServerGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunLoadBalancerVServerGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunMASGProviderConifg) DeepCopyInto(out *AliyunMASGProviderConifg) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	out.VPC = in.VPC
+	out.VSwitch = in.VSwitch
+	out.InstanceType = in.InstanceType
+	out.Image = in.Image
+	out.OSDisk = in.OSDisk
+	if in.DataDisks != nil {
+		in, out := &in.DataDisks, &out.DataDisks
+		*out = make([]AliyunDisk, len(*in))
+		copy(*out, *in)
+	}
+	out.SecurityGroup = in.SecurityGroup
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunMASGProviderConifg.
+func (in *AliyunMASGProviderConifg) DeepCopy() *AliyunMASGProviderConifg {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunMASGProviderConifg)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunMachineCloudProviderConfig) DeepCopyInto(out *AliyunMachineCloudProviderConfig) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	out.Image = in.Image
+	out.InstanceType = in.InstanceType
+	out.InstanceCharge = in.InstanceCharge
+	out.OSDisk = in.OSDisk
+	if in.DataDisks != nil {
+		in, out := &in.DataDisks, &out.DataDisks
+		*out = make([]AliyunDisk, len(*in))
+		copy(*out, *in)
+	}
+	out.VPC = in.VPC
+	out.VSwitch = in.VSwitch
+	out.SecurityGroup = in.SecurityGroup
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]AliyunNetworkInterface, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunMachineCloudProviderConfig.
+func (in *AliyunMachineCloudProviderConfig) DeepCopy() *AliyunMachineCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunMachineCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunMachineCloudProviderStatus) DeepCopyInto(out *AliyunMachineCloudProviderStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunMachineCloudProviderStatus.
+func (in *AliyunMachineCloudProviderStatus) DeepCopy() *AliyunMachineCloudProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunMachineCloudProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunMachineEipAddress) DeepCopyInto(out *AliyunMachineEipAddress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunMachineEipAddress.
+func (in *AliyunMachineEipAddress) DeepCopy() *AliyunMachineEipAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunMachineEipAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunNetworkInterface) DeepCopyInto(out *AliyunNetworkInterface) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunNetworkInterface.
+func (in *AliyunNetworkInterface) DeepCopy() *AliyunNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunObjectMeta) DeepCopyInto(out *AliyunObjectMeta) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunObjectMeta.
+func (in *AliyunObjectMeta) DeepCopy() *AliyunObjectMeta {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunObjectMeta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunSecurityGroup) DeepCopyInto(out *AliyunSecurityGroup) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunSecurityGroup.
+func (in *AliyunSecurityGroup) DeepCopy() *AliyunSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunVPC) DeepCopyInto(out *AliyunVPC) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunVPC.
+func (in *AliyunVPC) DeepCopy() *AliyunVPC {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunVPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AliyunVSwitch) DeepCopyInto(out *AliyunVSwitch) {
+	*out = *in
+	out.AliyunObjectMeta = in.AliyunObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AliyunVSwitch.
+func (in *AliyunVSwitch) DeepCopy() *AliyunVSwitch {
+	if in == nil {
+		return nil
+	}
+	out := new(AliyunVSwitch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoScalingNotifySetting) DeepCopyInto(out *AutoScalingNotifySetting) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScalingNotifySetting.
+func (in *AutoScalingNotifySetting) DeepCopy() *AutoScalingNotifySetting {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoScalingNotifySetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureAksClusterCloudProviderConfig) DeepCopyInto(out *AzureAksClusterCloudProviderConfig) {
+	*out = *in
+	out.VirtualNetwork = in.VirtualNetwork
+	out.Subnet = in.Subnet
+	in.Aks.DeepCopyInto(&out.Aks)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAksClusterCloudProviderConfig.
+func (in *AzureAksClusterCloudProviderConfig) DeepCopy() *AzureAksClusterCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureAksClusterCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureAksClusterCloudProviderStatus) DeepCopyInto(out *AzureAksClusterCloudProviderStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAksClusterCloudProviderStatus.
+func (in *AzureAksClusterCloudProviderStatus) DeepCopy() *AzureAksClusterCloudProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureAksClusterCloudProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureAvailabilitySet) DeepCopyInto(out *AzureAvailabilitySet) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]AzureObjectMeta, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAvailabilitySet.
+func (in *AzureAvailabilitySet) DeepCopy() *AzureAvailabilitySet {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureAvailabilitySet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBackendAddressPool) DeepCopyInto(out *AzureBackendAddressPool) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	if in.BackendIPConfigurations != nil {
+		in, out := &in.BackendIPConfigurations, &out.BackendIPConfigurations
+		*out = make([]AzureIpConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBackendAddressPool.
+func (in *AzureBackendAddressPool) DeepCopy() *AzureBackendAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBackendAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureClusterCloudProviderConfig) DeepCopyInto(out *AzureClusterCloudProviderConfig) {
+	*out = *in
+	out.VirtualNetwork = in.VirtualNetwork
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(AzureLoadBalancer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = make([]AzureLoadBalancer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureClusterCloudProviderConfig.
+func (in *AzureClusterCloudProviderConfig) DeepCopy() *AzureClusterCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureClusterCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureDisk) DeepCopyInto(out *AzureDisk) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureDisk.
+func (in *AzureDisk) DeepCopy() *AzureDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureFrontendIPConfiguration) DeepCopyInto(out *AzureFrontendIPConfiguration) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(AzurePublicIP)
+		**out = **in
+	}
+	out.Subnet = in.Subnet
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureFrontendIPConfiguration.
+func (in *AzureFrontendIPConfiguration) DeepCopy() *AzureFrontendIPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureFrontendIPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureImageReference) DeepCopyInto(out *AzureImageReference) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureImageReference.
+func (in *AzureImageReference) DeepCopy() *AzureImageReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureImageReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureIpConfig) DeepCopyInto(out *AzureIpConfig) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	out.Subnet = in.Subnet
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(AzurePublicIP)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureIpConfig.
+func (in *AzureIpConfig) DeepCopy() *AzureIpConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureIpConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLinuxProfile) DeepCopyInto(out *AzureLinuxProfile) {
+	*out = *in
+	in.SSH.DeepCopyInto(&out.SSH)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLinuxProfile.
+func (in *AzureLinuxProfile) DeepCopy() *AzureLinuxProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLinuxProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLoadBalancer) DeepCopyInto(out *AzureLoadBalancer) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	if in.FrontendIPConfigurations != nil {
+		in, out := &in.FrontendIPConfigurations, &out.FrontendIPConfigurations
+		*out = make([]AzureFrontendIPConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BackendAddressPools != nil {
+		in, out := &in.BackendAddressPools, &out.BackendAddressPools
+		*out = make([]AzureBackendAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = make([]AzureLoadBalancerProbe, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancingRules != nil {
+		in, out := &in.LoadBalancingRules, &out.LoadBalancingRules
+		*out = make([]AzureLoadBalancingRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLoadBalancer.
+func (in *AzureLoadBalancer) DeepCopy() *AzureLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLoadBalancerProbe) DeepCopyInto(out *AzureLoadBalancerProbe) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLoadBalancerProbe.
+func (in *AzureLoadBalancerProbe) DeepCopy() *AzureLoadBalancerProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLoadBalancerProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureLoadBalancingRule) DeepCopyInto(out *AzureLoadBalancingRule) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureLoadBalancingRule.
+func (in *AzureLoadBalancingRule) DeepCopy() *AzureLoadBalancingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureLoadBalancingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureMASGProviderConfig) DeepCopyInto(out *AzureMASGProviderConfig) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	out.VirtualNetwork = in.VirtualNetwork
+	out.Subnet = in.Subnet
+	out.VMSize = in.VMSize
+	out.ImageReference = in.ImageReference
+	out.OSDisk = in.OSDisk
+	if in.DataDisks != nil {
+		in, out := &in.DataDisks, &out.DataDisks
+		*out = make([]AzureDisk, len(*in))
+		copy(*out, *in)
+	}
+	out.SecurityGroup = in.SecurityGroup
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMASGProviderConfig.
+func (in *AzureMASGProviderConfig) DeepCopy() *AzureMASGProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureMASGProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureMachineCloudProviderConfig) DeepCopyInto(out *AzureMachineCloudProviderConfig) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	out.VirtualNetwork = in.VirtualNetwork
+	out.VMSize = in.VMSize
+	out.ImageReference = in.ImageReference
+	out.OSDisk = in.OSDisk
+	if in.DataDisks != nil {
+		in, out := &in.DataDisks, &out.DataDisks
+		*out = make([]AzureDisk, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]AzureNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AvailabilitySet != nil {
+		in, out := &in.AvailabilitySet, &out.AvailabilitySet
+		*out = new(AzureObjectMeta)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachineCloudProviderConfig.
+func (in *AzureMachineCloudProviderConfig) DeepCopy() *AzureMachineCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureMachineCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureManagedCluster) DeepCopyInto(out *AzureManagedCluster) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	if in.AgentPoolProfiles != nil {
+		in, out := &in.AgentPoolProfiles, &out.AgentPoolProfiles
+		*out = make([]AzureManagedClusterAgentPoolProfile, len(*in))
+		copy(*out, *in)
+	}
+	in.LinuxProfile.DeepCopyInto(&out.LinuxProfile)
+	out.ServicePrincipalProfile = in.ServicePrincipalProfile
+	out.NetworkProfile = in.NetworkProfile
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedCluster.
+func (in *AzureManagedCluster) DeepCopy() *AzureManagedCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureManagedCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureManagedClusterAgentPoolProfile) DeepCopyInto(out *AzureManagedClusterAgentPoolProfile) {
+	*out = *in
+	out.VMSize = in.VMSize
+	out.OSDisk = in.OSDisk
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedClusterAgentPoolProfile.
+func (in *AzureManagedClusterAgentPoolProfile) DeepCopy() *AzureManagedClusterAgentPoolProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureManagedClusterAgentPoolProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureManagedClusterNetworkProfile) DeepCopyInto(out *AzureManagedClusterNetworkProfile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedClusterNetworkProfile.
+func (in *AzureManagedClusterNetworkProfile) DeepCopy() *AzureManagedClusterNetworkProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureManagedClusterNetworkProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureManagedClusterServicePrincipalProfile) DeepCopyInto(out *AzureManagedClusterServicePrincipalProfile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedClusterServicePrincipalProfile.
+func (in *AzureManagedClusterServicePrincipalProfile) DeepCopy() *AzureManagedClusterServicePrincipalProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureManagedClusterServicePrincipalProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureNetworkInterface) DeepCopyInto(out *AzureNetworkInterface) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	out.SecurityGroup = in.SecurityGroup
+	if in.IPConfigurations != nil {
+		in, out := &in.IPConfigurations, &out.IPConfigurations
+		*out = make([]AzureIpConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureNetworkInterface.
+func (in *AzureNetworkInterface) DeepCopy() *AzureNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureObjectMeta) DeepCopyInto(out *AzureObjectMeta) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureObjectMeta.
+func (in *AzureObjectMeta) DeepCopy() *AzureObjectMeta {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureObjectMeta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePublicIP) DeepCopyInto(out *AzurePublicIP) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePublicIP.
+func (in *AzurePublicIP) DeepCopy() *AzurePublicIP {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePublicIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzurePublicKey) DeepCopyInto(out *AzurePublicKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzurePublicKey.
+func (in *AzurePublicKey) DeepCopy() *AzurePublicKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AzurePublicKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSSHConfiguration) DeepCopyInto(out *AzureSSHConfiguration) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]AzurePublicKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSSHConfiguration.
+func (in *AzureSSHConfiguration) DeepCopy() *AzureSSHConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSSHConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSecurityGroup) DeepCopyInto(out *AzureSecurityGroup) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSecurityGroup.
+func (in *AzureSecurityGroup) DeepCopy() *AzureSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSubnet) DeepCopyInto(out *AzureSubnet) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSubnet.
+func (in *AzureSubnet) DeepCopy() *AzureSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVMSize) DeepCopyInto(out *AzureVMSize) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVMSize.
+func (in *AzureVMSize) DeepCopy() *AzureVMSize {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVMSize)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureVirtualNetwork) DeepCopyInto(out *AzureVirtualNetwork) {
+	*out = *in
+	out.AzureObjectMeta = in.AzureObjectMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureVirtualNetwork.
+func (in *AzureVirtualNetwork) DeepCopy() *AzureVirtualNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureVirtualNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeVlan) DeepCopyInto(out *BridgeVlan) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeVlan.
+func (in *BridgeVlan) DeepCopy() *BridgeVlan {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeVlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CNI) DeepCopyInto(out *CNI) {
+	*out = *in
+	if in.Calico != nil {
+		in, out := &in.Calico, &out.Calico
+		*out = new(Calico)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canal != nil {
+		in, out := &in.Canal, &out.Canal
+		*out = new(Canal)
+		**out = **in
+	}
+	if in.BridgeVlan != nil {
+		in, out := &in.BridgeVlan, &out.BridgeVlan
+		*out = new(BridgeVlan)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CNI.
+func (in *CNI) DeepCopy() *CNI {
+	if in == nil {
+		return nil
+	}
+	out := new(CNI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Calico) DeepCopyInto(out *Calico) {
+	*out = *in
+	if in.RrNodes != nil {
+		in, out := &in.RrNodes, &out.RrNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RrIPs != nil {
+		in, out := &in.RrIPs, &out.RrIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Calico.
+func (in *Calico) DeepCopy() *Calico {
+	if in == nil {
+		return nil
+	}
+	out := new(Calico)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Canal) DeepCopyInto(out *Canal) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Canal.
+func (in *Canal) DeepCopy() *Canal {
+	if in == nil {
+		return nil
+	}
+	out := new(Canal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephMetaData) DeepCopyInto(out *CephMetaData) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]CephPool, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephMetaData.
+func (in *CephMetaData) DeepCopy() *CephMetaData {
+	if in == nil {
+		return nil
+	}
+	out := new(CephMetaData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CephPool) DeepCopyInto(out *CephPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CephPool.
+func (in *CephPool) DeepCopy() *CephPool {
+	if in == nil {
+		return nil
+	}
+	out := new(CephPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cluster) DeepCopyInto(out *Cluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cluster.
+func (in *Cluster) DeepCopy() *Cluster {
+	if in == nil {
+		return nil
+	}
+	out := new(Cluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Cluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAuth) DeepCopyInto(out *ClusterAuth) {
+	*out = *in
+	if in.KubeCAData != nil {
+		in, out := &in.KubeCAData, &out.KubeCAData
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeConfig != nil {
+		in, out := &in.KubeConfig, &out.KubeConfig
+		*out = new(api.Config)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAuth.
+func (in *ClusterAuth) DeepCopy() *ClusterAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoScalingSetting) DeepCopyInto(out *ClusterAutoScalingSetting) {
+	*out = *in
+	out.ScaleUpSetting = in.ScaleUpSetting
+	out.ScaleDownSetting = in.ScaleDownSetting
+	in.NotifySetting.DeepCopyInto(&out.NotifySetting)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoScalingSetting.
+func (in *ClusterAutoScalingSetting) DeepCopy() *ClusterAutoScalingSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoScalingSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoScalingStatus) DeepCopyInto(out *ClusterAutoScalingStatus) {
+	*out = *in
+	in.LastScaleUpTime.DeepCopyInto(&out.LastScaleUpTime)
+	in.LastScaleDownTime.DeepCopyInto(&out.LastScaleDownTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoScalingStatus.
+func (in *ClusterAutoScalingStatus) DeepCopy() *ClusterAutoScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCloudProviderConfig) DeepCopyInto(out *ClusterCloudProviderConfig) {
+	*out = *in
+	if in.AutoScalingSetting != nil {
+		in, out := &in.AutoScalingSetting, &out.AutoScalingSetting
+		*out = new(ClusterAutoScalingSetting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureClusterCloudProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureAks != nil {
+		in, out := &in.AzureAks, &out.AzureAks
+		*out = new(AzureAksClusterCloudProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aliyun != nil {
+		in, out := &in.Aliyun, &out.Aliyun
+		*out = new(AliyunClusterCloudProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Imported != nil {
+		in, out := &in.Imported, &out.Imported
+		*out = new(ImportedClusterCloudProviderConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCloudProviderConfig.
+func (in *ClusterCloudProviderConfig) DeepCopy() *ClusterCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterCondition) DeepCopyInto(out *ClusterCondition) {
+	*out = *in
+	in.LastHeartbeatTime.DeepCopyInto(&out.LastHeartbeatTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterCondition.
+func (in *ClusterCondition) DeepCopy() *ClusterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNetwork) DeepCopyInto(out *ClusterNetwork) {
+	*out = *in
+	in.Default.DeepCopyInto(&out.Default)
+	if in.Extras != nil {
+		in, out := &in.Extras, &out.Extras
+		*out = make([]NetworkTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetwork.
+func (in *ClusterNetwork) DeepCopy() *ClusterNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterProviderStatus) DeepCopyInto(out *ClusterProviderStatus) {
+	*out = *in
+	if in.AzureAks != nil {
+		in, out := &in.AzureAks, &out.AzureAks
+		*out = new(AzureAksClusterCloudProviderStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterProviderStatus.
+func (in *ClusterProviderStatus) DeepCopy() *ClusterProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRatio) DeepCopyInto(out *ClusterRatio) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRatio.
+func (in *ClusterRatio) DeepCopy() *ClusterRatio {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRatio)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterScaleDownSetting) DeepCopyInto(out *ClusterScaleDownSetting) {
+	*out = *in
+	out.CoolDown = in.CoolDown
+	out.IdleTime = in.IdleTime
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterScaleDownSetting.
+func (in *ClusterScaleDownSetting) DeepCopy() *ClusterScaleDownSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterScaleDownSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterScaleUpSetting) DeepCopyInto(out *ClusterScaleUpSetting) {
+	*out = *in
+	out.CoolDown = in.CoolDown
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterScaleUpSetting.
+func (in *ClusterScaleUpSetting) DeepCopy() *ClusterScaleUpSetting {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterScaleUpSetting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
+	*out = *in
+	in.ProviderConfig.DeepCopyInto(&out.ProviderConfig)
+	in.Network.DeepCopyInto(&out.Network)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = new(ClusterVersions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Masters != nil {
+		in, out := &in.Masters, &out.Masters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Etcds != nil {
+		in, out := &in.Etcds, &out.Etcds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeployToolsExternalVars != nil {
+		in, out := &in.DeployToolsExternalVars, &out.DeployToolsExternalVars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Ratio = in.Ratio
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
+func (in *ClusterSpec) DeepCopy() *ClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ClusterCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Masters != nil {
+		in, out := &in.Masters, &out.Masters
+		*out = make([]MachineThumbnail, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]MachineThumbnail, len(*in))
+		copy(*out, *in)
+	}
+	if in.Etcds != nil {
+		in, out := &in.Etcds, &out.Etcds
+		*out = make([]MachineThumbnail, len(*in))
+		copy(*out, *in)
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(map[ResourceName]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.OperationLogs != nil {
+		in, out := &in.OperationLogs, &out.OperationLogs
+		*out = make([]OperationLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.AutoScaling.DeepCopyInto(&out.AutoScaling)
+	in.ProviderStatus.DeepCopyInto(&out.ProviderStatus)
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = new(ClusterVersions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVersions) DeepCopyInto(out *ClusterVersions) {
+	*out = *in
+	if in.ClusterSets != nil {
+		in, out := &in.ClusterSets, &out.ClusterSets
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MasterSets != nil {
+		in, out := &in.MasterSets, &out.MasterSets
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSets != nil {
+		in, out := &in.NodeSets, &out.NodeSets
+		*out = make(MachineVersions, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVersions.
+func (in *ClusterVersions) DeepCopy() *ClusterVersions {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVersions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Config) DeepCopyInto(out *Config) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string][]byte, len(*in))
+		for key, val := range *in {
+			var outVal []byte
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]byte, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Config.
+func (in *Config) DeepCopy() *Config {
+	if in == nil {
+		return nil
+	}
+	out := new(Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Config) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigList) DeepCopyInto(out *ConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Config, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigList.
+func (in *ConfigList) DeepCopy() *ConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtendedResource) DeepCopyInto(out *ExtendedResource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtendedResource.
+func (in *ExtendedResource) DeepCopy() *ExtendedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtendedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExtendedResource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtendedResourceList) DeepCopyInto(out *ExtendedResourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExtendedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtendedResourceList.
+func (in *ExtendedResourceList) DeepCopy() *ExtendedResourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtendedResourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExtendedResourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtendedResourceSpec) DeepCopyInto(out *ExtendedResourceSpec) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtendedResourceSpec.
+func (in *ExtendedResourceSpec) DeepCopy() *ExtendedResourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtendedResourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtendedResourceStatus) DeepCopyInto(out *ExtendedResourceStatus) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtendedResourceStatus.
+func (in *ExtendedResourceStatus) DeepCopy() *ExtendedResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtendedResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedClusterCloudProviderConfig) DeepCopyInto(out *ImportedClusterCloudProviderConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedClusterCloudProviderConfig.
+func (in *ImportedClusterCloudProviderConfig) DeepCopy() *ImportedClusterCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedClusterCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraNetwork) DeepCopyInto(out *InfraNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraNetwork.
+func (in *InfraNetwork) DeepCopy() *InfraNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfraNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraNetworkAzureProviderConfig) DeepCopyInto(out *InfraNetworkAzureProviderConfig) {
+	*out = *in
+	out.VirtualNetwork = in.VirtualNetwork
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraNetworkAzureProviderConfig.
+func (in *InfraNetworkAzureProviderConfig) DeepCopy() *InfraNetworkAzureProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraNetworkAzureProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraNetworkBareMetalProviderConfig) DeepCopyInto(out *InfraNetworkBareMetalProviderConfig) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraNetworkBareMetalProviderConfig.
+func (in *InfraNetworkBareMetalProviderConfig) DeepCopy() *InfraNetworkBareMetalProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraNetworkBareMetalProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraNetworkList) DeepCopyInto(out *InfraNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InfraNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraNetworkList.
+func (in *InfraNetworkList) DeepCopy() *InfraNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InfraNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraNetworkProviderConfig) DeepCopyInto(out *InfraNetworkProviderConfig) {
+	*out = *in
+	if in.BareMetal != nil {
+		in, out := &in.BareMetal, &out.BareMetal
+		*out = new(InfraNetworkBareMetalProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(InfraNetworkAzureProviderConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraNetworkProviderConfig.
+func (in *InfraNetworkProviderConfig) DeepCopy() *InfraNetworkProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraNetworkProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraNetworkSpec) DeepCopyInto(out *InfraNetworkSpec) {
+	*out = *in
+	in.ProviderConfig.DeepCopyInto(&out.ProviderConfig)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraNetworkSpec.
+func (in *InfraNetworkSpec) DeepCopy() *InfraNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraNetworkStatus) DeepCopyInto(out *InfraNetworkStatus) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraNetworkStatus.
+func (in *InfraNetworkStatus) DeepCopy() *InfraNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MASGMachineAzureProviderConfig) DeepCopyInto(out *MASGMachineAzureProviderConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MASGMachineAzureProviderConfig.
+func (in *MASGMachineAzureProviderConfig) DeepCopy() *MASGMachineAzureProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MASGMachineAzureProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MASGMachineInfo) DeepCopyInto(out *MASGMachineInfo) {
+	*out = *in
+	in.ProviderConfig.DeepCopyInto(&out.ProviderConfig)
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BoundTime != nil {
+		in, out := &in.BoundTime, &out.BoundTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReadyTime != nil {
+		in, out := &in.ReadyTime, &out.ReadyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FailedTime != nil {
+		in, out := &in.FailedTime, &out.FailedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastBusyTime != nil {
+		in, out := &in.LastBusyTime, &out.LastBusyTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UnboundTime != nil {
+		in, out := &in.UnboundTime, &out.UnboundTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MASGMachineInfo.
+func (in *MASGMachineInfo) DeepCopy() *MASGMachineInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MASGMachineInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MASGMachineProviderConfig) DeepCopyInto(out *MASGMachineProviderConfig) {
+	*out = *in
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(MASGMachineAzureProviderConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MASGMachineProviderConfig.
+func (in *MASGMachineProviderConfig) DeepCopy() *MASGMachineProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MASGMachineProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MASGProviderConfig) DeepCopyInto(out *MASGProviderConfig) {
+	*out = *in
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureMASGProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aliyun != nil {
+		in, out := &in.Aliyun, &out.Aliyun
+		*out = new(AliyunMASGProviderConifg)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MASGProviderConfig.
+func (in *MASGProviderConfig) DeepCopy() *MASGProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MASGProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MASGSpec) DeepCopyInto(out *MASGSpec) {
+	*out = *in
+	in.ProviderConfig.DeepCopyInto(&out.ProviderConfig)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.NotifySetting.DeepCopyInto(&out.NotifySetting)
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MASGSpec.
+func (in *MASGSpec) DeepCopy() *MASGSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MASGSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MASGStatus) DeepCopyInto(out *MASGStatus) {
+	*out = *in
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = make([]MASGMachineInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastScaleUpTime.DeepCopyInto(&out.LastScaleUpTime)
+	in.LastScaleDownTime.DeepCopyInto(&out.LastScaleDownTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MASGStatus.
+func (in *MASGStatus) DeepCopy() *MASGStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MASGStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Machine) DeepCopyInto(out *Machine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
+func (in *Machine) DeepCopy() *Machine {
+	if in == nil {
+		return nil
+	}
+	out := new(Machine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Machine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAuth) DeepCopyInto(out *MachineAuth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAuth.
+func (in *MachineAuth) DeepCopy() *MachineAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAutoScalingGroup) DeepCopyInto(out *MachineAutoScalingGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAutoScalingGroup.
+func (in *MachineAutoScalingGroup) DeepCopy() *MachineAutoScalingGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAutoScalingGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineAutoScalingGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAutoScalingGroupList) DeepCopyInto(out *MachineAutoScalingGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachineAutoScalingGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAutoScalingGroupList.
+func (in *MachineAutoScalingGroupList) DeepCopy() *MachineAutoScalingGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAutoScalingGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineAutoScalingGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCloudProviderConfig) DeepCopyInto(out *MachineCloudProviderConfig) {
+	*out = *in
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureMachineCloudProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aliyun != nil {
+		in, out := &in.Aliyun, &out.Aliyun
+		*out = new(AliyunMachineCloudProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCloudProviderConfig.
+func (in *MachineCloudProviderConfig) DeepCopy() *MachineCloudProviderConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCloudProviderConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCondition) DeepCopyInto(out *MachineCondition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCondition.
+func (in *MachineCondition) DeepCopy() *MachineCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDiskInfo) DeepCopyInto(out *MachineDiskInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDiskInfo.
+func (in *MachineDiskInfo) DeepCopy() *MachineDiskInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDiskInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineEnvironment) DeepCopyInto(out *MachineEnvironment) {
+	*out = *in
+	out.SystemInfo = in.SystemInfo
+	out.HardwareInfo = in.HardwareInfo
+	if in.DiskInfo != nil {
+		in, out := &in.DiskInfo, &out.DiskInfo
+		*out = make([]MachineDiskInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.NicInfo != nil {
+		in, out := &in.NicInfo, &out.NicInfo
+		*out = make([]MachineNicInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GPUInfo != nil {
+		in, out := &in.GPUInfo, &out.GPUInfo
+		*out = make([]MachineGPUInfo, len(*in))
+		copy(*out, *in)
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineEnvironment.
+func (in *MachineEnvironment) DeepCopy() *MachineEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineGPUInfo) DeepCopyInto(out *MachineGPUInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineGPUInfo.
+func (in *MachineGPUInfo) DeepCopy() *MachineGPUInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineGPUInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MachineHardware) DeepCopyInto(out *MachineHardware) {
+	{
+		in := &in
+		*out = make(MachineHardware, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHardware.
+func (in MachineHardware) DeepCopy() MachineHardware {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHardware)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHardwareInfo) DeepCopyInto(out *MachineHardwareInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHardwareInfo.
+func (in *MachineHardwareInfo) DeepCopy() *MachineHardwareInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHardwareInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineList) DeepCopyInto(out *MachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Machine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineList.
+func (in *MachineList) DeepCopy() *MachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNicInfo) DeepCopyInto(out *MachineNicInfo) {
+	*out = *in
+	if in.Addrs != nil {
+		in, out := &in.Addrs, &out.Addrs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNicInfo.
+func (in *MachineNicInfo) DeepCopy() *MachineNicInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNicInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineNodeStatus) DeepCopyInto(out *MachineNodeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.NodeCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineNodeStatus.
+func (in *MachineNodeStatus) DeepCopy() *MachineNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineProviderStatus) DeepCopyInto(out *MachineProviderStatus) {
+	*out = *in
+	if in.Aliyun != nil {
+		in, out := &in.Aliyun, &out.Aliyun
+		*out = new(AliyunMachineCloudProviderStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineProviderStatus.
+func (in *MachineProviderStatus) DeepCopy() *MachineProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
+	*out = *in
+	in.ProviderConfig.DeepCopyInto(&out.ProviderConfig)
+	if in.Address != nil {
+		in, out := &in.Address, &out.Address
+		*out = make([]NodeAddress, len(*in))
+		copy(*out, *in)
+	}
+	out.Auth = in.Auth
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make(MachineVersions, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpec.
+func (in *MachineSpec) DeepCopy() *MachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
+	*out = *in
+	if in.Environment != nil {
+		in, out := &in.Environment, &out.Environment
+		*out = new(MachineEnvironment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(map[ResourceName]resource.Quantity, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	in.NodeStatus.DeepCopyInto(&out.NodeStatus)
+	if in.OperationLogs != nil {
+		in, out := &in.OperationLogs, &out.OperationLogs
+		*out = make([]OperationLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]MachineCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ProviderStatus.DeepCopyInto(&out.ProviderStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineStatus.
+func (in *MachineStatus) DeepCopy() *MachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSystemInfo) DeepCopyInto(out *MachineSystemInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSystemInfo.
+func (in *MachineSystemInfo) DeepCopy() *MachineSystemInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSystemInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineTemplate) DeepCopyInto(out *MachineTemplate) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineTemplate.
+func (in *MachineTemplate) DeepCopy() *MachineTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineThumbnail) DeepCopyInto(out *MachineThumbnail) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineThumbnail.
+func (in *MachineThumbnail) DeepCopy() *MachineThumbnail {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineThumbnail)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in MachineVersions) DeepCopyInto(out *MachineVersions) {
+	{
+		in := &in
+		*out = make(MachineVersions, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineVersions.
+func (in MachineVersions) DeepCopy() MachineVersions {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineVersions)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Network) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkList) DeepCopyInto(out *NetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Network, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkList.
+func (in *NetworkList) DeepCopy() *NetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.CNI.DeepCopyInto(&out.CNI)
+	if in.IPRecycleTimeout != nil {
+		in, out := &in.IPRecycleTimeout, &out.IPRecycleTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	if in.SubnetStatuses != nil {
+		in, out := &in.SubnetStatuses, &out.SubnetStatuses
+		*out = make([]SubnetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkTemplate) DeepCopyInto(out *NetworkTemplate) {
+	*out = *in
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkTemplate.
+func (in *NetworkTemplate) DeepCopy() *NetworkTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAddress) DeepCopyInto(out *NodeAddress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAddress.
+func (in *NodeAddress) DeepCopy() *NodeAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeClaim) DeepCopyInto(out *NodeClaim) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeClaim.
+func (in *NodeClaim) DeepCopy() *NodeClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeClaim) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeClaimCondition) DeepCopyInto(out *NodeClaimCondition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeClaimCondition.
+func (in *NodeClaimCondition) DeepCopy() *NodeClaimCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeClaimCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeClaimList) DeepCopyInto(out *NodeClaimList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeClaimList.
+func (in *NodeClaimList) DeepCopy() *NodeClaimList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeClaimList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeClaimList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeClaimSpec) DeepCopyInto(out *NodeClaimSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeClaimSpec.
+func (in *NodeClaimSpec) DeepCopy() *NodeClaimSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeClaimSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeClaimStatus) DeepCopyInto(out *NodeClaimStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]NodeClaimCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeClaimStatus.
+func (in *NodeClaimStatus) DeepCopy() *NodeClaimStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeClaimStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalStorage) DeepCopyInto(out *NodeLocalStorage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalStorage.
+func (in *NodeLocalStorage) DeepCopy() *NodeLocalStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeLocalStorage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalStorageList) DeepCopyInto(out *NodeLocalStorageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeLocalStorage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalStorageList.
+func (in *NodeLocalStorageList) DeepCopy() *NodeLocalStorageList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalStorageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeLocalStorageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalStorageSpec) DeepCopyInto(out *NodeLocalStorageSpec) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalStorageSpec.
+func (in *NodeLocalStorageSpec) DeepCopy() *NodeLocalStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalStorageStatus) DeepCopyInto(out *NodeLocalStorageStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalStorageStatus.
+func (in *NodeLocalStorageStatus) DeepCopy() *NodeLocalStorageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalStorageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationLog) DeepCopyInto(out *OperationLog) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationLog.
+func (in *OperationLog) DeepCopy() *OperationLog {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RBDSource) DeepCopyInto(out *RBDSource) {
+	*out = *in
+	if in.CephMonitors != nil {
+		in, out := &in.CephMonitors, &out.CephMonitors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RBDSource.
+func (in *RBDSource) DeepCopy() *RBDSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RBDSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequirementGap) DeepCopyInto(out *RequirementGap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequirementGap.
+func (in *RequirementGap) DeepCopy() *RequirementGap {
+	if in == nil {
+		return nil
+	}
+	out := new(RequirementGap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequirementGap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequirementGapList) DeepCopyInto(out *RequirementGapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RequirementGap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequirementGapList.
+func (in *RequirementGapList) DeepCopy() *RequirementGapList {
+	if in == nil {
+		return nil
+	}
+	out := new(RequirementGapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequirementGapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequirementGapReference) DeepCopyInto(out *RequirementGapReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequirementGapReference.
+func (in *RequirementGapReference) DeepCopy() *RequirementGapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RequirementGapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequirementGapSpec) DeepCopyInto(out *RequirementGapSpec) {
+	*out = *in
+	out.Reference = in.Reference
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequirementGapSpec.
+func (in *RequirementGapSpec) DeepCopy() *RequirementGapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RequirementGapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequirementGapStatus) DeepCopyInto(out *RequirementGapStatus) {
+	*out = *in
+	in.Summary.DeepCopyInto(&out.Summary)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequirementGapStatus.
+func (in *RequirementGapStatus) DeepCopy() *RequirementGapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RequirementGapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequirementGapSummary) DeepCopyInto(out *RequirementGapSummary) {
+	*out = *in
+	in.Single.DeepCopyInto(&out.Single)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequirementGapSummary.
+func (in *RequirementGapSummary) DeepCopy() *RequirementGapSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(RequirementGapSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceClass) DeepCopyInto(out *ResourceClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceClass.
+func (in *ResourceClass) DeepCopy() *ResourceClass {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceClassList) DeepCopyInto(out *ResourceClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceClassList.
+func (in *ResourceClassList) DeepCopy() *ResourceClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceClassSpec) DeepCopyInto(out *ResourceClassSpec) {
+	*out = *in
+	in.Requirements.DeepCopyInto(&out.Requirements)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceClassSpec.
+func (in *ResourceClassSpec) DeepCopy() *ResourceClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snapshot) DeepCopyInto(out *Snapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
+func (in *Snapshot) DeepCopy() *Snapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(Snapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotList.
+func (in *SnapshotList) DeepCopy() *SnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
+	*out = *in
+	in.StorageSource.DeepCopyInto(&out.StorageSource)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
+func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotStatus.
+func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMetaData) DeepCopyInto(out *StorageMetaData) {
+	*out = *in
+	if in.Ceph != nil {
+		in, out := &in.Ceph, &out.Ceph
+		*out = new(CephMetaData)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMetaData.
+func (in *StorageMetaData) DeepCopy() *StorageMetaData {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMetaData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageService) DeepCopyInto(out *StorageService) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.StorageMetaData.DeepCopyInto(&out.StorageMetaData)
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Allocated != nil {
+		in, out := &in.Allocated, &out.Allocated
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageService.
+func (in *StorageService) DeepCopy() *StorageService {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageServiceList) DeepCopyInto(out *StorageServiceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StorageService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageServiceList.
+func (in *StorageServiceList) DeepCopy() *StorageServiceList {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageServiceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageSource) DeepCopyInto(out *StorageSource) {
+	*out = *in
+	if in.RBD != nil {
+		in, out := &in.RBD, &out.RBD
+		*out = new(RBDSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageSource.
+func (in *StorageSource) DeepCopy() *StorageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageType) DeepCopyInto(out *StorageType) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.RequiredParameters != nil {
+		in, out := &in.RequiredParameters, &out.RequiredParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.OptionalParameters != nil {
+		in, out := &in.OptionalParameters, &out.OptionalParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageType.
+func (in *StorageType) DeepCopy() *StorageType {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageType) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageTypeList) DeepCopyInto(out *StorageTypeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StorageType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageTypeList.
+func (in *StorageTypeList) DeepCopy() *StorageTypeList {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageTypeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StorageTypeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subnet) DeepCopyInto(out *Subnet) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+	if in.RangeStart != nil {
+		in, out := &in.RangeStart, &out.RangeStart
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+	if in.RangeEnd != nil {
+		in, out := &in.RangeEnd, &out.RangeEnd
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
+func (in *Subnet) DeepCopy() *Subnet {
+	if in == nil {
+		return nil
+	}
+	out := new(Subnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
+	*out = *in
+	if in.RangeStart != nil {
+		in, out := &in.RangeStart, &out.RangeStart
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+	if in.RangeEnd != nil {
+		in, out := &in.RangeEnd, &out.RangeEnd
+		*out = make(net.IP, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
+func (in *SubnetStatus) DeepCopy() *SubnetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tag) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagList) DeepCopyInto(out *TagList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Tag, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagList.
+func (in *TagList) DeepCopy() *TagList {
+	if in == nil {
+		return nil
+	}
+	out := new(TagList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TagList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadNetwork) DeepCopyInto(out *WorkloadNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadNetwork.
+func (in *WorkloadNetwork) DeepCopy() *WorkloadNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadNetworkList) DeepCopyInto(out *WorkloadNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkloadNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadNetworkList.
+func (in *WorkloadNetworkList) DeepCopy() *WorkloadNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadNetworkSpec) DeepCopyInto(out *WorkloadNetworkSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadNetworkSpec.
+func (in *WorkloadNetworkSpec) DeepCopy() *WorkloadNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
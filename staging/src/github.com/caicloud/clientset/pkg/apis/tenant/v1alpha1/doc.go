@@ -0,0 +1,4 @@
+// +k8s:deepcopy-gen=package
+// +groupName=tenant.caicloud.io
+
+package v1alpha1
@@ -0,0 +1,8 @@
+package v1alpha1
+
+const (
+	// WorkloadPlural is the plural of workload resources
+	WorkloadPlural = "workloads"
+	// WorkloadRevisionPlural is the plural of workloadRevision resources
+	WorkloadRevisionPlural = "workloadrevisions"
+)
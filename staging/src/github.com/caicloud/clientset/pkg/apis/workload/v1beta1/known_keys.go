@@ -0,0 +1,6 @@
+package v1beta1
+
+const (
+	// WorkloadPlural is the plural of workload resources
+	WorkloadPlural = "workloads"
+)